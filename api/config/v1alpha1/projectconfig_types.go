@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"os"
+	"slices"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -26,8 +27,20 @@ const (
 	// EnvRelatedImageTempoGatewayOpa contains the name of the environment variable where the tempoGatewayOpa image location is stored.
 	EnvRelatedImageTempoGatewayOpa = "RELATED_IMAGE_TEMPO_GATEWAY_OPA"
 
+	// EnvRelatedImageOPA contains the name of the environment variable where the OPA image location is stored.
+	EnvRelatedImageOPA = "RELATED_IMAGE_OPA"
+
+	// EnvRelatedImageGubernator contains the name of the environment variable where the gubernator image location is stored.
+	EnvRelatedImageGubernator = "RELATED_IMAGE_GUBERNATOR"
+
+	// EnvRelatedImageKubeRBACProxy contains the name of the environment variable where the kube-rbac-proxy image location is stored.
+	EnvRelatedImageKubeRBACProxy = "RELATED_IMAGE_KUBE_RBAC_PROXY"
+
 	// EnvRelatedImageOauthProxy contains the name of the environment variable where the oauth-proxy image location is stored.
 	EnvRelatedImageOauthProxy = "RELATED_IMAGE_OAUTH_PROXY"
+
+	// EnvRelatedImageOAuth2Proxy contains the name of the environment variable where the oauth2-proxy image location is stored.
+	EnvRelatedImageOAuth2Proxy = "RELATED_IMAGE_OAUTH2_PROXY"
 )
 
 // ImagesSpec defines the image for each container.
@@ -57,10 +70,30 @@ type ImagesSpec struct {
 	// +optional
 	TempoGatewayOpa string `json:"tempoGatewayOpa,omitempty"`
 
+	// OPA defines the OPA container image which evaluates custom Rego policies of the TempoGateway.
+	//
+	// +optional
+	OPA string `json:"opa,omitempty"`
+
+	// Gubernator defines the rate limit service container image of the TempoGateway.
+	//
+	// +optional
+	Gubernator string `json:"gubernator,omitempty"`
+
 	// OauthProxy defines the oauth proxy image used to protect the jaegerUI on single tenant.
 	//
 	// +optional
 	OauthProxy string `json:"oauthProxy,omitempty"`
+
+	// KubeRBACProxy defines the kube-rbac-proxy image used to protect the jaegerUI on single tenant.
+	//
+	// +optional
+	KubeRBACProxy string `json:"kubeRBACProxy,omitempty"`
+
+	// OAuth2Proxy defines the oauth2-proxy image used for the OIDC login to the jaegerUI protected by kube-rbac-proxy.
+	//
+	// +optional
+	OAuth2Proxy string `json:"oauth2Proxy,omitempty"`
 }
 
 // BuiltInCertManagement is the configuration for the built-in facility to generate and rotate
@@ -144,10 +177,29 @@ type MetricsFeatureGates struct {
 	CreatePrometheusRules bool `json:"createPrometheusRules,omitempty"`
 }
 
+// TracingFeatureGates configures tracing of the operator.
+type TracingFeatureGates struct {
+	// Enabled defines whether the operator should export spans of its reconcile loops via OTLP/gRPC.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// OTLPEndpoint is the host and port of the OTLP/gRPC receiver, e.g. "otel-collector.observability.svc:4317".
+	OTLPEndpoint string `json:"otlpEndpoint,omitempty"`
+
+	// Insecure disables TLS for the connection to the OTLP/gRPC receiver.
+	Insecure bool `json:"insecure,omitempty"`
+
+	// SamplingRatio defines the ratio of sampled reconcile loops. Valid values are 0 to 1.
+	// All reconcile loops are sampled if the ratio is not set.
+	SamplingRatio string `json:"samplingRatio,omitempty"`
+}
+
 // ObservabilityFeatureGates configures observability of the operator.
 type ObservabilityFeatureGates struct {
 	// Metrics configures metrics of the operator.
 	Metrics MetricsFeatureGates `json:"metrics,omitempty"`
+
+	// Tracing configures tracing of the operator.
+	Tracing TracingFeatureGates `json:"tracing,omitempty"`
 }
 
 // OauthProxyFeatureGates configures oauth proxy options.
@@ -229,6 +281,12 @@ type FeatureGates struct {
 	// NetworkPolicies enables creating network policy objects.
 	NetworkPolicies bool `json:"networkPolicies,omitempty"`
 
+	// ServerSideApply enables reconciling the managed objects with server-side apply instead of
+	// client-side create or update. The operator owns only the fields it sets (field manager tempo-operator),
+	// fields set by other controllers (e.g. HPA, VPA or service mesh injectors) are kept, and
+	// conflicts with other field managers are reported in the status of the instance.
+	ServerSideApply bool `json:"serverSideApply,omitempty"`
+
 	// GrafanaOperator defines whether the Grafana Operator CRD exists in the cluster.
 	// This CRD is part of grafana-operator.
 	GrafanaOperator bool `json:"grafanaOperator,omitempty"`
@@ -322,6 +380,23 @@ type ProjectConfig struct {
 
 	// Distribution defines the operator distribution name.
 	Distribution string `json:"distribution"`
+
+	// WatchNamespaces restricts the operator to the listed namespaces.
+	// The operator watches all namespaces if the list is empty.
+	// In the namespace-scoped mode the operator does not create cluster-scoped objects,
+	// for example the ClusterRoles of the gateway.
+	// +optional
+	WatchNamespaces []string `json:"watchNamespaces,omitempty"`
+}
+
+// IsNamespaceScoped returns true if the operator watches only the namespaces listed in WatchNamespaces.
+func (c ProjectConfig) IsNamespaceScoped() bool {
+	return len(c.WatchNamespaces) > 0
+}
+
+// IsNamespaceWatched returns true if the operator manages the instances of the given namespace.
+func (c ProjectConfig) IsNamespaceWatched(namespace string) bool {
+	return !c.IsNamespaceScoped() || slices.Contains(c.WatchNamespaces, namespace)
 }
 
 // DefaultProjectConfig returns the default operator config.
@@ -350,7 +425,11 @@ func DefaultProjectConfig() ProjectConfig {
 			TempoQuery:      os.Getenv(EnvRelatedImageTempoQuery),
 			TempoGateway:    os.Getenv(EnvRelatedImageTempoGateway),
 			TempoGatewayOpa: os.Getenv(EnvRelatedImageTempoGatewayOpa),
+			OPA:             os.Getenv(EnvRelatedImageOPA),
+			Gubernator:      os.Getenv(EnvRelatedImageGubernator),
 			OauthProxy:      os.Getenv(EnvRelatedImageOauthProxy),
+			KubeRBACProxy:   os.Getenv(EnvRelatedImageKubeRBACProxy),
+			OAuth2Proxy:     os.Getenv(EnvRelatedImageOAuth2Proxy),
 		},
 		Gates: FeatureGates{
 			OpenShift: OpenShiftFeatureGates{
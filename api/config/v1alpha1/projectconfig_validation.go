@@ -3,8 +3,11 @@ package v1alpha1
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	dockerparser "github.com/novln/docker-parser"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // Validate validates the controller configuration (ProjectConfig).
@@ -25,6 +28,10 @@ func (c *ProjectConfig) Validate() error {
 		EnvRelatedImageTempoQuery:      c.DefaultImages.TempoQuery,
 		EnvRelatedImageTempoGateway:    c.DefaultImages.TempoGateway,
 		EnvRelatedImageTempoGatewayOpa: c.DefaultImages.TempoGatewayOpa,
+		EnvRelatedImageOPA:             c.DefaultImages.OPA,
+		EnvRelatedImageGubernator:      c.DefaultImages.Gubernator,
+		EnvRelatedImageKubeRBACProxy:   c.DefaultImages.KubeRBACProxy,
+		EnvRelatedImageOAuth2Proxy:     c.DefaultImages.OAuth2Proxy,
 	} {
 		if envValue != "" {
 			_, err := dockerparser.Parse(envValue)
@@ -44,5 +51,21 @@ func (c *ProjectConfig) Validate() error {
 		return errors.New("the Prometheus rules alert based on collected metrics, therefore the createServiceMonitors feature must be enabled when enabling the createPrometheusRules feature")
 	}
 
+	if c.Gates.Observability.Tracing.Enabled && c.Gates.Observability.Tracing.OTLPEndpoint == "" {
+		return errors.New("the featureGates.observability.tracing.otlpEndpoint setting must be set to enable tracing of the operator")
+	}
+	if ratio := c.Gates.Observability.Tracing.SamplingRatio; ratio != "" {
+		value, err := strconv.ParseFloat(ratio, 64)
+		if err != nil || value < 0 || value > 1 {
+			return fmt.Errorf("invalid value '%s' for setting featureGates.observability.tracing.samplingRatio (valid values: 0 to 1)", ratio)
+		}
+	}
+
+	for _, namespace := range c.WatchNamespaces {
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return fmt.Errorf("invalid namespace '%s' in setting watchNamespaces: %s", namespace, strings.Join(errs, ", "))
+		}
+	}
+
 	return nil
 }
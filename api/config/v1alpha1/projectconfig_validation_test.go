@@ -84,6 +84,120 @@ func TestValidateProjectConfig(t *testing.T) {
 			},
 			expected: errors.New("invalid value 'abc@def': please set the RELATED_IMAGE_TEMPO_GATEWAY_OPA environment variable to a valid container image"),
 		},
+		{
+			name: "invalid opa container image",
+			input: ProjectConfig{
+				DefaultImages: ImagesSpec{
+					OPA: "abc@def",
+				},
+				Gates: FeatureGates{
+					TLSProfile: "Modern",
+				},
+			},
+			expected: errors.New("invalid value 'abc@def': please set the RELATED_IMAGE_OPA environment variable to a valid container image"),
+		},
+		{
+			name: "invalid gubernator container image",
+			input: ProjectConfig{
+				DefaultImages: ImagesSpec{
+					Gubernator: "abc@def",
+				},
+				Gates: FeatureGates{
+					TLSProfile: "Modern",
+				},
+			},
+			expected: errors.New("invalid value 'abc@def': please set the RELATED_IMAGE_GUBERNATOR environment variable to a valid container image"),
+		},
+		{
+			name: "invalid kube-rbac-proxy container image",
+			input: ProjectConfig{
+				DefaultImages: ImagesSpec{
+					KubeRBACProxy: "abc@def",
+				},
+				Gates: FeatureGates{
+					TLSProfile: "Modern",
+				},
+			},
+			expected: errors.New("invalid value 'abc@def': please set the RELATED_IMAGE_KUBE_RBAC_PROXY environment variable to a valid container image"),
+		},
+		{
+			name: "invalid oauth2-proxy container image",
+			input: ProjectConfig{
+				DefaultImages: ImagesSpec{
+					OAuth2Proxy: "abc@def",
+				},
+				Gates: FeatureGates{
+					TLSProfile: "Modern",
+				},
+			},
+			expected: errors.New("invalid value 'abc@def': please set the RELATED_IMAGE_OAUTH2_PROXY environment variable to a valid container image"),
+		},
+		{
+			name: "valid featureGates.observability.tracing setting",
+			input: ProjectConfig{
+				Gates: FeatureGates{
+					TLSProfile: "Modern",
+					Observability: ObservabilityFeatureGates{
+						Tracing: TracingFeatureGates{
+							Enabled:       true,
+							OTLPEndpoint:  "otel-collector:4317",
+							SamplingRatio: "0.5",
+						},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "tracing without featureGates.observability.tracing.otlpEndpoint setting",
+			input: ProjectConfig{
+				Gates: FeatureGates{
+					TLSProfile: "Modern",
+					Observability: ObservabilityFeatureGates{
+						Tracing: TracingFeatureGates{
+							Enabled: true,
+						},
+					},
+				},
+			},
+			expected: errors.New("the featureGates.observability.tracing.otlpEndpoint setting must be set to enable tracing of the operator"),
+		},
+		{
+			name: "invalid featureGates.observability.tracing.samplingRatio setting",
+			input: ProjectConfig{
+				Gates: FeatureGates{
+					TLSProfile: "Modern",
+					Observability: ObservabilityFeatureGates{
+						Tracing: TracingFeatureGates{
+							Enabled:       true,
+							OTLPEndpoint:  "otel-collector:4317",
+							SamplingRatio: "1.5",
+						},
+					},
+				},
+			},
+			expected: errors.New("invalid value '1.5' for setting featureGates.observability.tracing.samplingRatio (valid values: 0 to 1)"),
+		},
+		{
+			name: "valid watchNamespaces setting",
+			input: ProjectConfig{
+				Gates: FeatureGates{
+					TLSProfile: "Modern",
+				},
+				WatchNamespaces: []string{"observability", "team-a"},
+			},
+			expected: nil,
+		},
+		{
+			name: "invalid watchNamespaces setting",
+			input: ProjectConfig{
+				Gates: FeatureGates{
+					TLSProfile: "Modern",
+				},
+				WatchNamespaces: []string{"observability", "Team_A"},
+			},
+			expected: errors.New("invalid namespace 'Team_A' in setting watchNamespaces: a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+		},
 	}
 
 	for _, test := range tests {
@@ -199,6 +199,7 @@ func (in *OauthProxyFeatureGates) DeepCopy() *OauthProxyFeatureGates {
 func (in *ObservabilityFeatureGates) DeepCopyInto(out *ObservabilityFeatureGates) {
 	*out = *in
 	out.Metrics = in.Metrics
+	out.Tracing = in.Tracing
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityFeatureGates.
@@ -234,6 +235,11 @@ func (in *ProjectConfig) DeepCopyInto(out *ProjectConfig) {
 	in.ControllerManagerConfigurationSpec.DeepCopyInto(&out.ControllerManagerConfigurationSpec)
 	out.DefaultImages = in.DefaultImages
 	in.Gates.DeepCopyInto(&out.Gates)
+	if in.WatchNamespaces != nil {
+		in, out := &in.WatchNamespaces, &out.WatchNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectConfig.
@@ -253,3 +259,18 @@ func (in *ProjectConfig) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingFeatureGates) DeepCopyInto(out *TracingFeatureGates) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingFeatureGates.
+func (in *TracingFeatureGates) DeepCopy() *TracingFeatureGates {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingFeatureGates)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -1,9 +1,13 @@
 package v1alpha1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // ModeType is the authentication/authorization mode in which Tempo Gateway
 // will be configured.
 //
-// +kubebuilder:validation:Enum=static;openshift
+// +kubebuilder:validation:Enum=static;openshift;kubernetes
 type ModeType string
 
 const (
@@ -12,6 +16,11 @@ const (
 	ModeStatic ModeType = "static"
 	// ModeOpenShift mode uses TokenReview API for authentication and SelfSubjectAccessReview for authorization.
 	ModeOpenShift ModeType = "openshift"
+	// ModeKubernetes mode authenticates the Kubernetes service account tokens with the OIDC issuer of the cluster,
+	// and uses SelfSubjectAccessReview for authorization.
+	// Unlike ModeOpenShift, it does not depend on the OpenShift OAuth server.
+	// The operator creates ClusterRoles which grant read and write access to the traces of the tenants.
+	ModeKubernetes ModeType = "kubernetes"
 )
 
 // TenantsSpec defines the mode, authentication and authorization
@@ -22,7 +31,7 @@ type TenantsSpec struct {
 	// +optional
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default:=static
-	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:static","urn:alm:descriptor:com.tectonic.ui:select:openshift"},displayName="Mode"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:static","urn:alm:descriptor:com.tectonic.ui:select:openshift","urn:alm:descriptor:com.tectonic.ui:select:kubernetes"},displayName="Mode"
 	Mode ModeType `json:"mode,omitempty"`
 
 	// Authentication defines the tempo-gateway component authentication configuration spec per tenant.
@@ -37,6 +46,64 @@ type TenantsSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authorization"
 	Authorization *AuthorizationSpec `json:"authorization,omitempty"`
+
+	// Kubernetes defines the authentication of the tenants in kubernetes mode.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kubernetes"
+	Kubernetes *KubernetesTenantsSpec `json:"kubernetes,omitempty"`
+
+	// TempoTenantSelector selects the TempoTenant resources which are added to the tenants.
+	// Only supported by TempoStack in static mode.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TempoTenant Selector"
+	TempoTenantSelector *TempoTenantSelectorSpec `json:"tempoTenantSelector,omitempty"`
+}
+
+// KubernetesTenantsSpec defines the authentication of the tenants in kubernetes mode.
+// The gateway verifies the bearer tokens with the OIDC discovery of the service account issuer of the cluster,
+// which must be accessible without authentication, e.g. by binding the system:service-account-issuer-discovery
+// ClusterRole to the system:unauthenticated group.
+type KubernetesTenantsSpec struct {
+	// IssuerURL is the service account issuer of the cluster (--service-account-issuer of the Kubernetes API server).
+	// Defaults to https://kubernetes.default.svc.cluster.local.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Issuer URL"
+	IssuerURL string `json:"issuerURL,omitempty"`
+
+	// Audience is the audience which the tokens must be issued for.
+	// Defaults to the issuer URL, which is the default audience of the Kubernetes API server.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Audience"
+	Audience string `json:"audience,omitempty"`
+}
+
+// TempoTenantSelectorSpec selects TempoTenant resources.
+// A TempoTenant is selected if its namespace matches the namespaceSelector and its labels match the selector.
+type TempoTenantSelectorSpec struct {
+	// NamespaceSelector selects the namespaces of the TempoTenants.
+	// If not set, only TempoTenants in the namespace of the TempoStack are selected.
+	// Use labels on namespaces which can only be set by the cluster administrator to approve TempoTenants.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace Selector"
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Selector selects the TempoTenants by their labels.
+	// If not set, all TempoTenants of the selected namespaces are selected.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Selector"
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 }
 
 // SubjectKind is a kind of Tempo Gateway RBAC subject.
@@ -79,6 +146,33 @@ type AuthorizationSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Static Role Bindings"
 	RoleBindings []RoleBindingsSpec `json:"roleBindings"`
+	// OPA defines custom OPA policies which authorize the requests of all tenants.
+	// If set, the policies replace the static roles and role bindings.
+	// Only supported by TempoStack in static mode.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OPA Policies"
+	OPA *OPAPolicySpec `json:"opa,omitempty"`
+}
+
+// OPAPolicySpec references custom Rego policies evaluated by an OPA sidecar of the gateway.
+type OPAPolicySpec struct {
+	// ConfigMap is the name of a ConfigMap in the namespace of the TempoStack containing the Rego policies.
+	// Every key with the .rego suffix is loaded as a policy.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:ConfigMap",displayName="ConfigMap"
+	ConfigMap string `json:"configMap"`
+	// Package is the Rego package of the policies.
+	// The gateway queries the data.<package>.allow rule for every request.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:="^[a-zA-Z_][a-zA-Z0-9_]*(\\.[a-zA-Z_][a-zA-Z0-9_]*)*$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Package"
+	Package string `json:"package"`
 }
 
 // PermissionType is a Tempo Gateway RBAC permission.
@@ -137,6 +231,42 @@ type AuthenticationSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OIDC Configuration"
 	OIDC *OIDCSpec `json:"oidc,omitempty"`
+
+	// MTLS defines the spec for the client certificate authentication of the tenant.
+	// Only supported in static mode, and requires TLS on the gateway (spec.template.gateway.tls).
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="mTLS Configuration"
+	MTLS *MTLSSpec `json:"mTLS,omitempty"`
+}
+
+// MTLSSpec defines the client certificate authentication of a tenant.
+type MTLSSpec struct {
+	// CA is the name of a ConfigMap containing the CA certificate used to verify the client certificates of the tenant.
+	// It needs to be in the same namespace as the TempoStack custom resource.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:ConfigMap",displayName="CA ConfigMap"
+	CA string `json:"caName"`
+
+	// CAKey is the key of the CA certificate in the ConfigMap.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=service-ca.crt
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="CA Key"
+	CAKey string `json:"caKey,omitempty"`
+
+	// Subjects lists the client certificate subjects which are allowed to write traces to the tenant.
+	// The subject of a client certificate is its first email, URI or DNS subject alternative name, or otherwise its common name.
+	// Use spec.tenants.authorization to grant further permissions to the subjects.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Subjects"
+	Subjects []string `json:"subjects,omitempty"`
 }
 
 // OIDCSpec defines the oidc configuration spec for Tempo Gateway component.
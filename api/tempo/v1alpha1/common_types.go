@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // PodStatus is a short description of the status a Pod can be in.
@@ -76,6 +77,641 @@ type ExtraConfigSpec struct {
 	Tempo apiextensionsv1.JSON `json:"tempo,omitempty"`
 }
 
+// ObjectOverrideSpec defines a patch of the objects generated by the operator.
+// The patches are applied after all objects are generated, therefore they can set
+// options of the generated objects which are not exposed by the custom resource.
+type ObjectOverrideSpec struct {
+	// Target selects the generated objects which are patched.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target"
+	Target ObjectOverrideTarget `json:"target"`
+
+	// Type defines the type of the patch.
+	// A strategic merge patch is a partial object, which is merged into the generated object.
+	// A JSON patch is a list of operations as defined in RFC 6902.
+	// Default: strategic.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=strategic
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Type"
+	Type ObjectOverridePatchType `json:"type,omitempty"`
+
+	// Patch defines the strategic merge patch or the JSON patch.
+	// Patches of selectors, pod labels or other immutable fields are rejected.
+	// The pods referencing a patched ConfigMap or Secret are restarted if the patched data changes.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Patch"
+	Patch apiextensionsv1.JSON `json:"patch"`
+}
+
+// ObjectOverrideTarget selects generated objects by kind, name and component.
+// All set fields must match, at least one field must be set.
+type ObjectOverrideTarget struct {
+	// Kind is the kind of the generated objects, e.g. Service or StatefulSet.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kind"
+	Kind string `json:"kind,omitempty"`
+
+	// Name is the name of the generated object, e.g. tempo-simplest-compactor.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name"
+	Name string `json:"name,omitempty"`
+
+	// Component is the value of the app.kubernetes.io/component label of the generated objects, e.g. compactor.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Component"
+	Component string `json:"component,omitempty"`
+}
+
+// ObjectOverridePatchType defines the type of an override patch.
+//
+// +kubebuilder:validation:Enum=strategic;json
+type ObjectOverridePatchType string
+
+const (
+	// ObjectOverridePatchTypeStrategic defines a strategic merge patch.
+	ObjectOverridePatchTypeStrategic ObjectOverridePatchType = "strategic"
+	// ObjectOverridePatchTypeJSON defines a JSON patch (RFC 6902).
+	ObjectOverridePatchTypeJSON ObjectOverridePatchType = "json"
+)
+
+// TuningSpec defines typed performance tuning options of the Tempo components.
+// Unset fields fall back to the defaults of the selected size profile (TempoStack only)
+// or to the operator and Tempo defaults.
+type TuningSpec struct {
+	// Compaction defines tuning options of the compactor.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Compaction"
+	Compaction *CompactionTuningSpec `json:"compaction,omitempty"`
+
+	// Ingestion defines tuning options of the ingester.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Ingestion"
+	Ingestion *IngestionTuningSpec `json:"ingestion,omitempty"`
+
+	// Query defines tuning options of the query-frontend and querier.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Query"
+	Query *QueryTuningSpec `json:"query,omitempty"`
+}
+
+// CompactionTuningSpec defines tuning options of the compactor.
+type CompactionTuningSpec struct {
+	// CompactionWindow defines the time window of blocks which are compacted together.
+	// Must be between 1m and 24h. Tempo default: 1h.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Compaction Window"
+	CompactionWindow *metav1.Duration `json:"compactionWindow,omitempty"`
+
+	// MaxCompactionObjects defines the maximum number of traces in a compacted block.
+	// Tempo default: 6000000.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number",displayName="Max Compaction Objects"
+	MaxCompactionObjects *int `json:"maxCompactionObjects,omitempty"`
+
+	// MaxBlockBytes defines the maximum size of a compacted block in bytes.
+	// Must be at least 1MiB. Tempo default: 107374182400 (100GiB).
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number",displayName="Max Block Bytes"
+	MaxBlockBytes *int `json:"maxBlockBytes,omitempty"`
+}
+
+// IngestionTuningSpec defines tuning options of the ingester.
+type IngestionTuningSpec struct {
+	// MaxBlockDuration defines the maximum time before a head block is cut.
+	// Must be between 1m and 1h. Default: 10m.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Block Duration"
+	MaxBlockDuration *metav1.Duration `json:"maxBlockDuration,omitempty"`
+
+	// MaxBlockBytes defines the maximum size of a head block in bytes before it is cut.
+	// Must be at least 1MiB. Tempo default: 524288000 (500MiB).
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number",displayName="Max Block Bytes"
+	MaxBlockBytes *int `json:"maxBlockBytes,omitempty"`
+
+	// TraceIdlePeriod defines the time after which a trace without new spans is flushed to the head block.
+	// Must be at least 1s and must not exceed the max block duration. Tempo default: 5s.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Trace Idle Period"
+	TraceIdlePeriod *metav1.Duration `json:"traceIdlePeriod,omitempty"`
+}
+
+// QueryTuningSpec defines tuning options of the query path.
+type QueryTuningSpec struct {
+	// TraceByIDQueryShards defines the number of shards a trace by ID request is split into.
+	// Must be between 2 and 100000. Tempo default: 50.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=100000
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number",displayName="Trace by ID Query Shards"
+	TraceByIDQueryShards *int `json:"traceByIDQueryShards,omitempty"`
+
+	// SearchConcurrentJobs defines the number of concurrent jobs a search request is split into.
+	// Default: 2000.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number",displayName="Search Concurrent Jobs"
+	SearchConcurrentJobs *int `json:"searchConcurrentJobs,omitempty"`
+
+	// MetricsConcurrentJobs defines the number of concurrent jobs a TraceQL metrics request is split into.
+	// Tempo default: 1000.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number",displayName="Metrics Concurrent Jobs"
+	MetricsConcurrentJobs *int `json:"metricsConcurrentJobs,omitempty"`
+
+	// MaxOutstandingPerTenant defines the maximum number of outstanding requests per tenant in the query-frontend.
+	// Tempo default: 2000.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number",displayName="Max Outstanding Requests per Tenant"
+	MaxOutstandingPerTenant *int `json:"maxOutstandingPerTenant,omitempty"`
+
+	// MaxConcurrentQueries defines the maximum number of jobs a single querier processes concurrently.
+	// Default: 20.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:number",displayName="Max Concurrent Queries"
+	MaxConcurrentQueries *int `json:"maxConcurrentQueries,omitempty"`
+}
+
+// MetricsScrapeSpec defines the scrape settings of the metrics endpoints.
+type MetricsScrapeSpec struct {
+	// ScrapeInterval defines the interval between scrapes, e.g. 30s.
+	// Defaults to the scrape interval of Prometheus.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Scrape Interval"
+	ScrapeInterval *metav1.Duration `json:"scrapeInterval,omitempty"`
+
+	// ScrapeTimeout defines the timeout of a scrape, e.g. 10s.
+	// It must not be greater than the scrape interval.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Scrape Timeout"
+	ScrapeTimeout *metav1.Duration `json:"scrapeTimeout,omitempty"`
+
+	// SampleLimit defines the maximum number of samples of a scrape.
+	// The scrape fails if the limit is exceeded.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Sample Limit",xDescriptors="urn:alm:descriptor:com.tectonic.ui:number"
+	SampleLimit *int `json:"sampleLimit,omitempty"`
+
+	// MetricRelabelings defines the relabelings of the scraped samples, applied before ingestion.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Metric Relabelings",xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced"
+	MetricRelabelings []MetricRelabelConfig `json:"metricRelabelings,omitempty"`
+}
+
+// MetricRelabelConfig defines a relabeling of the scraped samples.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#metric_relabel_configs
+type MetricRelabelConfig struct {
+	// SourceLabels defines the labels whose values are concatenated and matched against the regex.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Source Labels"
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+
+	// Separator is placed between the concatenated values of the source labels. Default: ;
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Separator"
+	Separator *string `json:"separator,omitempty"`
+
+	// Regex is matched against the concatenated values of the source labels. Default: (.*)
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Regex"
+	Regex string `json:"regex,omitempty"`
+
+	// TargetLabel is the label which is written by the replace action.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target Label"
+	TargetLabel string `json:"targetLabel,omitempty"`
+
+	// Replacement is the value which is written by the replace action. Default: $1
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replacement"
+	Replacement *string `json:"replacement,omitempty"`
+
+	// Action defines the relabel action. Default: replace
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=replace;keep;drop;labelmap;labeldrop;labelkeep;lowercase;uppercase;keepequal;dropequal
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Action"
+	Action string `json:"action,omitempty"`
+}
+
+// AlertsSpec defines the customization of the Prometheus alerts.
+type AlertsSpec struct {
+	// RunbookURL defines the base URL of the runbooks of the built-in alerts.
+	// The name of the alert is appended as anchor, e.g. <runbookURL>#TempoRequestLatency.
+	// Default: https://github.com/grafana/tempo/tree/main/operations/tempo-mixin/runbook.md
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Runbook URL"
+	RunbookURL string `json:"runbookURL,omitempty"`
+
+	// Rules customizes the built-in alerts.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=alert
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rules"
+	Rules []AlertRuleOverrideSpec `json:"rules,omitempty"`
+
+	// CustomRules defines additional alerts.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=alert
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Custom Rules",xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced"
+	CustomRules []CustomAlertRuleSpec `json:"customRules,omitempty"`
+}
+
+// AlertRuleOverrideSpec customizes a built-in alert.
+type AlertRuleOverrideSpec struct {
+	// Alert is the name of the built-in alert, e.g. TempoRequestLatency.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Alert"
+	Alert string `json:"alert"`
+
+	// Disabled removes the alert from the PrometheusRule.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Disabled",xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	Disabled bool `json:"disabled,omitempty"`
+
+	// For defines how long the condition must be true before the alert fires.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="For"
+	For *metav1.Duration `json:"for,omitempty"`
+
+	// Threshold overrides the threshold of the alert expression, e.g. 5 for a request latency of 5 seconds.
+	// Not every alert has a threshold.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Threshold"
+	Threshold string `json:"threshold,omitempty"`
+
+	// Severity overrides the severity label of the alert.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Severity"
+	Severity string `json:"severity,omitempty"`
+
+	// ExtraLabels defines additional labels of the alert.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Extra Labels"
+	ExtraLabels map[string]string `json:"extraLabels,omitempty"`
+
+	// ExtraAnnotations defines additional annotations of the alert.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Extra Annotations"
+	ExtraAnnotations map[string]string `json:"extraAnnotations,omitempty"`
+}
+
+// CustomAlertRuleSpec defines an additional alert.
+type CustomAlertRuleSpec struct {
+	// Alert is the name of the alert.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Alert"
+	Alert string `json:"alert"`
+
+	// Expr is the PromQL expression of the alert.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Expression"
+	Expr string `json:"expr"`
+
+	// For defines how long the condition must be true before the alert fires.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="For"
+	For *metav1.Duration `json:"for,omitempty"`
+
+	// Labels defines the labels of the alert.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Labels"
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations defines the annotations of the alert.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Annotations"
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// GrafanaDataSourceLinksSpec defines the links of the Grafana data source to other Grafana data sources.
+type GrafanaDataSourceLinksSpec struct {
+	// TracesToLogs defines the link from spans to the logs of a Loki data source.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Traces to Logs",xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced"
+	TracesToLogs *GrafanaTracesToLogsSpec `json:"tracesToLogs,omitempty"`
+
+	// TracesToMetrics defines the link from spans to the metrics of a Prometheus data source.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Traces to Metrics",xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced"
+	TracesToMetrics *GrafanaTracesToMetricsSpec `json:"tracesToMetrics,omitempty"`
+
+	// ServiceMap defines the Prometheus data source of the service graph metrics,
+	// which are generated by the metrics-generator.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Service Map",xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced"
+	ServiceMap *GrafanaServiceMapSpec `json:"serviceMap,omitempty"`
+}
+
+// GrafanaTracesToLogsSpec defines the link from spans to logs.
+type GrafanaTracesToLogsSpec struct {
+	// DataSourceUID is the UID of the Loki data source.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Data Source UID"
+	DataSourceUID string `json:"dataSourceUID"`
+
+	// Tags defines the span attributes which are used in the log query, and the names of the log labels.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tags"
+	Tags []GrafanaTagMapping `json:"tags,omitempty"`
+
+	// SpanStartTimeShift shifts the start time of the log query relative to the start time of the span, e.g. -1h.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Span Start Time Shift"
+	SpanStartTimeShift string `json:"spanStartTimeShift,omitempty"`
+
+	// SpanEndTimeShift shifts the end time of the log query relative to the end time of the span, e.g. 1h.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Span End Time Shift"
+	SpanEndTimeShift string `json:"spanEndTimeShift,omitempty"`
+
+	// FilterByTraceID filters the logs by the trace ID of the span.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Filter by Trace ID",xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	FilterByTraceID bool `json:"filterByTraceID,omitempty"`
+
+	// FilterBySpanID filters the logs by the span ID of the span.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Filter by Span ID",xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	FilterBySpanID bool `json:"filterBySpanID,omitempty"`
+
+	// Query defines a custom log query, which replaces the query generated from the tags.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Query"
+	Query string `json:"query,omitempty"`
+}
+
+// GrafanaTracesToMetricsSpec defines the link from spans to metrics.
+type GrafanaTracesToMetricsSpec struct {
+	// DataSourceUID is the UID of the Prometheus data source.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Data Source UID"
+	DataSourceUID string `json:"dataSourceUID"`
+
+	// Tags defines the span attributes which are used in the metric queries, and the names of the metric labels.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tags"
+	Tags []GrafanaTagMapping `json:"tags,omitempty"`
+
+	// SpanStartTimeShift shifts the start time of the metric queries relative to the start time of the span, e.g. -1h.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Span Start Time Shift"
+	SpanStartTimeShift string `json:"spanStartTimeShift,omitempty"`
+
+	// SpanEndTimeShift shifts the end time of the metric queries relative to the end time of the span, e.g. 1h.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Span End Time Shift"
+	SpanEndTimeShift string `json:"spanEndTimeShift,omitempty"`
+
+	// Queries defines the metric queries of the link.
+	// The $__tags placeholder of a query is replaced with the label matchers of the tags.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Queries"
+	Queries []GrafanaMetricsQuery `json:"queries,omitempty"`
+}
+
+// GrafanaTagMapping maps a span attribute to a label of the linked data source.
+type GrafanaTagMapping struct {
+	// Key is the name of the span attribute, e.g. service.name.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key"
+	Key string `json:"key"`
+
+	// Value is the name of the label. The key is used as label name if the value is empty.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Value"
+	Value string `json:"value,omitempty"`
+}
+
+// GrafanaMetricsQuery defines a metric query of the traces to metrics link.
+type GrafanaMetricsQuery struct {
+	// Name is the name of the link.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name"
+	Name string `json:"name"`
+
+	// Query is the PromQL query.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Query"
+	Query string `json:"query"`
+}
+
+// GrafanaServiceMapSpec defines the data source of the service map.
+type GrafanaServiceMapSpec struct {
+	// DataSourceUID is the UID of the Prometheus data source.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Data Source UID"
+	DataSourceUID string `json:"dataSourceUID"`
+}
+
+// GrafanaDataSourceAuthenticationMethod defines how a Grafana data source authenticates at the gateway.
+//
+// +kubebuilder:validation:Enum=forwardOAuthIdentity;serviceAccountToken
+type GrafanaDataSourceAuthenticationMethod string
+
+const (
+	// GrafanaDataSourceAuthenticationForwardOAuthIdentity forwards the OAuth identity of the Grafana user to the gateway.
+	GrafanaDataSourceAuthenticationForwardOAuthIdentity GrafanaDataSourceAuthenticationMethod = "forwardOAuthIdentity"
+	// GrafanaDataSourceAuthenticationServiceAccountToken sends the token of a service account to the gateway.
+	GrafanaDataSourceAuthenticationServiceAccountToken GrafanaDataSourceAuthenticationMethod = "serviceAccountToken"
+)
+
+// GrafanaDataSourceAuthenticationSpec defines the authentication of the per-tenant Grafana data sources at the gateway.
+type GrafanaDataSourceAuthenticationSpec struct {
+	// Method defines how the data sources authenticate at the gateway.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=forwardOAuthIdentity
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:forwardOAuthIdentity","urn:alm:descriptor:com.tectonic.ui:select:serviceAccountToken"},displayName="Method"
+	Method GrafanaDataSourceAuthenticationMethod `json:"method,omitempty"`
+
+	// TokenSecret is the name of a Secret containing a service account token (token),
+	// e.g. a Secret of type kubernetes.io/service-account-token.
+	// It needs to be in the same namespace as the Tempo custom resource.
+	// Required if the method is serviceAccountToken.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Token Secret",xDescriptors="urn:alm:descriptor:io.kubernetes:Secret"
+	TokenSecret string `json:"tokenSecret,omitempty"`
+}
+
+// GrafanaDashboardsSpec defines the Grafana dashboards of a Tempo deployment.
+type GrafanaDashboardsSpec struct {
+	// Enabled defines if Grafana dashboards should be created for this Tempo deployment.
+	// The dashboards are created as GrafanaDashboard objects if the grafanaOperator feature gate is enabled,
+	// otherwise as ConfigMaps which are discovered by the Grafana dashboard sidecar.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enabled",order=1,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	Enabled bool `json:"enabled"`
+
+	// InstanceSelector defines the Grafana instance where the dashboards should be created.
+	// Only used if the grafanaOperator feature gate is enabled.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Instance Selector",xDescriptors="urn:alm:descriptor:com.tectonic.ui:selector:grafana.integreatly.org:v1beta1:Grafana"
+	InstanceSelector *metav1.LabelSelector `json:"instanceSelector,omitempty"`
+
+	// Folder defines the Grafana folder of the dashboards.
+	// The dashboard sidecar reads the folder from the grafana_folder annotation of the ConfigMaps,
+	// if the folderAnnotation setting of the sidecar is set to grafana_folder.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Folder"
+	Folder string `json:"folder,omitempty"`
+
+	// ExtraLabels defines additional labels for the dashboard objects.
+	// The ConfigMaps are labelled with grafana_dashboard: "1" by default.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Extra Labels"
+	ExtraLabels map[string]string `json:"extraLabels,omitempty"`
+}
+
 // JaegerQueryAuthenticationSpec defines options applied to proxy sidecar that controls the authentication of the jaeger UI.
 type JaegerQueryAuthenticationSpec struct {
 	// Defines if the authentication will be enabled for jaeger UI.
@@ -85,8 +721,21 @@ type JaegerQueryAuthenticationSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enabled",order=1,xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
 	Enabled bool `json:"enabled"`
 
+	// Provider defines the proxy which authenticates and authorizes the requests to the Jaeger UI.
+	// The oauth-proxy provider requires the OpenShift OAuth server, the kube-rbac-proxy provider
+	// authenticates bearer tokens and client certificates with the Kubernetes API and works on any cluster.
+	// Default: oauth-proxy.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Provider"
+	Provider JaegerUIAuthenticationProvider `json:"provider,omitempty"`
+
 	// SAR defines the SAR to be used in the oauth-proxy
 	// default is "{"namespace": "<tempo_stack_namespace>", "resource": "pods", "verb": "get"}
+	// The kube-rbac-proxy provider authorizes the requests against the namespace, resource, resourceAPIGroup,
+	// resourceAPIVersion and resourceName of the SAR. The verb is derived from the HTTP method of the request,
+	// therefore the verb of the SAR must be empty or "get".
 	//
 	// +optional
 	// +kubebuilder:validation:Optional
@@ -98,6 +747,75 @@ type JaegerQueryAuthenticationSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resources",xDescriptors="urn:alm:descriptor:com.tectonic.ui:resourceRequirements"
 	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// OIDC enables the browser login to the Jaeger UI with an OpenID Connect provider.
+	// An oauth2-proxy sidecar redirects the users to the OIDC provider and forwards the ID token to kube-rbac-proxy,
+	// which validates the ID token and authorizes the username and groups of the token with the SAR.
+	// The redirect URI https://<Jaeger UI host>/oauth2/callback must be registered for the client of the OIDC provider.
+	// If enabled, kube-rbac-proxy authenticates only ID tokens of the OIDC provider, not ServiceAccount tokens.
+	// Only supported by the kube-rbac-proxy provider.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OIDC"
+	OIDC *JaegerUIOIDCSpec `json:"oidc,omitempty"`
+}
+
+// JaegerUIOIDCSpec defines the OpenID Connect provider of the browser login to the Jaeger UI.
+type JaegerUIOIDCSpec struct {
+	// IssuerURL is the URL of the OIDC provider.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Issuer URL"
+	IssuerURL string `json:"issuerURL"`
+
+	// Secret is the name of a Secret in the namespace of the instance with the keys
+	// clientID, clientSecret and cookieSecret. The cookieSecret must have 16, 24 or 32 bytes, optionally base64 encoded.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret",xDescriptors="urn:alm:descriptor:io.kubernetes:Secret"
+	Secret string `json:"secret"`
+
+	// UsernameClaim is the claim of the ID token which is used as username in the SAR.
+	// Default: email.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Username Claim"
+	UsernameClaim string `json:"usernameClaim,omitempty"`
+
+	// GroupsClaim is the claim of the ID token which is used as groups in the SAR.
+	// Default: groups.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Groups Claim"
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+}
+
+// JaegerUIAuthenticationProvider defines the proxy which protects the Jaeger UI.
+//
+// +kubebuilder:validation:Enum=oauth-proxy;kube-rbac-proxy
+type JaegerUIAuthenticationProvider string
+
+const (
+	// JaegerUIAuthenticationProviderOAuthProxy authenticates the users with the OpenShift OAuth server.
+	JaegerUIAuthenticationProviderOAuthProxy JaegerUIAuthenticationProvider = "oauth-proxy"
+	// JaegerUIAuthenticationProviderKubeRBACProxy authenticates the requests with a TokenReview
+	// and authorizes them with a SubjectAccessReview.
+	JaegerUIAuthenticationProviderKubeRBACProxy JaegerUIAuthenticationProvider = "kube-rbac-proxy"
+)
+
+// IsKubeRBACProxy returns true if the Jaeger UI is protected by kube-rbac-proxy.
+func (a *JaegerQueryAuthenticationSpec) IsKubeRBACProxy() bool {
+	return a != nil && a.Enabled && a.Provider == JaegerUIAuthenticationProviderKubeRBACProxy
+}
+
+// IsOIDC returns true if the Jaeger UI is protected by kube-rbac-proxy with the OIDC browser login.
+func (a *JaegerQueryAuthenticationSpec) IsOIDC() bool {
+	return a.IsKubeRBACProxy() && a.OIDC != nil
 }
 
 // CredentialMode represents the type of authentication used for accessing the object storage.
@@ -120,3 +838,134 @@ const (
 	// a secret. This mode is only supported for certain object storage types in certain runtime environments.
 	CredentialModeTokenCCO CredentialMode = "token-cco"
 )
+
+// OTLPTracingProtocol defines the protocol of the OTLP trace exporter.
+//
+// +kubebuilder:validation:Enum=grpc;http/protobuf
+type OTLPTracingProtocol string
+
+const (
+	// OTLPTracingProtocolGRPC exports the traces via OTLP/gRPC.
+	OTLPTracingProtocolGRPC OTLPTracingProtocol = "grpc"
+	// OTLPTracingProtocolHTTP exports the traces via OTLP/HTTP with protobuf encoding.
+	OTLPTracingProtocolHTTP OTLPTracingProtocol = "http/protobuf"
+)
+
+// TracingSampler defines the sampler of the traces.
+//
+// +kubebuilder:validation:Enum=always_on;always_off;traceidratio;parentbased_always_on;parentbased_always_off;parentbased_traceidratio
+type TracingSampler string
+
+const (
+	// TracingSamplerAlwaysOn samples every trace.
+	TracingSamplerAlwaysOn TracingSampler = "always_on"
+	// TracingSamplerAlwaysOff samples no trace.
+	TracingSamplerAlwaysOff TracingSampler = "always_off"
+	// TracingSamplerTraceIDRatio samples a given fraction of the traces.
+	TracingSamplerTraceIDRatio TracingSampler = "traceidratio"
+	// TracingSamplerParentBasedAlwaysOn samples every trace, unless the parent span is not sampled.
+	TracingSamplerParentBasedAlwaysOn TracingSampler = "parentbased_always_on"
+	// TracingSamplerParentBasedAlwaysOff samples no trace, unless the parent span is sampled.
+	TracingSamplerParentBasedAlwaysOff TracingSampler = "parentbased_always_off"
+	// TracingSamplerParentBasedTraceIDRatio samples a given fraction of the traces, unless the parent span decides otherwise.
+	TracingSamplerParentBasedTraceIDRatio TracingSampler = "parentbased_traceidratio"
+)
+
+// OTLPTracingSpec defines the export of the traces of the Tempo components via OTLP.
+type OTLPTracingSpec struct {
+	// Protocol defines the protocol of the OTLP exporter.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=grpc
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Protocol",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:grpc","urn:alm:descriptor:com.tectonic.ui:select:http/protobuf"}
+	Protocol OTLPTracingProtocol `json:"protocol,omitempty"`
+
+	// Endpoint defines the URL of the OTLP receiver, for example "https://otel-collector.observability.svc:4317".
+	// The traces are sent via TLS if the scheme is https.
+	// Either the endpoint or the gateway must be set.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Endpoint"
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Gateway sends the traces to the gateway of this Tempo instance.
+	// Either the endpoint or the gateway must be set.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Gateway"
+	Gateway *OTLPTracingGatewaySpec `json:"gateway,omitempty"`
+
+	// TLS defines the CA and client certificate of the OTLP exporter.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS"
+	TLS *OTLPTracingTLSSpec `json:"tls,omitempty"`
+
+	// HeadersSecret is the name of a Secret containing the headers sent with every export request (headers key),
+	// in the format "key1=value1,key2=value2".
+	// It needs to be in the same namespace as the Tempo custom resource.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:Secret",displayName="Headers Secret"
+	HeadersSecret string `json:"headersSecret,omitempty"`
+
+	// Sampler defines the sampler of the traces.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=parentbased_traceidratio
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Sampler"
+	Sampler TracingSampler `json:"sampler,omitempty"`
+
+	// SamplerArgument defines the argument of the sampler, i.e. the sampling ratio of the traceidratio samplers.
+	// Valid values are 0 to 1.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Sampler Argument"
+	SamplerArgument string `json:"samplerArgument,omitempty"`
+
+	// ResourceAttributes defines additional resource attributes of the traces.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resource Attributes"
+	ResourceAttributes map[string]string `json:"resourceAttributes,omitempty"`
+}
+
+// OTLPTracingGatewaySpec defines the export of the traces to the gateway of this Tempo instance.
+type OTLPTracingGatewaySpec struct {
+	// Tenant defines the tenant receiving the traces.
+	// The gateway authenticates the export requests, therefore the credentials
+	// (e.g. an Authorization header) must be supplied via the headersSecret.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tenant"
+	Tenant string `json:"tenant"`
+}
+
+// OTLPTracingTLSSpec defines the TLS configuration of the OTLP trace exporter.
+type OTLPTracingTLSSpec struct {
+	// CA is the name of a ConfigMap containing a CA certificate (service-ca.crt) to verify the receiver.
+	// It needs to be in the same namespace as the Tempo custom resource.
+	// Defaults to the CA of the gateway if the traces are sent to the gateway.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:ConfigMap",displayName="CA ConfigMap"
+	CA string `json:"caName,omitempty"`
+
+	// Cert is the name of a Secret containing a client certificate (tls.crt) and private key (tls.key).
+	// It needs to be in the same namespace as the Tempo custom resource.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:io.kubernetes:Secret",displayName="Client Certificate Secret"
+	Cert string `json:"certName,omitempty"`
+}
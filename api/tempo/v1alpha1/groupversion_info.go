@@ -24,6 +24,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(GroupVersion,
 		&TempoStack{}, &TempoStackList{},
 		&TempoMonolithic{}, &TempoMonolithicList{},
+		&TempoTenant{}, &TempoTenantList{},
 	)
 	metav1.AddToGroupVersion(scheme, GroupVersion)
 	return nil
@@ -92,6 +92,11 @@ func (r *TempoMonolithic) Default(ctrlConfig configv1alpha1.ProjectConfig) {
 			}
 		}
 
+		// kube-rbac-proxy protects the Jaeger UI also without a route
+		if r.Spec.JaegerUI.Authentication.IsKubeRBACProxy() && len(strings.TrimSpace(r.Spec.JaegerUI.Authentication.SAR)) == 0 {
+			r.Spec.JaegerUI.Authentication.SAR = fmt.Sprintf("{\"namespace\": \"%s\", \"resource\": \"pods\", \"verb\": \"get\"}", r.Namespace)
+		}
+
 		if r.Spec.JaegerUI.ServicesQueryDuration == nil {
 			r.Spec.JaegerUI.ServicesQueryDuration = &defaultServicesDuration
 		}
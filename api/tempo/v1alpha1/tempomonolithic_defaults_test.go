@@ -516,6 +516,63 @@ func TestMonolithicDefault(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:       "set SAR of kube-rbac-proxy without a route",
+			ctrlConfig: defaultCtrlConfig,
+			input: &TempoMonolithic{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "testns",
+				},
+				Spec: TempoMonolithicSpec{
+					JaegerUI: &MonolithicJaegerUISpec{
+						Enabled: true,
+						Authentication: &JaegerQueryAuthenticationSpec{
+							Enabled:  true,
+							Provider: JaegerUIAuthenticationProviderKubeRBACProxy,
+						},
+					},
+				},
+			},
+			expected: &TempoMonolithic{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "testns",
+				},
+				Spec: TempoMonolithicSpec{
+					Storage: &MonolithicStorageSpec{
+						Traces: MonolithicTracesStorageSpec{
+							Backend: "memory",
+							Size:    &twoGBQuantity,
+						},
+					},
+					Ingestion: &MonolithicIngestionSpec{
+						OTLP: &MonolithicIngestionOTLPSpec{
+							GRPC: &MonolithicIngestionOTLPProtocolsGRPCSpec{
+								Enabled: true,
+							},
+							HTTP: &MonolithicIngestionOTLPProtocolsHTTPSpec{
+								Enabled: true,
+							},
+						},
+					},
+					JaegerUI: &MonolithicJaegerUISpec{
+						Enabled: true,
+						Authentication: &JaegerQueryAuthenticationSpec{
+							Enabled:  true,
+							Provider: JaegerUIAuthenticationProviderKubeRBACProxy,
+							SAR:      "{\"namespace\": \"testns\", \"resource\": \"pods\", \"verb\": \"get\"}",
+						},
+						ServicesQueryDuration:        &defaultServicesDuration,
+						FindTracesConcurrentRequests: 2,
+					},
+					Management:         "Managed",
+					Timeout:            metav1.Duration{Duration: 30 * time.Second},
+					Query:              &MonolithicQuerySpec{},
+					PodSecurityContext: defaultPodSecurityContext,
+				},
+			},
+		},
 		{
 			name:       "query defined",
 			ctrlConfig: defaultCtrlConfig,
@@ -68,12 +68,25 @@ type TempoMonolithicSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Management State",xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced"
 	Management ManagementStateType `json:"management,omitempty"`
 
+	// Tuning defines typed performance tuning options of the compactor, ingester and query path.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tuning",xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced"
+	Tuning *TuningSpec `json:"tuning,omitempty"`
+
 	// ExtraConfig defines any extra (overlay) configuration of components.
 	//
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Extra Configuration",xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced"
 	ExtraConfig *ExtraConfigSpec `json:"extraConfig,omitempty"`
 
+	// Overrides defines patches of the objects generated by the operator, for settings which are not exposed by the TempoMonolithic.
+	// Overrides which do not match any generated object are ignored.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Overrides",xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced"
+	Overrides []ObjectOverrideSpec `json:"overrides,omitempty"`
+
 	// Env defines additional environment variables for the Tempo container.
 	// These environment variables can be used together with extraConfig and the -config.expand-env=true flag
 	// to reference Kubernetes Secrets or ConfigMaps in the Tempo configuration,
@@ -459,6 +472,12 @@ type MonolithicObservabilitySpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Grafana"
 	Grafana *MonolithicObservabilityGrafanaSpec `json:"grafana,omitempty"`
+
+	// Tracing defines the export of the traces of Tempo via OTLP/gRPC or OTLP/HTTP.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tracing"
+	Tracing *OTLPTracingSpec `json:"tracing,omitempty"`
 }
 
 // MonolithicObservabilityMetricsSpec defines the metrics settings of the Tempo deployment.
@@ -504,6 +523,12 @@ type MonolithicObservabilityMetricsPrometheusRulesSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Extra Labels"
 	ExtraLabels map[string]string `json:"extraLabels,omitempty"`
+
+	// Alerts customizes the alerts of the PrometheusRules objects.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Alerts"
+	Alerts *AlertsSpec `json:"alerts,omitempty"`
 }
 
 // MonolithicObservabilityGrafanaSpec defines the Grafana configuration of the Tempo deployment.
@@ -513,6 +538,12 @@ type MonolithicObservabilityGrafanaSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Grafana data source"
 	DataSource *MonolithicObservabilityGrafanaDataSourceSpec `json:"dataSource,omitempty"`
+
+	// Dashboards defines the Grafana dashboards of the Tempo deployment.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Dashboards"
+	Dashboards *GrafanaDashboardsSpec `json:"dashboards,omitempty"`
 }
 
 // MonolithicObservabilityGrafanaDataSourceSpec defines the Grafana data source configuration of the Tempo deployment.
@@ -528,6 +559,15 @@ type MonolithicObservabilityGrafanaDataSourceSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Instance Selector",xDescriptors="urn:alm:descriptor:com.tectonic.ui:selector:grafana.integreatly.org:v1beta1:Grafana"
 	InstanceSelector *metav1.LabelSelector `json:"instanceSelector,omitempty"`
+
+	GrafanaDataSourceLinksSpec `json:",inline"`
+
+	// Authentication defines how the data sources authenticate at the gateway.
+	// If multi-tenancy is enabled, a data source is created for every tenant.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication"
+	Authentication *GrafanaDataSourceAuthenticationSpec `json:"authentication,omitempty"`
 }
 
 // MonolithicComponentStatus defines the status of each component.
@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -80,6 +81,14 @@ type TempoStackSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Ingestion and Querying Ratelimiting"
 	LimitSpec LimitSpec `json:"limits,omitempty"`
 
+	// UserConfigurableOverrides enables the user-configurable overrides module of Tempo.
+	// Tenants can manage their own limits and metrics-generator settings using the overrides API of Tempo.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="User-configurable Overrides"
+	UserConfigurableOverrides *UserConfigurableOverridesSpec `json:"userConfigurableOverrides,omitempty"`
+
 	// Timeout configures the same timeout on all components starting at ingress down to the ingestor/querier.
 	// Timeout configuration on a specific component has a higher precedence.
 	// Defaults to 30 seconds.
@@ -187,10 +196,26 @@ type TempoStackSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Network Policy"
 	NetworkPolicy NetworkPolicySpec `json:"networkPolicy,omitempty"`
 
+	// Tuning defines typed performance tuning options of the compactor, ingester and query path.
+	// Unset options are defaulted based on spec.size.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tuning",xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced"
+	Tuning *TuningSpec `json:"tuning,omitempty"`
+
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Extra Configurations"
 	ExtraConfig *ExtraConfigSpec `json:"extraConfig,omitempty"`
 
+	// Overrides defines patches of the objects generated by the operator, for settings which are not exposed by the TempoStack.
+	// Overrides which do not match any generated object are ignored.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Overrides",xDescriptors="urn:alm:descriptor:com.tectonic.ui:advanced"
+	Overrides []ObjectOverrideSpec `json:"overrides,omitempty"`
+
 	// Env defines additional environment variables for the Tempo containers of all components.
 	// These environment variables can be used together with extraConfig and the -config.expand-env=true flag
 	// to reference Kubernetes Secrets or ConfigMaps in the Tempo configuration,
@@ -271,8 +296,43 @@ type MetricsConfigSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Extra PrometheusRule Labels"
 	ExtraPrometheusRuleLabels map[string]string `json:"extraPrometheusRuleLabels,omitempty"`
+
+	// Alerts customizes the alerts of the PrometheusRule.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Alerts"
+	Alerts *AlertsSpec `json:"alerts,omitempty"`
+
+	// ScrapeMode defines how the metrics endpoints of the Tempo components are exposed for scraping.
+	// serviceMonitor creates ServiceMonitor objects if createServiceMonitors is enabled.
+	// annotations adds prometheus.io/* annotations to the Services of the components.
+	// otelCollector creates a ConfigMap with the scrape configuration of an OpenTelemetry Collector prometheus receiver.
+	// The annotations and otelCollector modes do not require the Prometheus Operator.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=serviceMonitor
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:serviceMonitor","urn:alm:descriptor:com.tectonic.ui:select:annotations","urn:alm:descriptor:com.tectonic.ui:select:otelCollector"},displayName="Scrape Mode"
+	ScrapeMode MetricsScrapeMode `json:"scrapeMode,omitempty"`
+
+	MetricsScrapeSpec `json:",inline"`
 }
 
+// MetricsScrapeMode defines how the metrics endpoints are exposed for scraping.
+//
+// +kubebuilder:validation:Enum=serviceMonitor;annotations;otelCollector
+type MetricsScrapeMode string
+
+const (
+	// MetricsScrapeModeServiceMonitor creates ServiceMonitor objects of the Prometheus Operator.
+	MetricsScrapeModeServiceMonitor MetricsScrapeMode = "serviceMonitor"
+	// MetricsScrapeModeAnnotations adds prometheus.io/* annotations to the Services.
+	MetricsScrapeModeAnnotations MetricsScrapeMode = "annotations"
+	// MetricsScrapeModeOTelCollector creates a ConfigMap with the scrape configuration of an OpenTelemetry Collector prometheus receiver.
+	MetricsScrapeModeOTelCollector MetricsScrapeMode = "otelCollector"
+)
+
 // TracingConfigSpec defines a tracing config including endpoints and sampling.
 type TracingConfigSpec struct {
 	// SamplingFraction defines the sampling ratio. Valid values are 0 to 1.
@@ -301,6 +361,14 @@ type TracingConfigSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OTLP-HTTP-Endpoint"
 	OTLPHttpEndpoint string `json:"otlp_http_endpoint,omitempty"`
+
+	// OTLP defines the export of the traces of the Tempo components via OTLP/gRPC or OTLP/HTTP.
+	// If set, it takes precedence over SamplingFraction and OTLPHttpEndpoint for all components except the gateway.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OTLP"
+	OTLP *OTLPTracingSpec `json:"otlp,omitempty"`
 }
 
 // GrafanaConfigSpec defines configuration for Grafana.
@@ -318,6 +386,24 @@ type GrafanaConfigSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Create CreateDatasource for Tempo"
 	InstanceSelector metav1.LabelSelector `json:"instanceSelector,omitempty"`
+
+	GrafanaDataSourceLinksSpec `json:",inline"`
+
+	// Authentication defines how the data sources authenticate at the gateway.
+	// If the gateway is enabled, a data source is created for every tenant.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication"
+	Authentication *GrafanaDataSourceAuthenticationSpec `json:"authentication,omitempty"`
+
+	// Dashboards defines the Grafana dashboards of the TempoStack.
+	// The instanceSelector of the data source is used if the instanceSelector of the dashboards is not set.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Dashboards"
+	Dashboards *GrafanaDashboardsSpec `json:"dashboards,omitempty"`
 }
 
 // ComponentStatus defines the status of each component.
@@ -399,6 +485,86 @@ type TempoStackStatus struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=status,xDescriptors="urn:alm:descriptor:io.kubernetes.conditions"
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// TenantOverrides shows which version of the per-tenant overrides is loaded by Tempo.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Tenant Overrides"
+	TenantOverrides *TenantOverridesStatus `json:"tenantOverrides,omitempty"`
+}
+
+// UserConfigurableOverridesSpec defines the user-configurable overrides.
+type UserConfigurableOverridesSpec struct {
+	// Enabled enables the user-configurable overrides module and its API.
+	// The overrides are stored in the object storage of the TempoStack.
+	// The API is served by the query-frontend at /api/overrides. If the gateway is enabled,
+	// the API is available read-only at /api/traces/v1/<tenant>/tempo/api/overrides:
+	// the gateway authorizes all requests to Tempo with the read permission of the tenant on the traces resource,
+	// and cannot require the write permission for modifications.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enabled",xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	Enabled bool `json:"enabled,omitempty"`
+
+	// PollInterval defines how often the user-configurable overrides are reloaded from the object storage.
+	// Defaults to 60 seconds.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Format:=duration
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Poll Interval"
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+}
+
+// UserConfigurableOverridesEnabled returns true if the user-configurable overrides module is enabled.
+func (spec *TempoStackSpec) UserConfigurableOverridesEnabled() bool {
+	return spec.UserConfigurableOverrides != nil && spec.UserConfigurableOverrides.Enabled
+}
+
+// TenantOverridesStatus shows the version of the per-tenant overrides.
+// Per-tenant overrides are reloaded by Tempo periodically, without restarting the pods.
+type TenantOverridesStatus struct {
+	// Version is the checksum of the per-tenant overrides generated by the operator.
+	//
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// AppliedVersion is the checksum of the per-tenant overrides which are confirmed to be loaded
+	// by all Tempo pods, using the /status/runtime_config endpoint of Tempo.
+	// The loaded overrides can only be verified if the httpEncryption feature gate is disabled.
+	//
+	// +optional
+	AppliedVersion string `json:"appliedVersion,omitempty"`
+
+	// UserConfigurable shows the user-configurable and the effective overrides of every tenant
+	// which has user-configurable overrides configured, as returned by Tempo.
+	// The user-configurable overrides can only be read if the httpEncryption feature gate is disabled.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=tenant
+	UserConfigurable []UserConfigurableOverridesStatus `json:"userConfigurable,omitempty"`
+}
+
+// UserConfigurableOverridesStatus shows the user-configurable overrides of a tenant.
+type UserConfigurableOverridesStatus struct {
+	// Tenant is the name of the tenant.
+	Tenant string `json:"tenant"`
+
+	// Overrides are the user-configurable overrides of the tenant.
+	//
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Overrides apiextensionsv1.JSON `json:"overrides,omitempty"`
+
+	// Effective are the effective overrides of the tenant, i.e. the runtime overrides loaded by Tempo
+	// merged with the user-configurable overrides, which take precedence.
+	//
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Effective apiextensionsv1.JSON `json:"effective,omitempty"`
 }
 
 // ConditionStatus defines the status of a condition (e.g. ready, failed, pending or configuration error).
@@ -454,6 +620,8 @@ const (
 	ReasonFailedReconciliation ConditionReason = "FailedReconciliation"
 	// ReasonFailedUpgrade when the operator failed to upgrade an instance.
 	ReasonFailedUpgrade ConditionReason = "FailedUpgrade"
+	// ReasonFieldManagerConflict when the operator cannot apply a managed object because another field manager owns some of its fields.
+	ReasonFieldManagerConflict ConditionReason = "FieldManagerConflict"
 )
 
 // Resources defines resources configuration.
@@ -741,6 +909,24 @@ type TempoComponentSpec struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="PodSecurityContext"
 	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+
+	// ExtraConfig defines extra Tempo configuration of this component, which will be merged with the operator's
+	// generated configuration and spec.extraConfig. Configuration defined here has precedence.
+	// Only the pods of this component are restarted when this configuration changes.
+	// Not supported for the gateway.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Extra Configurations"
+	ExtraConfig *ExtraConfigSpec `json:"extraConfig,omitempty"`
+
+	// ExtraArgs defines additional command line arguments of the main container of this component.
+	// The arguments are appended to the arguments generated by the operator, therefore they take precedence.
+	//
+	// +optional
+	// +listType=atomic
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Extra Arguments"
+	ExtraArgs []string `json:"extraArgs,omitempty"`
 }
 
 // TempoGatewaySpec extends TempoComponentSpec with gateway parameters.
@@ -764,12 +950,159 @@ type TempoGatewaySpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Gateway Ingress Settings"
 	Ingress IngressSpec `json:"ingress,omitempty"`
 
+	// TLS defines the TLS configuration of the public gateway endpoints.
+	// TLS is required if a tenant uses mTLS authentication, because the gateway must verify the client certificates.
+	// The CA ConfigMap is used by the gateway health checks.
+	//
+	// If openshift feature flag `servingCertsService` is enabled and TLS is enabled but no
+	// certName is specified, OpenShift service serving certificates will be used.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS"
+	TLS TLSSpec `json:"tls,omitempty"`
+
 	// RBAC defines query RBAC options.
 	//
 	// +optional
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Query RBAC Settings"
 	RBAC RBACSpec `json:"rbac,omitempty"`
+
+	// RateLimits defines request rate and concurrency limits of the gateway.
+	// The limits only apply to the HTTP endpoints of the gateway, OTLP/gRPC requests are not limited by the gateway.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rate Limits"
+	RateLimits *GatewayRateLimitsSpec `json:"rateLimits,omitempty"`
+}
+
+// GatewayRateLimitsSpec defines request rate and concurrency limits of the gateway.
+type GatewayRateLimitsSpec struct {
+	// Global defines the request rate limits of every tenant without per-tenant limits.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Global Rate Limits"
+	Global GatewayRequestRateLimitsSpec `json:"global,omitempty"`
+
+	// PerTenant defines the request rate limits of a tenant, keyed by the tenant name.
+	// The limits of a tenant take precedence over the global limits.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Per Tenant Rate Limits"
+	PerTenant map[string]GatewayRequestRateLimitsSpec `json:"perTenant,omitempty"`
+
+	// Concurrency limits the number of concurrently processed requests of a gateway replica.
+	// The limit applies to the requests of all tenants, the gateway does not support per-tenant concurrency limits.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Concurrency Limit"
+	Concurrency *GatewayConcurrencyLimitSpec `json:"concurrency,omitempty"`
+
+	// Service deploys a rate limit service which shares the request rate limits between the gateway replicas.
+	// Without the service, every gateway replica enforces the request rate limits independently.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rate Limit Service"
+	Service GatewayRateLimitServiceSpec `json:"service,omitempty"`
+}
+
+// GatewayRequestRateLimitsSpec defines the request rate limits of the read and write paths.
+type GatewayRequestRateLimitsSpec struct {
+	// Write limits the requests which write traces using OTLP/HTTP.
+	// Requests using OTLP/gRPC are not rate limited by the gateway.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Write"
+	Write *RequestRateLimitSpec `json:"write,omitempty"`
+
+	// Read limits the requests which query traces.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Read"
+	Read *RequestRateLimitSpec `json:"read,omitempty"`
+}
+
+// RequestRateLimitSpec defines a request rate limit.
+type RequestRateLimitSpec struct {
+	// Requests is the maximum number of requests in a window.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Requests",xDescriptors="urn:alm:descriptor:com.tectonic.ui:number"
+	Requests int `json:"requests"`
+
+	// Window is the time window of the limit. Defaults to one second.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Window"
+	Window metav1.Duration `json:"window,omitempty"`
+
+	// FailOpen allows the requests if the rate limit service is not available.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fail Open",xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	FailOpen bool `json:"failOpen,omitempty"`
+}
+
+// GatewayConcurrencyLimitSpec defines the concurrency limit of the gateway.
+type GatewayConcurrencyLimitSpec struct {
+	// MaxRequests is the maximum number of concurrently processed requests.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Requests",xDescriptors="urn:alm:descriptor:com.tectonic.ui:number"
+	MaxRequests int `json:"maxRequests"`
+
+	// Backlog is the number of requests which wait for processing if the limit is reached.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Backlog",xDescriptors="urn:alm:descriptor:com.tectonic.ui:number"
+	Backlog int `json:"backlog,omitempty"`
+
+	// BacklogDuration is the maximum time a request waits in the backlog.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Backlog Duration"
+	BacklogDuration metav1.Duration `json:"backlogDuration,omitempty"`
+}
+
+// GatewayRateLimitServiceSpec defines the rate limit service of the gateway.
+type GatewayRateLimitServiceSpec struct {
+	// Enabled defines if the rate limit service should be created.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enabled",xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Replicas defines the number of replicas of the rate limit service.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replicas",xDescriptors="urn:alm:descriptor:com.tectonic.ui:podCount"
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Resources defines the compute resource requirements of the rate limit service.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resources",xDescriptors="urn:alm:descriptor:com.tectonic.ui:resourceRequirements"
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 // RBACSpec defines RBAC options.
@@ -0,0 +1,142 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TempoTenantSpec defines the desired state of TempoTenant.
+type TempoTenantSpec struct {
+	// TenantName defines a human readable, unique name of the tenant.
+	// The value of this field must be specified in the X-Scope-OrgID header to identify the tenant.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tenant Name"
+	TenantName string `json:"tenantName"`
+
+	// TenantID defines a universally unique identifier of the tenant.
+	// Unlike the tenantName, which must be unique at a given time, the tenantId must be unique over the entire lifetime of the Tempo deployment.
+	// Tempo uses this ID to prefix objects in the object storage.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tenant ID"
+	TenantID string `json:"tenantId"`
+
+	// OIDC defines the spec for the OIDC tenant's authentication.
+	// The secret must exist in the namespace of the TempoTenant.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OIDC Configuration"
+	OIDC OIDCSpec `json:"oidc"`
+
+	// Authorization defines the roles and role bindings of the tenant.
+	// The roles only grant permissions on this tenant.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authorization"
+	Authorization *TempoTenantAuthorizationSpec `json:"authorization,omitempty"`
+
+	// Limits defines the rate limits of the tenant.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rate Limits"
+	Limits *RateLimitSpec `json:"limits,omitempty"`
+
+	// Retention defines the retention of the traces of the tenant.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Retention"
+	Retention *RetentionConfig `json:"retention,omitempty"`
+}
+
+// TempoTenantAuthorizationSpec defines the roles and role bindings of a TempoTenant.
+type TempoTenantAuthorizationSpec struct {
+	// Roles defines a set of permissions to interact with the tenant.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Roles"
+	Roles []TempoTenantRoleSpec `json:"roles,omitempty"`
+
+	// RoleBindings defines configuration to bind a set of roles to a set of subjects.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Role Bindings"
+	RoleBindings []RoleBindingsSpec `json:"roleBindings,omitempty"`
+}
+
+// TempoTenantRoleSpec describes a set of permissions to interact with the tenant.
+type TempoTenantRoleSpec struct {
+	Name        string           `json:"name"`
+	Resources   []string         `json:"resources"`
+	Permissions []PermissionType `json:"permissions"`
+}
+
+// TempoTenantConditionType is a valid value for Condition.Type of a TempoTenant.
+type TempoTenantConditionType string
+
+const (
+	// ConditionAccepted defines that the tenant is added to at least one TempoStack.
+	ConditionAccepted TempoTenantConditionType = "Accepted"
+)
+
+const (
+	// ReasonTenantAccepted when the tenant is added to all TempoStacks selecting it.
+	ReasonTenantAccepted ConditionReason = "TenantAccepted"
+	// ReasonTenantNotSelected when no TempoStack selects the tenant.
+	ReasonTenantNotSelected ConditionReason = "TenantNotSelected"
+	// ReasonTenantConflict when the tenant name or ID is already in use by another tenant of a TempoStack.
+	ReasonTenantConflict ConditionReason = "TenantConflict"
+)
+
+// TempoTenantStatus defines the observed state of TempoTenant.
+type TempoTenantStatus struct {
+	// TempoStacks lists the TempoStacks (in the format namespace/name) which serve the tenant.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="TempoStacks"
+	TempoStacks []string `json:"tempoStacks,omitempty"`
+
+	// Conditions of the TempoTenant.
+	//
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,xDescriptors="urn:alm:descriptor:io.kubernetes.conditions"
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Tenant",type="string",JSONPath=".spec.tenantName"
+//+kubebuilder:printcolumn:name="Accepted",type="string",JSONPath=`.status.conditions[?(@.type=="Accepted")].status`
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// TempoTenant defines a tenant of a multi-tenant TempoStack.
+// TempoStacks in static mode add the TempoTenants selected by spec.tenants.tempoTenantSelector to their tenants.
+//
+// +operator-sdk:csv:customresourcedefinitions:displayName="TempoTenant"
+//
+//nolint:godot
+type TempoTenant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TempoTenantSpec   `json:"spec,omitempty"`
+	Status TempoTenantStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TempoTenantList contains a list of TempoTenant.
+type TempoTenantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TempoTenant `json:"items"`
+}
@@ -5,11 +5,74 @@
 package v1alpha1
 
 import (
-	"k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleOverrideSpec) DeepCopyInto(out *AlertRuleOverrideSpec) {
+	*out = *in
+	if in.For != nil {
+		in, out := &in.For, &out.For
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ExtraLabels != nil {
+		in, out := &in.ExtraLabels, &out.ExtraLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExtraAnnotations != nil {
+		in, out := &in.ExtraAnnotations, &out.ExtraAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleOverrideSpec.
+func (in *AlertRuleOverrideSpec) DeepCopy() *AlertRuleOverrideSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleOverrideSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsSpec) DeepCopyInto(out *AlertsSpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AlertRuleOverrideSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CustomRules != nil {
+		in, out := &in.CustomRules, &out.CustomRules
+		*out = make([]CustomAlertRuleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsSpec.
+func (in *AlertsSpec) DeepCopy() *AlertsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
 	*out = *in
@@ -18,6 +81,11 @@ func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
 		*out = new(OIDCSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MTLS != nil {
+		in, out := &in.MTLS, &out.MTLS
+		*out = new(MTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationSpec.
@@ -47,6 +115,11 @@ func (in *AuthorizationSpec) DeepCopyInto(out *AuthorizationSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OPA != nil {
+		in, out := &in.OPA, &out.OPA
+		*out = new(OPAPolicySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationSpec.
@@ -59,6 +132,36 @@ func (in *AuthorizationSpec) DeepCopy() *AuthorizationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompactionTuningSpec) DeepCopyInto(out *CompactionTuningSpec) {
+	*out = *in
+	if in.CompactionWindow != nil {
+		in, out := &in.CompactionWindow, &out.CompactionWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxCompactionObjects != nil {
+		in, out := &in.MaxCompactionObjects, &out.MaxCompactionObjects
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxBlockBytes != nil {
+		in, out := &in.MaxBlockBytes, &out.MaxBlockBytes
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompactionTuningSpec.
+func (in *CompactionTuningSpec) DeepCopy() *CompactionTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CompactionTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
 	*out = *in
@@ -186,6 +289,40 @@ func (in *ComponentStatus) DeepCopy() *ComponentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomAlertRuleSpec) DeepCopyInto(out *CustomAlertRuleSpec) {
+	*out = *in
+	if in.For != nil {
+		in, out := &in.For, &out.For
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAlertRuleSpec.
+func (in *CustomAlertRuleSpec) DeepCopy() *CustomAlertRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomAlertRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtraConfigSpec) DeepCopyInto(out *ExtraConfigSpec) {
 	*out = *in
@@ -202,10 +339,116 @@ func (in *ExtraConfigSpec) DeepCopy() *ExtraConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayConcurrencyLimitSpec) DeepCopyInto(out *GatewayConcurrencyLimitSpec) {
+	*out = *in
+	out.BacklogDuration = in.BacklogDuration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConcurrencyLimitSpec.
+func (in *GatewayConcurrencyLimitSpec) DeepCopy() *GatewayConcurrencyLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayConcurrencyLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayRateLimitServiceSpec) DeepCopyInto(out *GatewayRateLimitServiceSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRateLimitServiceSpec.
+func (in *GatewayRateLimitServiceSpec) DeepCopy() *GatewayRateLimitServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayRateLimitServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayRateLimitsSpec) DeepCopyInto(out *GatewayRateLimitsSpec) {
+	*out = *in
+	in.Global.DeepCopyInto(&out.Global)
+	if in.PerTenant != nil {
+		in, out := &in.PerTenant, &out.PerTenant
+		*out = make(map[string]GatewayRequestRateLimitsSpec, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(GatewayConcurrencyLimitSpec)
+		**out = **in
+	}
+	in.Service.DeepCopyInto(&out.Service)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRateLimitsSpec.
+func (in *GatewayRateLimitsSpec) DeepCopy() *GatewayRateLimitsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayRateLimitsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayRequestRateLimitsSpec) DeepCopyInto(out *GatewayRequestRateLimitsSpec) {
+	*out = *in
+	if in.Write != nil {
+		in, out := &in.Write, &out.Write
+		*out = new(RequestRateLimitSpec)
+		**out = **in
+	}
+	if in.Read != nil {
+		in, out := &in.Read, &out.Read
+		*out = new(RequestRateLimitSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRequestRateLimitsSpec.
+func (in *GatewayRequestRateLimitsSpec) DeepCopy() *GatewayRequestRateLimitsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayRequestRateLimitsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GrafanaConfigSpec) DeepCopyInto(out *GrafanaConfigSpec) {
 	*out = *in
 	in.InstanceSelector.DeepCopyInto(&out.InstanceSelector)
+	in.GrafanaDataSourceLinksSpec.DeepCopyInto(&out.GrafanaDataSourceLinksSpec)
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(GrafanaDataSourceAuthenticationSpec)
+		**out = **in
+	}
+	if in.Dashboards != nil {
+		in, out := &in.Dashboards, &out.Dashboards
+		*out = new(GrafanaDashboardsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaConfigSpec.
@@ -218,6 +461,168 @@ func (in *GrafanaConfigSpec) DeepCopy() *GrafanaConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaDashboardsSpec) DeepCopyInto(out *GrafanaDashboardsSpec) {
+	*out = *in
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExtraLabels != nil {
+		in, out := &in.ExtraLabels, &out.ExtraLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDashboardsSpec.
+func (in *GrafanaDashboardsSpec) DeepCopy() *GrafanaDashboardsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaDashboardsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaDataSourceAuthenticationSpec) DeepCopyInto(out *GrafanaDataSourceAuthenticationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDataSourceAuthenticationSpec.
+func (in *GrafanaDataSourceAuthenticationSpec) DeepCopy() *GrafanaDataSourceAuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaDataSourceAuthenticationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaDataSourceLinksSpec) DeepCopyInto(out *GrafanaDataSourceLinksSpec) {
+	*out = *in
+	if in.TracesToLogs != nil {
+		in, out := &in.TracesToLogs, &out.TracesToLogs
+		*out = new(GrafanaTracesToLogsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TracesToMetrics != nil {
+		in, out := &in.TracesToMetrics, &out.TracesToMetrics
+		*out = new(GrafanaTracesToMetricsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceMap != nil {
+		in, out := &in.ServiceMap, &out.ServiceMap
+		*out = new(GrafanaServiceMapSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDataSourceLinksSpec.
+func (in *GrafanaDataSourceLinksSpec) DeepCopy() *GrafanaDataSourceLinksSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaDataSourceLinksSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaMetricsQuery) DeepCopyInto(out *GrafanaMetricsQuery) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaMetricsQuery.
+func (in *GrafanaMetricsQuery) DeepCopy() *GrafanaMetricsQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaMetricsQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaServiceMapSpec) DeepCopyInto(out *GrafanaServiceMapSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaServiceMapSpec.
+func (in *GrafanaServiceMapSpec) DeepCopy() *GrafanaServiceMapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaServiceMapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaTagMapping) DeepCopyInto(out *GrafanaTagMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaTagMapping.
+func (in *GrafanaTagMapping) DeepCopy() *GrafanaTagMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaTagMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaTracesToLogsSpec) DeepCopyInto(out *GrafanaTracesToLogsSpec) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]GrafanaTagMapping, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaTracesToLogsSpec.
+func (in *GrafanaTracesToLogsSpec) DeepCopy() *GrafanaTracesToLogsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaTracesToLogsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaTracesToMetricsSpec) DeepCopyInto(out *GrafanaTracesToMetricsSpec) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]GrafanaTagMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.Queries != nil {
+		in, out := &in.Queries, &out.Queries
+		*out = make([]GrafanaMetricsQuery, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaTracesToMetricsSpec.
+func (in *GrafanaTracesToMetricsSpec) DeepCopy() *GrafanaTracesToMetricsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaTracesToMetricsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HashRingSpec) DeepCopyInto(out *HashRingSpec) {
 	*out = *in
@@ -269,6 +674,36 @@ func (in *IngestionLimitSpec) DeepCopy() *IngestionLimitSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngestionTuningSpec) DeepCopyInto(out *IngestionTuningSpec) {
+	*out = *in
+	if in.MaxBlockDuration != nil {
+		in, out := &in.MaxBlockDuration, &out.MaxBlockDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxBlockBytes != nil {
+		in, out := &in.MaxBlockBytes, &out.MaxBlockBytes
+		*out = new(int)
+		**out = **in
+	}
+	if in.TraceIdlePeriod != nil {
+		in, out := &in.TraceIdlePeriod, &out.TraceIdlePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngestionTuningSpec.
+func (in *IngestionTuningSpec) DeepCopy() *IngestionTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngestionTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressSpec) DeepCopyInto(out *IngressSpec) {
 	*out = *in
@@ -302,9 +737,14 @@ func (in *JaegerQueryAuthenticationSpec) DeepCopyInto(out *JaegerQueryAuthentica
 	*out = *in
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(JaegerUIOIDCSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerQueryAuthenticationSpec.
@@ -344,13 +784,13 @@ func (in *JaegerQuerySpec) DeepCopyInto(out *JaegerQuerySpec) {
 	in.MonitorTab.DeepCopyInto(&out.MonitorTab)
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	in.TempoQuery.DeepCopyInto(&out.TempoQuery)
 	if in.ServicesQueryDuration != nil {
 		in, out := &in.ServicesQueryDuration, &out.ServicesQueryDuration
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Authentication != nil {
@@ -370,6 +810,36 @@ func (in *JaegerQuerySpec) DeepCopy() *JaegerQuerySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerUIOIDCSpec) DeepCopyInto(out *JaegerUIOIDCSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerUIOIDCSpec.
+func (in *JaegerUIOIDCSpec) DeepCopy() *JaegerUIOIDCSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerUIOIDCSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesTenantsSpec) DeepCopyInto(out *KubernetesTenantsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesTenantsSpec.
+func (in *KubernetesTenantsSpec) DeepCopy() *KubernetesTenantsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesTenantsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LimitSpec) DeepCopyInto(out *LimitSpec) {
 	*out = *in
@@ -408,6 +878,26 @@ func (in *MCPServerSpec) DeepCopy() *MCPServerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MTLSSpec) DeepCopyInto(out *MTLSSpec) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MTLSSpec.
+func (in *MTLSSpec) DeepCopy() *MTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemberListSpec) DeepCopyInto(out *MemberListSpec) {
 	*out = *in
@@ -428,6 +918,36 @@ func (in *MemberListSpec) DeepCopy() *MemberListSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricRelabelConfig) DeepCopyInto(out *MetricRelabelConfig) {
+	*out = *in
+	if in.SourceLabels != nil {
+		in, out := &in.SourceLabels, &out.SourceLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Separator != nil {
+		in, out := &in.Separator, &out.Separator
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricRelabelConfig.
+func (in *MetricRelabelConfig) DeepCopy() *MetricRelabelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricRelabelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricsConfigSpec) DeepCopyInto(out *MetricsConfigSpec) {
 	*out = *in
@@ -445,6 +965,12 @@ func (in *MetricsConfigSpec) DeepCopyInto(out *MetricsConfigSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Alerts != nil {
+		in, out := &in.Alerts, &out.Alerts
+		*out = new(AlertsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.MetricsScrapeSpec.DeepCopyInto(&out.MetricsScrapeSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsConfigSpec.
@@ -457,6 +983,43 @@ func (in *MetricsConfigSpec) DeepCopy() *MetricsConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsScrapeSpec) DeepCopyInto(out *MetricsScrapeSpec) {
+	*out = *in
+	if in.ScrapeInterval != nil {
+		in, out := &in.ScrapeInterval, &out.ScrapeInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ScrapeTimeout != nil {
+		in, out := &in.ScrapeTimeout, &out.ScrapeTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SampleLimit != nil {
+		in, out := &in.SampleLimit, &out.SampleLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.MetricRelabelings != nil {
+		in, out := &in.MetricRelabelings, &out.MetricRelabelings
+		*out = make([]MetricRelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsScrapeSpec.
+func (in *MetricsScrapeSpec) DeepCopy() *MetricsScrapeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsScrapeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MonolithicComponentStatus) DeepCopyInto(out *MonolithicComponentStatus) {
 	*out = *in
@@ -627,12 +1190,12 @@ func (in *MonolithicJaegerUISpec) DeepCopyInto(out *MonolithicJaegerUISpec) {
 	*out = *in
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.TempoQueryResources != nil {
 		in, out := &in.TempoQueryResources, &out.TempoQueryResources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Ingress != nil {
@@ -652,7 +1215,7 @@ func (in *MonolithicJaegerUISpec) DeepCopyInto(out *MonolithicJaegerUISpec) {
 	}
 	if in.ServicesQueryDuration != nil {
 		in, out := &in.ServicesQueryDuration, &out.ServicesQueryDuration
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -673,7 +1236,7 @@ func (in *MonolithicMultitenancySpec) DeepCopyInto(out *MonolithicMultitenancySp
 	in.TenantsSpec.DeepCopyInto(&out.TenantsSpec)
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -693,9 +1256,15 @@ func (in *MonolithicObservabilityGrafanaDataSourceSpec) DeepCopyInto(out *Monoli
 	*out = *in
 	if in.InstanceSelector != nil {
 		in, out := &in.InstanceSelector, &out.InstanceSelector
-		*out = new(metav1.LabelSelector)
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	in.GrafanaDataSourceLinksSpec.DeepCopyInto(&out.GrafanaDataSourceLinksSpec)
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(GrafanaDataSourceAuthenticationSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonolithicObservabilityGrafanaDataSourceSpec.
@@ -716,6 +1285,11 @@ func (in *MonolithicObservabilityGrafanaSpec) DeepCopyInto(out *MonolithicObserv
 		*out = new(MonolithicObservabilityGrafanaDataSourceSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Dashboards != nil {
+		in, out := &in.Dashboards, &out.Dashboards
+		*out = new(GrafanaDashboardsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonolithicObservabilityGrafanaSpec.
@@ -738,6 +1312,11 @@ func (in *MonolithicObservabilityMetricsPrometheusRulesSpec) DeepCopyInto(out *M
 			(*out)[key] = val
 		}
 	}
+	if in.Alerts != nil {
+		in, out := &in.Alerts, &out.Alerts
+		*out = new(AlertsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonolithicObservabilityMetricsPrometheusRulesSpec.
@@ -810,6 +1389,11 @@ func (in *MonolithicObservabilitySpec) DeepCopyInto(out *MonolithicObservability
 		*out = new(MonolithicObservabilityGrafanaSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(OTLPTracingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonolithicObservabilitySpec.
@@ -855,14 +1439,14 @@ func (in *MonolithicSchedulerSpec) DeepCopyInto(out *MonolithicSchedulerSpec) {
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+		*out = new(corev1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1009,6 +1593,115 @@ func (in *OIDCSpec) DeepCopy() *OIDCSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OPAPolicySpec) DeepCopyInto(out *OPAPolicySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OPAPolicySpec.
+func (in *OPAPolicySpec) DeepCopy() *OPAPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OPAPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPTracingGatewaySpec) DeepCopyInto(out *OTLPTracingGatewaySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPTracingGatewaySpec.
+func (in *OTLPTracingGatewaySpec) DeepCopy() *OTLPTracingGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPTracingGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPTracingSpec) DeepCopyInto(out *OTLPTracingSpec) {
+	*out = *in
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(OTLPTracingGatewaySpec)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(OTLPTracingTLSSpec)
+		**out = **in
+	}
+	if in.ResourceAttributes != nil {
+		in, out := &in.ResourceAttributes, &out.ResourceAttributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPTracingSpec.
+func (in *OTLPTracingSpec) DeepCopy() *OTLPTracingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPTracingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPTracingTLSSpec) DeepCopyInto(out *OTLPTracingTLSSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPTracingTLSSpec.
+func (in *OTLPTracingTLSSpec) DeepCopy() *OTLPTracingTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPTracingTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectOverrideSpec) DeepCopyInto(out *ObjectOverrideSpec) {
+	*out = *in
+	out.Target = in.Target
+	in.Patch.DeepCopyInto(&out.Patch)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectOverrideSpec.
+func (in *ObjectOverrideSpec) DeepCopy() *ObjectOverrideSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectOverrideSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectOverrideTarget) DeepCopyInto(out *ObjectOverrideTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectOverrideTarget.
+func (in *ObjectOverrideTarget) DeepCopy() *ObjectOverrideTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectOverrideTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectStorageSecretSpec) DeepCopyInto(out *ObjectStorageSecretSpec) {
 	*out = *in
@@ -1045,7 +1738,7 @@ func (in *ObjectStorageSpec) DeepCopy() *ObjectStorageSpec {
 func (in *ObservabilitySpec) DeepCopyInto(out *ObservabilitySpec) {
 	*out = *in
 	in.Metrics.DeepCopyInto(&out.Metrics)
-	out.Tracing = in.Tracing
+	in.Tracing.DeepCopyInto(&out.Tracing)
 	in.Grafana.DeepCopyInto(&out.Grafana)
 }
 
@@ -1105,12 +1798,52 @@ func (in *QueryLimit) DeepCopyInto(out *QueryLimit) {
 	out.MaxSearchDuration = in.MaxSearchDuration
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryLimit.
-func (in *QueryLimit) DeepCopy() *QueryLimit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryLimit.
+func (in *QueryLimit) DeepCopy() *QueryLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryTuningSpec) DeepCopyInto(out *QueryTuningSpec) {
+	*out = *in
+	if in.TraceByIDQueryShards != nil {
+		in, out := &in.TraceByIDQueryShards, &out.TraceByIDQueryShards
+		*out = new(int)
+		**out = **in
+	}
+	if in.SearchConcurrentJobs != nil {
+		in, out := &in.SearchConcurrentJobs, &out.SearchConcurrentJobs
+		*out = new(int)
+		**out = **in
+	}
+	if in.MetricsConcurrentJobs != nil {
+		in, out := &in.MetricsConcurrentJobs, &out.MetricsConcurrentJobs
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxOutstandingPerTenant != nil {
+		in, out := &in.MaxOutstandingPerTenant, &out.MaxOutstandingPerTenant
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxConcurrentQueries != nil {
+		in, out := &in.MaxConcurrentQueries, &out.MaxConcurrentQueries
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryTuningSpec.
+func (in *QueryTuningSpec) DeepCopy() *QueryTuningSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QueryLimit)
+	out := new(QueryTuningSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1147,12 +1880,28 @@ func (in *RateLimitSpec) DeepCopy() *RateLimitSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestRateLimitSpec) DeepCopyInto(out *RequestRateLimitSpec) {
+	*out = *in
+	out.Window = in.Window
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestRateLimitSpec.
+func (in *RequestRateLimitSpec) DeepCopy() *RequestRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resources) DeepCopyInto(out *Resources) {
 	*out = *in
 	if in.Total != nil {
 		in, out := &in.Total, &out.Total
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1349,21 +2098,31 @@ func (in *TempoComponentSpec) DeepCopyInto(out *TempoComponentSpec) {
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PodSecurityContext != nil {
 		in, out := &in.PodSecurityContext, &out.PodSecurityContext
-		*out = new(v1.PodSecurityContext)
+		*out = new(corev1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExtraConfig != nil {
+		in, out := &in.ExtraConfig, &out.ExtraConfig
+		*out = new(ExtraConfigSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TempoComponentSpec.
@@ -1398,7 +2157,13 @@ func (in *TempoGatewaySpec) DeepCopyInto(out *TempoGatewaySpec) {
 	*out = *in
 	in.TempoComponentSpec.DeepCopyInto(&out.TempoComponentSpec)
 	in.Ingress.DeepCopyInto(&out.Ingress)
+	in.TLS.DeepCopyInto(&out.TLS)
 	out.RBAC = in.RBAC
+	if in.RateLimits != nil {
+		in, out := &in.RateLimits, &out.RateLimits
+		*out = new(GatewayRateLimitsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TempoGatewaySpec.
@@ -1526,30 +2291,42 @@ func (in *TempoMonolithicSpec) DeepCopyInto(out *TempoMonolithicSpec) {
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PodSecurityContext != nil {
 		in, out := &in.PodSecurityContext, &out.PodSecurityContext
-		*out = new(v1.PodSecurityContext)
+		*out = new(corev1.PodSecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
 	out.Timeout = in.Timeout
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(TuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ExtraConfig != nil {
 		in, out := &in.ExtraConfig, &out.ExtraConfig
 		*out = new(ExtraConfigSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]ObjectOverrideSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
+		*out = make([]corev1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.EnvFrom != nil {
 		in, out := &in.EnvFrom, &out.EnvFrom
-		*out = make([]v1.EnvFromSource, len(*in))
+		*out = make([]corev1.EnvFromSource, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1578,7 +2355,7 @@ func (in *TempoMonolithicStatus) DeepCopyInto(out *TempoMonolithicStatus) {
 	in.Components.DeepCopyInto(&out.Components)
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1618,7 +2395,7 @@ func (in *TempoQuerySpec) DeepCopyInto(out *TempoQuerySpec) {
 	*out = *in
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1696,6 +2473,11 @@ func (in *TempoStackList) DeepCopyObject() runtime.Object {
 func (in *TempoStackSpec) DeepCopyInto(out *TempoStackSpec) {
 	*out = *in
 	in.LimitSpec.DeepCopyInto(&out.LimitSpec)
+	if in.UserConfigurableOverrides != nil {
+		in, out := &in.UserConfigurableOverrides, &out.UserConfigurableOverrides
+		*out = new(UserConfigurableOverridesSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	out.Timeout = in.Timeout
 	if in.StorageClassName != nil {
 		in, out := &in.StorageClassName, &out.StorageClassName
@@ -1722,21 +2504,33 @@ func (in *TempoStackSpec) DeepCopyInto(out *TempoStackSpec) {
 	}
 	in.Observability.DeepCopyInto(&out.Observability)
 	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(TuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ExtraConfig != nil {
 		in, out := &in.ExtraConfig, &out.ExtraConfig
 		*out = new(ExtraConfigSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]ObjectOverrideSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
+		*out = make([]corev1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.EnvFrom != nil {
 		in, out := &in.EnvFrom, &out.EnvFrom
-		*out = make([]v1.EnvFromSource, len(*in))
+		*out = make([]corev1.EnvFromSource, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1759,11 +2553,16 @@ func (in *TempoStackStatus) DeepCopyInto(out *TempoStackStatus) {
 	in.Components.DeepCopyInto(&out.Components)
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TenantOverrides != nil {
+		in, out := &in.TenantOverrides, &out.TenantOverrides
+		*out = new(TenantOverridesStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TempoStackStatus.
@@ -1798,6 +2597,224 @@ func (in *TempoTemplateSpec) DeepCopy() *TempoTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TempoTenant) DeepCopyInto(out *TempoTenant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TempoTenant.
+func (in *TempoTenant) DeepCopy() *TempoTenant {
+	if in == nil {
+		return nil
+	}
+	out := new(TempoTenant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TempoTenant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TempoTenantAuthorizationSpec) DeepCopyInto(out *TempoTenantAuthorizationSpec) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]TempoTenantRoleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RoleBindings != nil {
+		in, out := &in.RoleBindings, &out.RoleBindings
+		*out = make([]RoleBindingsSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TempoTenantAuthorizationSpec.
+func (in *TempoTenantAuthorizationSpec) DeepCopy() *TempoTenantAuthorizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TempoTenantAuthorizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TempoTenantList) DeepCopyInto(out *TempoTenantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TempoTenant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TempoTenantList.
+func (in *TempoTenantList) DeepCopy() *TempoTenantList {
+	if in == nil {
+		return nil
+	}
+	out := new(TempoTenantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TempoTenantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TempoTenantRoleSpec) DeepCopyInto(out *TempoTenantRoleSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]PermissionType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TempoTenantRoleSpec.
+func (in *TempoTenantRoleSpec) DeepCopy() *TempoTenantRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TempoTenantRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TempoTenantSelectorSpec) DeepCopyInto(out *TempoTenantSelectorSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TempoTenantSelectorSpec.
+func (in *TempoTenantSelectorSpec) DeepCopy() *TempoTenantSelectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TempoTenantSelectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TempoTenantSpec) DeepCopyInto(out *TempoTenantSpec) {
+	*out = *in
+	in.OIDC.DeepCopyInto(&out.OIDC)
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(TempoTenantAuthorizationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(RateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(RetentionConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TempoTenantSpec.
+func (in *TempoTenantSpec) DeepCopy() *TempoTenantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TempoTenantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TempoTenantStatus) DeepCopyInto(out *TempoTenantStatus) {
+	*out = *in
+	if in.TempoStacks != nil {
+		in, out := &in.TempoStacks, &out.TempoStacks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TempoTenantStatus.
+func (in *TempoTenantStatus) DeepCopy() *TempoTenantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TempoTenantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantOverridesStatus) DeepCopyInto(out *TenantOverridesStatus) {
+	*out = *in
+	if in.UserConfigurable != nil {
+		in, out := &in.UserConfigurable, &out.UserConfigurable
+		*out = make([]UserConfigurableOverridesStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantOverridesStatus.
+func (in *TenantOverridesStatus) DeepCopy() *TenantOverridesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantOverridesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TenantSecretSpec) DeepCopyInto(out *TenantSecretSpec) {
 	*out = *in
@@ -1828,6 +2845,16 @@ func (in *TenantsSpec) DeepCopyInto(out *TenantsSpec) {
 		*out = new(AuthorizationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(KubernetesTenantsSpec)
+		**out = **in
+	}
+	if in.TempoTenantSelector != nil {
+		in, out := &in.TempoTenantSelector, &out.TempoTenantSelector
+		*out = new(TempoTenantSelectorSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantsSpec.
@@ -1843,6 +2870,11 @@ func (in *TenantsSpec) DeepCopy() *TenantsSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracingConfigSpec) DeepCopyInto(out *TracingConfigSpec) {
 	*out = *in
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLPTracingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingConfigSpec.
@@ -1855,6 +2887,73 @@ func (in *TracingConfigSpec) DeepCopy() *TracingConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TuningSpec) DeepCopyInto(out *TuningSpec) {
+	*out = *in
+	if in.Compaction != nil {
+		in, out := &in.Compaction, &out.Compaction
+		*out = new(CompactionTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingestion != nil {
+		in, out := &in.Ingestion, &out.Ingestion
+		*out = new(IngestionTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(QueryTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TuningSpec.
+func (in *TuningSpec) DeepCopy() *TuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserConfigurableOverridesSpec) DeepCopyInto(out *UserConfigurableOverridesSpec) {
+	*out = *in
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserConfigurableOverridesSpec.
+func (in *UserConfigurableOverridesSpec) DeepCopy() *UserConfigurableOverridesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserConfigurableOverridesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserConfigurableOverridesStatus) DeepCopyInto(out *UserConfigurableOverridesStatus) {
+	*out = *in
+	in.Overrides.DeepCopyInto(&out.Overrides)
+	in.Effective.DeepCopyInto(&out.Effective)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserConfigurableOverridesStatus.
+func (in *UserConfigurableOverridesStatus) DeepCopy() *UserConfigurableOverridesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserConfigurableOverridesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ZoneSpec) DeepCopyInto(out *ZoneSpec) {
 	*out = *in
@@ -46,9 +46,9 @@ func TestBuild(t *testing.T) {
 
 	objects, err := build(params)
 	require.NoError(t, err)
-	// 14 base objects + 8 network policies (gossip, metrics, DNS, distributor, ingester, compactor, querier, query-frontend)
+	// 15 base objects + 9 network policies (gossip, metrics, operator, DNS, distributor, ingester, compactor, querier, query-frontend)
 	// + 4 pod disruption budgets (distributor, ingester, querier, query-frontend; no PDB for the compactor)
-	require.Equal(t, 26, len(objects))
+	require.Equal(t, 28, len(objects))
 }
 
 func TestYAMLEncoding(t *testing.T) {
@@ -123,6 +123,60 @@ metadata:
 `)
 }
 
+func TestGenerateCmdOverrides(t *testing.T) {
+	c := root.NewRootCommand()
+	c.AddCommand(NewGenerateCommand())
+
+	cr := `
+apiVersion: tempo.grafana.com/v1alpha1
+kind: TempoStack
+metadata:
+  name: simplest
+spec:
+  images:
+    tempo: docker.io/grafana/tempo:x.y.z
+    tempoQuery: docker.io/grafana/tempo-query:x.y.z
+    tempoGateway: quay.io/observatorium/api
+    tempoGatewayOpa: quay.io/observatorium/opa-openshift
+  storage:
+    secret:
+      name: minio-test
+      type: s3
+  storageSize: 1Gi
+  overrides:
+  - target:
+      kind: Service
+      name: tempo-simplest-distributor
+    patch:
+      metadata:
+        annotations:
+          service.beta.kubernetes.io/aws-load-balancer-internal: "true"
+`
+	c.SetIn(strings.NewReader(cr))
+
+	out := &strings.Builder{}
+	c.SetOut(out)
+	c.SetErr(out)
+
+	c.SetArgs([]string{"generate"})
+	_, err := c.ExecuteC()
+	require.NoError(t, err)
+
+	require.Contains(t, out.String(), `
+apiVersion: v1
+kind: Service
+metadata:
+  annotations:
+    service.beta.kubernetes.io/aws-load-balancer-internal: "true"
+  labels:
+    app.kubernetes.io/component: distributor
+    app.kubernetes.io/instance: simplest
+    app.kubernetes.io/managed-by: tempo-operator
+    app.kubernetes.io/name: tempo
+  name: tempo-simplest-distributor
+`)
+}
+
 func TestGenerateCmdReadFromFile(t *testing.T) {
 	c := root.NewRootCommand()
 	c.AddCommand(NewGenerateCommand())
@@ -0,0 +1,156 @@
+package root
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+const (
+	benchmarkUnmanagedSecrets = 5000
+	benchmarkManagedSecrets   = 50
+	benchmarkSecretDataSize   = 4096
+)
+
+// generateSecrets returns the Secrets of a large cluster: many Secrets of other applications
+// and a few Secrets created by the operator.
+func generateSecrets() []corev1.Secret {
+	secrets := make([]corev1.Secret, 0, benchmarkUnmanagedSecrets+benchmarkManagedSecrets)
+	data := make([]byte, benchmarkSecretDataSize)
+
+	for i := range benchmarkUnmanagedSecrets {
+		secrets = append(secrets, corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            fmt.Sprintf("secret-%d", i),
+				Namespace:       fmt.Sprintf("namespace-%d", i%100),
+				ResourceVersion: "1",
+			},
+			Data: map[string][]byte{"tls.crt": data},
+		})
+	}
+	for i := range benchmarkManagedSecrets {
+		secrets = append(secrets, corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            fmt.Sprintf("tempo-%d-gateway", i),
+				Namespace:       fmt.Sprintf("namespace-%d", i%100),
+				ResourceVersion: "1",
+				Labels:          manifestutils.CommonLabels(fmt.Sprintf("tempo-%d", i)),
+			},
+			Data: map[string][]byte{"tls.crt": data},
+		})
+	}
+	return secrets
+}
+
+// listWatch lists the objects once and never sends watch events.
+// The streaming list of the reflector is disabled, because the fake watch does not send the initial events.
+type listWatch struct {
+	*toolscache.ListWatch
+}
+
+func (listWatch) IsWatchListSemanticsUnSupported() bool {
+	return true
+}
+
+// heapAfterSync starts an informer with the given list of objects, waits until the cache is synced
+// and returns the heap memory retained by the informer.
+func heapAfterSync(b *testing.B, objType k8sruntime.Object, list func() k8sruntime.Object) uint64 {
+	b.Helper()
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	informer := toolscache.NewSharedIndexInformer(listWatch{&toolscache.ListWatch{
+		ListWithContextFunc: func(_ context.Context, _ metav1.ListOptions) (k8sruntime.Object, error) {
+			return list(), nil
+		},
+		WatchFuncWithContext: func(_ context.Context, _ metav1.ListOptions) (watch.Interface, error) {
+			return watch.NewFake(), nil
+		},
+	}}, objType, 0, toolscache.Indexers{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go informer.RunWithContext(ctx)
+	if !toolscache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		b.Fatal("informer did not sync")
+	}
+
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(informer)
+	cancel()
+
+	if after.HeapAlloc < before.HeapAlloc {
+		return 0
+	}
+	return after.HeapAlloc - before.HeapAlloc
+}
+
+// BenchmarkSecretCacheMemory compares the memory of the Secret informers of the operator
+// without and with the cache restrictions of setCacheConfig.
+//
+// Run with: go test ./cmd/root -run '^$' -bench BenchmarkSecretCacheMemory
+func BenchmarkSecretCacheMemory(b *testing.B) {
+	secrets := generateSecrets()
+
+	b.Run("all secrets", func(b *testing.B) {
+		var retained uint64
+		for b.Loop() {
+			retained += heapAfterSync(b, &corev1.Secret{}, func() k8sruntime.Object {
+				// Deep copy the Secrets, the API server sends a new copy of each object.
+				list := &corev1.SecretList{Items: make([]corev1.Secret, 0, len(secrets))}
+				for _, secret := range secrets {
+					list.Items = append(list.Items, *secret.DeepCopy())
+				}
+				return list
+			})
+		}
+		b.ReportMetric(float64(retained)/float64(b.N)/1024/1024, "MiB/cache")
+	})
+
+	b.Run("managed secrets and metadata of all secrets", func(b *testing.B) {
+		options := setCacheConfig(ctrl.Options{}, &configv1alpha1.ProjectConfig{})
+		var selector labels.Selector
+		for obj, byObject := range options.Cache.ByObject {
+			if _, ok := obj.(*corev1.Secret); ok {
+				selector = byObject.Label
+			}
+		}
+
+		var retained uint64
+		for b.Loop() {
+			// The label-restricted cache of the manager.
+			retained += heapAfterSync(b, &corev1.Secret{}, func() k8sruntime.Object {
+				list := &corev1.SecretList{}
+				for _, secret := range secrets {
+					if selector.Matches(labels.Set(secret.Labels)) {
+						list.Items = append(list.Items, *secret.DeepCopy())
+					}
+				}
+				return list
+			})
+			// The metadata-only cache of the referenced Secrets.
+			retained += heapAfterSync(b, &metav1.PartialObjectMetadata{}, func() k8sruntime.Object {
+				list := &metav1.PartialObjectMetadataList{Items: make([]metav1.PartialObjectMetadata, 0, len(secrets))}
+				for _, secret := range secrets {
+					list.Items = append(list.Items, metav1.PartialObjectMetadata{ObjectMeta: *secret.ObjectMeta.DeepCopy()})
+				}
+				return list
+			})
+		}
+		b.ReportMetric(float64(retained)/float64(b.N)/1024/1024, "MiB/cache")
+	})
+}
@@ -11,10 +11,13 @@ import (
 
 	configv1 "github.com/openshift/api/config/v1"
 	openshifttls "github.com/openshift/controller-runtime-common/pkg/tls"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -22,6 +25,7 @@ import (
 
 	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/envconfig"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/tlsprofile"
 )
 
@@ -76,6 +80,7 @@ func LoadConfig(scheme *runtime.Scheme, configFile string) (*configv1alpha1.Proj
 
 func mergeOptionsFromFile(o manager.Options, cfg *configv1alpha1.ProjectConfig) (manager.Options, configv1.TLSProfileSpec, error) {
 	o = setLeaderElectionConfig(o, cfg.ControllerManagerConfigurationSpec)
+	o = setCacheConfig(o, cfg)
 
 	if o.Metrics.BindAddress == "" && cfg.Metrics.BindAddress != "" {
 		o.Metrics.BindAddress = cfg.Metrics.BindAddress
@@ -145,6 +150,40 @@ func mergeOptionsFromFile(o manager.Options, cfg *configv1alpha1.ProjectConfig)
 	return o, tlsProfileSpec, nil
 }
 
+// setCacheConfig restricts the cache of the manager to the watched namespaces and
+// the Secrets and ConfigMaps created by the operator.
+// Cluster-scoped objects are not affected by the namespace restriction of the cache.
+//
+// Caching every Secret and ConfigMap of the cluster dominates the memory usage of the operator on large clusters.
+// Therefore, only the Secrets and ConfigMaps with the managed-by label of the operator are cached
+// (to watch the owned objects), and all reads of Secrets and ConfigMaps go directly to the API server,
+// as the referenced Secrets and ConfigMaps (e.g. the storage secret) are not labelled by the operator.
+// Changes of the referenced Secrets are watched with metadata-only informers, see controllers.NewMetadataCache.
+func setCacheConfig(o manager.Options, cfg *configv1alpha1.ProjectConfig) manager.Options {
+	if cfg.IsNamespaceScoped() && len(o.Cache.DefaultNamespaces) == 0 {
+		o.Cache.DefaultNamespaces = make(map[string]cache.Config, len(cfg.WatchNamespaces))
+		for _, namespace := range cfg.WatchNamespaces {
+			o.Cache.DefaultNamespaces[namespace] = cache.Config{}
+		}
+	}
+
+	if o.Cache.ByObject == nil {
+		managedBySelector := labels.SelectorFromSet(manifestutils.ManagedByLabels())
+		o.Cache.ByObject = map[client.Object]cache.ByObject{
+			&corev1.Secret{}:    {Label: managedBySelector},
+			&corev1.ConfigMap{}: {Label: managedBySelector},
+		}
+	}
+
+	if o.Client.Cache == nil {
+		o.Client.Cache = &client.CacheOptions{
+			DisableFor: []client.Object{&corev1.Secret{}, &corev1.ConfigMap{}},
+		}
+	}
+
+	return o
+}
+
 func setLeaderElectionConfig(o manager.Options, obj configv1alpha1.ControllerManagerConfigurationSpec) manager.Options {
 	if obj.LeaderElection == nil {
 		// The source does not have any configuration; noop
@@ -7,6 +7,10 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
 )
@@ -43,6 +47,14 @@ func TestReadConfig(t *testing.T) {
 				assert.True(t, cfg.Gates.HTTPEncryption)
 			},
 		},
+		{
+			name:  "watchNamespaces given, operator is namespace-scoped",
+			input: "../testdata/watch_namespaces.yaml",
+			modifyCheck: func(t *testing.T, cfg configv1alpha1.ProjectConfig) {
+				assert.Equal(t, []string{"team-a", "team-b"}, cfg.WatchNamespaces)
+				assert.True(t, cfg.IsNamespaceScoped())
+			},
+		},
 		{
 			name:  "invalid featureGates.tlsProfile given, show error",
 			input: "../testdata/tlsprofile_invalid.yaml",
@@ -68,3 +80,42 @@ func TestReadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestSetCacheConfig(t *testing.T) {
+	tests := []struct {
+		name               string
+		cfg                configv1alpha1.ProjectConfig
+		expectedNamespaces map[string]cache.Config
+	}{
+		{
+			name: "cluster-scoped operator",
+			cfg:  configv1alpha1.ProjectConfig{},
+		},
+		{
+			name: "namespace-scoped operator",
+			cfg: configv1alpha1.ProjectConfig{
+				WatchNamespaces: []string{"team-a", "team-b"},
+			},
+			expectedNamespaces: map[string]cache.Config{
+				"team-a": {},
+				"team-b": {},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := setCacheConfig(ctrl.Options{}, &test.cfg)
+			assert.Equal(t, test.expectedNamespaces, options.Cache.DefaultNamespaces)
+
+			cachedObjects := []client.Object{}
+			for obj, byObject := range options.Cache.ByObject {
+				cachedObjects = append(cachedObjects, obj)
+				assert.Equal(t, "app.kubernetes.io/managed-by=tempo-operator", byObject.Label.String())
+				assert.Nil(t, byObject.Namespaces)
+			}
+			assert.ElementsMatch(t, []client.Object{&corev1.Secret{}, &corev1.ConfigMap{}}, cachedObjects)
+			assert.ElementsMatch(t, []client.Object{&corev1.Secret{}, &corev1.ConfigMap{}}, options.Client.Cache.DisableFor)
+		})
+	}
+}
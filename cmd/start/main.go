@@ -21,6 +21,8 @@ import (
 	"github.com/grafana/tempo-operator/cmd/root"
 	controllers "github.com/grafana/tempo-operator/internal/controller/tempo"
 	"github.com/grafana/tempo-operator/internal/crdmetrics"
+	"github.com/grafana/tempo-operator/internal/eventrecorder"
+	"github.com/grafana/tempo-operator/internal/tracing"
 	"github.com/grafana/tempo-operator/internal/version"
 	"github.com/grafana/tempo-operator/internal/webhooks"
 	//+kubebuilder:scaffold:imports
@@ -57,10 +59,15 @@ func start(c *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 
+	// The Events of each instance are rate-limited to avoid flooding etcd.
+	tempoStackRecorder := eventrecorder.NewRateLimited(mgr.GetEventRecorder("tempostack-controller"), eventrecorder.DefaultInterval, eventrecorder.DefaultBurst)
+	tempoMonolithicRecorder := eventrecorder.NewRateLimited(mgr.GetEventRecorder("tempomonolithic-controller"), eventrecorder.DefaultInterval, eventrecorder.DefaultBurst)
+
 	if ctrlConfig.Gates.BuiltInCertManagement.Enabled {
 		if err = (&controllers.CertRotationReconciler{
 			Client:       mgr.GetClient(),
 			Scheme:       mgr.GetScheme(),
+			Recorder:     tempoStackRecorder,
 			FeatureGates: ctrlConfig.Gates,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "certrotation")
@@ -70,6 +77,7 @@ func start(c *cobra.Command, args []string) {
 		if err = (&controllers.CertRotationMonolithicReconciler{
 			Client:       mgr.GetClient(),
 			Scheme:       mgr.GetScheme(),
+			Recorder:     tempoMonolithicRecorder,
 			FeatureGates: ctrlConfig.Gates,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "certrotationmonolithic")
@@ -77,28 +85,43 @@ func start(c *cobra.Command, args []string) {
 		}
 	}
 
+	metadataCache, err := controllers.NewMetadataCache(mgr, ctrlConfig)
+	if err != nil {
+		setupLog.Error(err, "unable to create metadata cache")
+		os.Exit(1)
+	}
+
 	if err = (&controllers.TempoStackReconciler{
-		Client:     mgr.GetClient(),
-		Scheme:     mgr.GetScheme(),
-		Recorder:   mgr.GetEventRecorder("tempostack-controller"),
-		CtrlConfig: ctrlConfig,
-		Version:    version,
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Recorder:      tempoStackRecorder,
+		CtrlConfig:    ctrlConfig,
+		Version:       version,
+		MetadataCache: metadataCache,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "TempoStack")
 		os.Exit(1)
 	}
 
 	if err = (&controllers.TempoMonolithicReconciler{
-		Client:     mgr.GetClient(),
-		Scheme:     mgr.GetScheme(),
-		Recorder:   mgr.GetEventRecorder("tempomonolithic-controller"),
-		CtrlConfig: ctrlConfig,
-		Version:    version,
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Recorder:      tempoMonolithicRecorder,
+		CtrlConfig:    ctrlConfig,
+		Version:       version,
+		MetadataCache: metadataCache,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "TempoMonolithic")
 		os.Exit(1)
 	}
 
+	if err = (&controllers.TempoTenantReconciler{
+		Client: mgr.GetClient(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TempoTenant")
+		os.Exit(1)
+	}
+
 	if err = (&controllers.TempoStackZoneAwarePodReconciler{
 		Client: mgr.GetClient(),
 	}).SetupWithManager(mgr); err != nil {
@@ -164,17 +187,33 @@ func start(c *cobra.Command, args []string) {
 		"default-tempo-query-image", rootCmdConfig.CtrlConfig.DefaultImages.TempoQuery,
 		"default-tempo-gateway-image", rootCmdConfig.CtrlConfig.DefaultImages.TempoGateway,
 		"default-tempo-gateway-opa-image", rootCmdConfig.CtrlConfig.DefaultImages.TempoGatewayOpa,
+		"default-opa-image", rootCmdConfig.CtrlConfig.DefaultImages.OPA,
+		"default-gubernator-image", rootCmdConfig.CtrlConfig.DefaultImages.Gubernator,
+		"default-kube-rbac-proxy-image", rootCmdConfig.CtrlConfig.DefaultImages.KubeRBACProxy,
+		"default-oauth2-proxy-image", rootCmdConfig.CtrlConfig.DefaultImages.OAuth2Proxy,
 		"default-network-policies", ctrlConfig.Gates.NetworkPolicies,
+		"watch-namespaces", ctrlConfig.WatchNamespaces,
 		"go-version", version.GoVersion,
 		"go-arch", runtime.GOARCH,
 		"go-os", runtime.GOOS,
 	)
 
-	if err := crdmetrics.Bootstrap(mgr.GetClient()); err != nil {
+	if err := crdmetrics.Bootstrap(mgr.GetClient(), ctrlConfig); err != nil {
 		setupLog.Error(err, "problem init crd metrics")
 		os.Exit(1)
 	}
 
+	shutdownTracing, err := tracing.Bootstrap(ctx, ctrlConfig.Gates.Observability.Tracing, version.OperatorVersion)
+	if err != nil {
+		setupLog.Error(err, "problem init tracing")
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLog.Error(err, "problem shutting down tracing")
+		}
+	}()
+
 	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
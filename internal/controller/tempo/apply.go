@@ -0,0 +1,135 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/grafana/tempo-operator/internal/eventrecorder"
+	"github.com/grafana/tempo-operator/internal/manifests"
+	"github.com/grafana/tempo-operator/internal/status"
+)
+
+// fieldManager is the field manager of the objects applied with server-side apply.
+const fieldManager = "tempo-operator"
+
+// clientSideFieldManagers are the field managers of the objects created or updated with client-side create or update.
+// client-go derives the field manager from the name of the binary, which is called manager in the operator image.
+var clientSideFieldManagers = sets.New("manager")
+
+// applyManagedObject creates or updates the object with server-side apply.
+//
+// The operator owns only the fields set in the desired object, therefore fields removed from
+// the desired object are removed from the cluster, and fields set by other field managers are kept.
+// Apply requests without any change are no-ops, i.e. the resourceVersion of the object does not change
+// and no watch events are sent.
+// The fields changed by an update are returned for the Event of the update.
+func applyManagedObject(ctx context.Context, k8sclient client.Client, scheme *runtime.Scheme, obj client.Object) (controllerutil.OperationResult, []string, error) {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return controllerutil.OperationResultNone, nil, err
+	}
+
+	existing := obj.DeepCopyObject().(client.Object)
+	err = k8sclient.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return controllerutil.OperationResultNone, nil, err
+	}
+	exists := err == nil
+
+	if exists {
+		if err := manifests.CheckImmutableFields(existing, obj); err != nil {
+			return controllerutil.OperationResultNone, nil, err
+		}
+
+		// Transfer the ownership of the fields set by client-side updates of earlier operator versions,
+		// otherwise the fields removed from the desired object would be kept forever.
+		patch, err := csaupgrade.UpgradeManagedFieldsPatch(existing, clientSideFieldManagers, fieldManager)
+		if err != nil {
+			return controllerutil.OperationResultNone, nil, err
+		}
+		if patch != nil {
+			if err := k8sclient.Patch(ctx, existing, client.RawPatch(types.JSONPatchType, patch)); err != nil {
+				return controllerutil.OperationResultNone, nil, fmt.Errorf("failed to upgrade managed fields: %w", err)
+			}
+		}
+	}
+
+	desired, err := toApplyConfiguration(obj, gvk.GroupVersion().String(), gvk.Kind)
+	if err != nil {
+		return controllerutil.OperationResultNone, nil, err
+	}
+
+	err = k8sclient.Apply(ctx, client.ApplyConfigurationFromUnstructured(desired), client.FieldOwner(fieldManager))
+	if apierrors.IsConflict(err) {
+		return controllerutil.OperationResultNone, nil, fieldManagerConflictError(gvk.Kind, obj.GetName(), err)
+	}
+	if err != nil {
+		return controllerutil.OperationResultNone, nil, err
+	}
+
+	// Update the object with the response of the API server, e.g. the UID is required for pruning.
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(desired.Object, obj); err != nil {
+		return controllerutil.OperationResultNone, nil, err
+	}
+
+	switch {
+	case !exists:
+		return controllerutil.OperationResultCreated, nil, nil
+	case existing.GetResourceVersion() != obj.GetResourceVersion():
+		changedFields, err := eventrecorder.ChangedFields(existing, obj)
+		return controllerutil.OperationResultUpdated, changedFields, err
+	default:
+		return controllerutil.OperationResultNone, nil, nil
+	}
+}
+
+// toApplyConfiguration converts the desired object to the request body of server-side apply.
+// The status and the read-only metadata fields are not part of the desired state.
+func toApplyConfiguration(obj client.Object, apiVersion string, kind string) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := &unstructured.Unstructured{Object: content}
+	desired.SetAPIVersion(apiVersion)
+	desired.SetKind(kind)
+	desired.SetResourceVersion("")
+	desired.SetManagedFields(nil)
+	unstructured.RemoveNestedField(desired.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(desired.Object, "status")
+	return desired, nil
+}
+
+// fieldManagerConflictError lists the fields of an apply conflict and the field managers owning them.
+func fieldManagerConflictError(kind string, name string, err error) error {
+	conflictErr := &status.FieldManagerConflictError{
+		Kind: kind,
+		Name: name,
+	}
+
+	var apiStatus apierrors.APIStatus
+	if errors.As(err, &apiStatus) && apiStatus.Status().Details != nil {
+		for _, cause := range apiStatus.Status().Details.Causes {
+			if cause.Type == metav1.CauseTypeFieldManagerConflict {
+				conflictErr.Conflicts = append(conflictErr.Conflicts, fmt.Sprintf("%s: %s", cause.Field, cause.Message))
+			}
+		}
+	}
+	if len(conflictErr.Conflicts) == 0 {
+		conflictErr.Conflicts = []string{err.Error()}
+	}
+	return conflictErr
+}
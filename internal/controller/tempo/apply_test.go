@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/grafana/tempo-operator/internal/status"
+)
+
+func TestToApplyConfiguration(t *testing.T) {
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "tempo-simplest-ingester",
+			Namespace:       "observability",
+			ResourceVersion: "42",
+			ManagedFields:   []metav1.ManagedFieldsEntry{{Manager: "manager"}},
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(int32(1)),
+		},
+		Status: appsv1.StatefulSetStatus{
+			ReadyReplicas: 1,
+		},
+	}
+
+	desired, err := toApplyConfiguration(sts, "apps/v1", "StatefulSet")
+	require.NoError(t, err)
+
+	assert.Equal(t, "apps/v1", desired.GetAPIVersion())
+	assert.Equal(t, "StatefulSet", desired.GetKind())
+	assert.Equal(t, "tempo-simplest-ingester", desired.GetName())
+	assert.Empty(t, desired.GetResourceVersion())
+	assert.Empty(t, desired.GetManagedFields())
+	_, found, _ := unstructured.NestedFieldNoCopy(desired.Object, "metadata", "creationTimestamp")
+	assert.False(t, found)
+	_, found, _ = unstructured.NestedFieldNoCopy(desired.Object, "status")
+	assert.False(t, found)
+	replicas, _, _ := unstructured.NestedInt64(desired.Object, "spec", "replicas")
+	assert.Equal(t, int64(1), replicas)
+
+	// The desired object must not be modified
+	assert.Equal(t, "42", sts.ResourceVersion)
+}
+
+func TestFieldManagerConflictError(t *testing.T) {
+	conflict := apierrors.NewApplyConflict([]metav1.StatusCause{
+		{
+			Type:    metav1.CauseTypeFieldManagerConflict,
+			Message: `conflict with "kube-controller-manager" using apps/v1`,
+			Field:   ".spec.replicas",
+		},
+	}, "Apply failed with 1 conflict")
+
+	err := fieldManagerConflictError("StatefulSet", "tempo-simplest-ingester", conflict)
+	var conflictErr *status.FieldManagerConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Equal(t, []string{`.spec.replicas: conflict with "kube-controller-manager" using apps/v1`}, conflictErr.Conflicts)
+	assert.EqualError(t, err, `StatefulSet tempo-simplest-ingester has conflicting field managers: .spec.replicas: conflict with "kube-controller-manager" using apps/v1`)
+
+	// Conflicts without details
+	err = fieldManagerConflictError("StatefulSet", "tempo-simplest-ingester", apierrors.NewConflict(schema.GroupResource{Group: "apps", Resource: "statefulsets"}, "tempo-simplest-ingester", errors.New("conflict")))
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Len(t, conflictErr.Conflicts, 1)
+}
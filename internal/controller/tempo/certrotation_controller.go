@@ -8,6 +8,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -16,6 +17,8 @@ import (
 	"github.com/grafana/tempo-operator/internal/certrotation"
 	"github.com/grafana/tempo-operator/internal/certrotation/handlers"
 	tempoStackState "github.com/grafana/tempo-operator/internal/controller/tempo/internal/management/state"
+	"github.com/grafana/tempo-operator/internal/eventrecorder"
+	"github.com/grafana/tempo-operator/internal/tracing"
 )
 
 // CertRotationReconciler reconciles the `tempo.grafana.com/certRotationRequiredAt` annotation on
@@ -24,6 +27,7 @@ import (
 type CertRotationReconciler struct {
 	client.Client
 	Scheme       *runtime.Scheme
+	Recorder     events.EventRecorder
 	FeatureGates configv1alpha1.FeatureGates
 }
 
@@ -94,6 +98,8 @@ func (r *CertRotationReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		log.Error(err, "failed to annotate required cert rotation", "name", req.String())
 		return ctrl.Result{}, err
 	}
+	recordEvent(r.Recorder, &tempoStack, nil, corev1.EventTypeNormal, eventrecorder.ReasonCertificateRotationRequired, eventrecorder.ActionRotate,
+		"The certificates will be rotated: %s", expired.Error())
 
 	return ctrl.Result{
 		RequeueAfter: checkExpiryAfter,
@@ -106,7 +112,7 @@ func (r *CertRotationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Named("certrotation").
 		For(&v1alpha1.TempoStack{}).
 		Owns(&corev1.Secret{}).
-		Complete(r)
+		Complete(tracing.NewReconciler("CertRotation", r))
 }
 
 func expiryRetryAfter(certRefresh time.Duration) time.Duration {
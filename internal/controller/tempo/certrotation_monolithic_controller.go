@@ -8,6 +8,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -15,6 +16,8 @@ import (
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/certrotation"
 	"github.com/grafana/tempo-operator/internal/certrotation/handlers"
+	"github.com/grafana/tempo-operator/internal/eventrecorder"
+	"github.com/grafana/tempo-operator/internal/tracing"
 )
 
 // CertRotationMonolithicReconciler reconciles the `tempo.grafana.com/certRotationRequiredAt` annotation on
@@ -23,6 +26,7 @@ import (
 type CertRotationMonolithicReconciler struct {
 	client.Client
 	Scheme       *runtime.Scheme
+	Recorder     events.EventRecorder
 	FeatureGates configv1alpha1.FeatureGates
 }
 
@@ -92,6 +96,8 @@ func (r *CertRotationMonolithicReconciler) Reconcile(ctx context.Context, req ct
 		log.Error(err, "failed to annotate required cert rotation", "name", req.String())
 		return ctrl.Result{}, err
 	}
+	recordEvent(r.Recorder, &monolithic, nil, corev1.EventTypeNormal, eventrecorder.ReasonCertificateRotationRequired, eventrecorder.ActionRotate,
+		"The certificates will be rotated: %s", expired.Error())
 
 	return ctrl.Result{
 		RequeueAfter: checkExpiryAfter,
@@ -104,5 +110,5 @@ func (r *CertRotationMonolithicReconciler) SetupWithManager(mgr ctrl.Manager) er
 		Named("certrotation_monolithic").
 		For(&v1alpha1.TempoMonolithic{}).
 		Owns(&corev1.Secret{}).
-		Complete(r)
+		Complete(tracing.NewReconciler("CertRotationMonolithic", r))
 }
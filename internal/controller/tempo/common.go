@@ -7,16 +7,19 @@ import (
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/attribute"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -24,10 +27,13 @@ import (
 
 	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/controller/tempo/internal/metrics"
+	"github.com/grafana/tempo-operator/internal/eventrecorder"
 	"github.com/grafana/tempo-operator/internal/handlers/gateway"
 	"github.com/grafana/tempo-operator/internal/manifests"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/status"
+	"github.com/grafana/tempo-operator/internal/tracing"
 	"github.com/grafana/tempo-operator/internal/webhooks"
 )
 
@@ -82,14 +88,6 @@ var (
 			return false
 		},
 	})
-	createUpdateOrDeletePred = ctrlbuilder.WithPredicates(predicate.Funcs{
-		UpdateFunc: func(e event.UpdateEvent) bool {
-			return e.ObjectOld.GetResourceVersion() != e.ObjectNew.GetResourceVersion()
-		},
-		CreateFunc:  func(e event.CreateEvent) bool { return true },
-		DeleteFunc:  func(e event.DeleteEvent) bool { return true },
-		GenericFunc: func(e event.GenericEvent) bool { return false },
-	})
 )
 
 func statusDifferent(e event.UpdateEvent) bool {
@@ -114,18 +112,52 @@ func isNamespaceScoped(obj client.Object) bool {
 	}
 }
 
+// withoutClusterScopedObjects removes the cluster-scoped objects if the operator is namespace-scoped.
+// A namespace-scoped operator has no permissions to manage cluster-scoped objects,
+// therefore a cluster administrator needs to create them.
+func withoutClusterScopedObjects(ctx context.Context, ctrlConfig configv1alpha1.ProjectConfig, objects []client.Object) []client.Object {
+	if !ctrlConfig.IsNamespaceScoped() {
+		return objects
+	}
+
+	log := log.FromContext(ctx)
+	namespacedObjects := make([]client.Object, 0, len(objects))
+	for _, obj := range objects {
+		if !isNamespaceScoped(obj) {
+			log.Info("skipping cluster-scoped object, the operator is namespace-scoped",
+				"object_name", obj.GetName(),
+				"object_kind", fmt.Sprintf("%T", obj),
+			)
+			continue
+		}
+		namespacedObjects = append(namespacedObjects, obj)
+	}
+	return namespacedObjects
+}
+
+// operationResultRecreated is the result of an object deleted because of a change in an immutable field.
+const operationResultRecreated controllerutil.OperationResult = "recreated"
+
 // reconcileManagedObjects creates or updates all managed objects.
+// The objects are applied with server-side apply if the serverSideApply feature gate is enabled.
 // If immutable fields are changed, the object will be deleted and re-created.
+// Every change of the managed objects is recorded as an Event on the owner.
 func reconcileManagedObjects(
 	ctx context.Context,
 	k8sclient client.Client,
-	owner metav1.Object,
+	recorder events.EventRecorder,
+	ctrlConfig configv1alpha1.ProjectConfig,
+	owner client.Object,
 	scheme *runtime.Scheme,
 	managedObjects []client.Object,
 	ownedObjects map[types.UID]client.Object,
-) error {
+) (retErr error) {
+	ctx, span := tracing.Start(ctx, "ReconcileManagedObjects", attribute.Int("tempo.managed_objects", len(managedObjects)))
+	defer func() { tracing.End(span, retErr) }()
+
 	log := log.FromContext(ctx)
 	pruneObjects := ownedObjects
+	instance := metrics.Instance{Kind: objectKind(owner, scheme), Namespace: owner.GetNamespace(), Name: owner.GetName()}
 
 	// Create or update all objects managed by the operator
 	errs := []error{}
@@ -144,26 +176,65 @@ func reconcileManagedObjects(
 		}
 
 		desired := obj.DeepCopyObject().(client.Object)
-		mutateFn := manifests.MutateFuncFor(obj, desired)
+
+		kind := objectKind(obj, scheme)
+		objCtx, objSpan := tracing.Start(ctx, "CreateOrUpdate", tracing.ObjectAttributes(kind, obj.GetName())...)
 
 		var op controllerutil.OperationResult
-		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-			var err error
-			op, err = ctrl.CreateOrUpdate(ctx, k8sclient, obj, mutateFn)
-			return err
-		})
+		var changedFields []string
+		var err error
+		if ctrlConfig.Gates.ServerSideApply {
+			op, changedFields, err = applyManagedObject(objCtx, k8sclient, scheme, obj)
+		} else {
+			mutateFn := manifests.MutateFuncFor(obj, desired)
+			var existing client.Object
+			err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				var err error
+				op, err = ctrl.CreateOrUpdate(objCtx, k8sclient, obj, func() error {
+					existing = obj.DeepCopyObject().(client.Object)
+					return mutateFn()
+				})
+				return err
+			})
+			if err == nil && op == controllerutil.OperationResultUpdated {
+				changedFields, _ = eventrecorder.ChangedFields(existing, obj)
+			}
+		}
 
 		var immutableErr *manifests.ImmutableErr
 		if err != nil && errors.As(err, &immutableErr) {
 			l.Error(err, "detected a change in an immutable field. The object will be deleted, and re-created on next reconcile", "obj", obj.GetName())
-			err = k8sclient.Delete(ctx, desired)
+			op = operationResultRecreated
+			err = k8sclient.Delete(objCtx, desired)
+			if err == nil {
+				metrics.ObjectRecreated(instance, kind)
+				recordEvent(recorder, owner, obj, corev1.EventTypeNormal, eventrecorder.ReasonRecreated, eventrecorder.ActionDelete,
+					"Deleted %s %s to change an immutable field, it will be created again: %s", kind, obj.GetName(), immutableErr.Error())
+			}
 		}
+		objSpan.SetAttributes(attribute.String("k8s.operation", string(op)))
+		tracing.End(objSpan, err)
 
 		if err != nil {
 			l.Error(err, "failed to configure resource")
+			metrics.APIError(instance, kind)
+			recordEvent(recorder, owner, obj, corev1.EventTypeWarning, eventrecorder.ReasonFailedCreateOrUpdate, eventrecorder.ActionUpdate,
+				"Failed to configure %s %s: %s", kind, obj.GetName(), err.Error())
 			errs = append(errs, err)
 		} else {
 			l.V(1).Info(fmt.Sprintf("resource has been %s", op))
+			switch op {
+			case controllerutil.OperationResultNone:
+				metrics.ObjectReconciled(instance, op)
+			case controllerutil.OperationResultCreated:
+				metrics.ObjectReconciled(instance, op)
+				recordEvent(recorder, owner, obj, corev1.EventTypeNormal, eventrecorder.ReasonCreated, eventrecorder.ActionCreate,
+					"Created %s %s", kind, obj.GetName())
+			case controllerutil.OperationResultUpdated:
+				metrics.ObjectReconciled(instance, op)
+				recordEvent(recorder, owner, obj, corev1.EventTypeNormal, eventrecorder.ReasonUpdated, eventrecorder.ActionUpdate,
+					"Updated %s %s: %s", kind, obj.GetName(), eventrecorder.SummarizeFields(changedFields))
+			}
 		}
 
 		// This object is still managed by the operator, remove it from the list of objects to prune
@@ -182,10 +253,20 @@ func reconcileManagedObjects(
 		)
 
 		l.Info("pruning unmanaged resource")
-		err := k8sclient.Delete(ctx, obj)
+		kind := objectKind(obj, scheme)
+		pruneCtx, pruneSpan := tracing.Start(ctx, "Prune", tracing.ObjectAttributes(kind, obj.GetName())...)
+		err := k8sclient.Delete(pruneCtx, obj)
+		tracing.End(pruneSpan, err)
 		if err != nil {
 			l.Error(err, "failed to delete resource")
+			metrics.APIError(instance, kind)
+			recordEvent(recorder, owner, obj, corev1.EventTypeWarning, eventrecorder.ReasonFailedPrune, eventrecorder.ActionDelete,
+				"Failed to delete unmanaged %s %s: %s", kind, obj.GetName(), err.Error())
 			pruneErrs = append(pruneErrs, err)
+		} else {
+			metrics.ObjectPruned(instance)
+			recordEvent(recorder, owner, obj, corev1.EventTypeNormal, eventrecorder.ReasonPruned, eventrecorder.ActionDelete,
+				"Deleted unmanaged %s %s", kind, obj.GetName())
 		}
 	}
 	if len(pruneErrs) > 0 {
@@ -195,6 +276,24 @@ func reconcileManagedObjects(
 	return nil
 }
 
+// recordEvent records an Event regarding the owner of a managed object.
+// The Event is dropped if no recorder is configured.
+func recordEvent(recorder events.EventRecorder, owner client.Object, related runtime.Object, eventtype, reason, action, note string, args ...interface{}) {
+	if recorder == nil {
+		return
+	}
+	recorder.Eventf(owner, related, eventtype, reason, action, note, args...)
+}
+
+// objectKind returns the kind of the object, or the Go type if the kind is not registered in the scheme.
+func objectKind(obj client.Object, scheme *runtime.Scheme) string {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return fmt.Sprintf("%T", obj)
+	}
+	return gvk.Kind
+}
+
 // listFieldErrors converts field.ErrorList to a comma separated string of errors.
 func listFieldErrors(fieldErrs field.ErrorList) string {
 	msgs := make([]string, len(fieldErrs))
@@ -212,6 +311,7 @@ func getTenantParams(
 	name string,
 	tenants v1alpha1.TenantsSpec,
 	gatewayEnabled bool,
+	secretNamespaces map[string]string,
 ) ([]*manifestutils.GatewayTenantOIDCSecret, []*manifestutils.GatewayTenantsData, error) {
 	log := log.FromContext(ctx)
 
@@ -226,7 +326,7 @@ func getTenantParams(
 
 	switch tenants.Mode {
 	case v1alpha1.ModeStatic:
-		tenantsSecrets, err := gateway.GetOIDCTenantSecrets(ctx, k8sclient, namespace, tenants)
+		tenantsSecrets, err := gateway.GetOIDCTenantSecrets(ctx, k8sclient, namespace, tenants, secretNamespaces)
 		if err != nil {
 			return nil, nil, err
 		}
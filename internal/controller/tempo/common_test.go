@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+)
+
+func TestWithoutClusterScopedObjects(t *testing.T) {
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "tempo-simplest"}}
+	clusterRole := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tempo-simplest-gateway-observability"}}
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tempo-simplest-gateway-observability"}}
+	objects := []client.Object{configMap, clusterRole, clusterRoleBinding}
+
+	tests := []struct {
+		name       string
+		ctrlConfig configv1alpha1.ProjectConfig
+		expected   []client.Object
+	}{
+		{
+			name:     "cluster-scoped operator",
+			expected: objects,
+		},
+		{
+			name: "namespace-scoped operator",
+			ctrlConfig: configv1alpha1.ProjectConfig{
+				WatchNamespaces: []string{"observability"},
+			},
+			expected: []client.Object{configMap},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, withoutClusterScopedObjects(context.Background(), test.ctrlConfig, objects))
+		})
+	}
+}
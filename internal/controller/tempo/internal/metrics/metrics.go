@@ -0,0 +1,123 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// KindTempoStack is the kind label of TempoStack instances.
+	KindTempoStack = "TempoStack"
+	// KindTempoMonolithic is the kind label of TempoMonolithic instances.
+	KindTempoMonolithic = "TempoMonolithic"
+
+	resultSuccess = "success"
+	resultError   = "error"
+
+	// OperationPruned is the operation label of objects which are not managed anymore and were deleted.
+	OperationPruned = "pruned"
+)
+
+var instanceLabels = []string{"kind", "stack_namespace", "stack_name"}
+
+var (
+	metricReconcileDuration = promauto.With(ctrlmetrics.Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "tempooperator",
+		Name:      "reconcile_duration_seconds",
+		Help:      "The duration of the reconcile loop of a TempoStack or TempoMonolithic instance.",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 2, 10),
+	}, append(instanceLabels, "result"))
+	metricBuildDuration = promauto.With(ctrlmetrics.Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "tempooperator",
+		Name:      "manifests_build_duration_seconds",
+		Help:      "The duration of building the manifests of a TempoStack or TempoMonolithic instance.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 12),
+	}, instanceLabels)
+	metricManagedObjects = promauto.With(ctrlmetrics.Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: "tempooperator",
+		Name:      "managed_objects_total",
+		Help:      "The number of created, updated, unchanged and pruned objects of a TempoStack or TempoMonolithic instance.",
+	}, append(instanceLabels, "operation"))
+	metricRecreations = promauto.With(ctrlmetrics.Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: "tempooperator",
+		Name:      "managed_object_recreations_total",
+		Help:      "The number of objects of a TempoStack or TempoMonolithic instance deleted and re-created because of a change in an immutable field.",
+	}, append(instanceLabels, "object_kind"))
+	metricAPIErrors = promauto.With(ctrlmetrics.Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: "tempooperator",
+		Name:      "api_errors_total",
+		Help:      "The number of failed requests to the Kubernetes API for the objects of a TempoStack or TempoMonolithic instance.",
+	}, append(instanceLabels, "object_kind"))
+	metricLastSuccessfulReconcile = promauto.With(ctrlmetrics.Registry).NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "tempooperator",
+		Name:      "last_successful_reconcile_timestamp_seconds",
+		Help:      "The timestamp of the last successful reconcile of a TempoStack or TempoMonolithic instance.",
+	}, instanceLabels)
+)
+
+// Instance identifies the TempoStack or TempoMonolithic instance of the metrics.
+type Instance struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (i Instance) labels(values ...string) []string {
+	return append([]string{i.Kind, i.Namespace, i.Name}, values...)
+}
+
+// ObserveReconcile records the duration and the result of a reconcile.
+// The timestamp of the last successful reconcile is updated if the reconcile succeeded.
+func ObserveReconcile(instance Instance, duration time.Duration, err error) {
+	result := resultSuccess
+	if err != nil {
+		result = resultError
+	} else {
+		metricLastSuccessfulReconcile.WithLabelValues(instance.labels()...).SetToCurrentTime()
+	}
+	metricReconcileDuration.WithLabelValues(instance.labels(result)...).Observe(duration.Seconds())
+}
+
+// ObserveBuild records the duration of building the manifests.
+func ObserveBuild(instance Instance, duration time.Duration) {
+	metricBuildDuration.WithLabelValues(instance.labels()...).Observe(duration.Seconds())
+}
+
+// ObjectReconciled counts a created, updated or unchanged managed object.
+func ObjectReconciled(instance Instance, op controllerutil.OperationResult) {
+	metricManagedObjects.WithLabelValues(instance.labels(string(op))...).Inc()
+}
+
+// ObjectPruned counts a deleted object, which is not managed anymore.
+func ObjectPruned(instance Instance) {
+	metricManagedObjects.WithLabelValues(instance.labels(OperationPruned)...).Inc()
+}
+
+// ObjectRecreated counts an object deleted because of a change in an immutable field.
+func ObjectRecreated(instance Instance, objectKind string) {
+	metricRecreations.WithLabelValues(instance.labels(objectKind)...).Inc()
+}
+
+// APIError counts a failed request to the Kubernetes API.
+func APIError(instance Instance, objectKind string) {
+	metricAPIErrors.WithLabelValues(instance.labels(objectKind)...).Inc()
+}
+
+// Delete removes all metrics of a deleted instance.
+func Delete(instance Instance) {
+	labels := prometheus.Labels{
+		"kind":            instance.Kind,
+		"stack_namespace": instance.Namespace,
+		"stack_name":      instance.Name,
+	}
+	metricReconcileDuration.DeletePartialMatch(labels)
+	metricBuildDuration.DeletePartialMatch(labels)
+	metricManagedObjects.DeletePartialMatch(labels)
+	metricRecreations.DeletePartialMatch(labels)
+	metricAPIErrors.DeletePartialMatch(labels)
+	metricLastSuccessfulReconcile.DeletePartialMatch(labels)
+}
@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func TestInstanceMetrics(t *testing.T) {
+	simplest := Instance{Kind: KindTempoStack, Namespace: "default", Name: "simplest"}
+	other := Instance{Kind: KindTempoMonolithic, Namespace: "default", Name: "simplest"}
+
+	ObserveReconcile(simplest, 2*time.Second, errors.New("failed"))
+	assert.Equal(t, 0, testutil.CollectAndCount(metricLastSuccessfulReconcile))
+
+	ObserveReconcile(simplest, time.Second, nil)
+	ObserveReconcile(other, time.Second, nil)
+	ObserveBuild(simplest, 10*time.Millisecond)
+	ObjectReconciled(simplest, controllerutil.OperationResultCreated)
+	ObjectReconciled(simplest, controllerutil.OperationResultCreated)
+	ObjectReconciled(simplest, controllerutil.OperationResultNone)
+	ObjectPruned(simplest)
+	ObjectRecreated(simplest, "StatefulSet")
+	APIError(simplest, "Deployment")
+
+	assert.Equal(t, 3, testutil.CollectAndCount(metricReconcileDuration))
+	assert.Equal(t, 2, testutil.CollectAndCount(metricLastSuccessfulReconcile))
+	assert.InDelta(t, float64(time.Now().Unix()), testutil.ToFloat64(metricLastSuccessfulReconcile.WithLabelValues(simplest.labels()...)), 5)
+	assert.Equal(t, float64(2), testutil.ToFloat64(metricManagedObjects.WithLabelValues(simplest.labels("created")...)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metricManagedObjects.WithLabelValues(simplest.labels("unchanged")...)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metricManagedObjects.WithLabelValues(simplest.labels("pruned")...)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metricRecreations.WithLabelValues(simplest.labels("StatefulSet")...)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metricAPIErrors.WithLabelValues(simplest.labels("Deployment")...)))
+
+	// The metrics of other instances are kept.
+	Delete(simplest)
+	assert.Equal(t, 1, testutil.CollectAndCount(metricReconcileDuration))
+	assert.Equal(t, 1, testutil.CollectAndCount(metricLastSuccessfulReconcile))
+	assert.Equal(t, 0, testutil.CollectAndCount(metricBuildDuration))
+	assert.Equal(t, 0, testutil.CollectAndCount(metricManagedObjects))
+	assert.Equal(t, 0, testutil.CollectAndCount(metricRecreations))
+	assert.Equal(t, 0, testutil.CollectAndCount(metricAPIErrors))
+}
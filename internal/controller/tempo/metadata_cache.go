@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+)
+
+// NewMetadataCache creates a cache for metadata-only watches and adds it to the manager.
+//
+// The cache of the manager only contains the Secrets and ConfigMaps created by the operator.
+// Changes of the Secrets referenced by the instances (e.g. the storage secret) are watched through this cache instead.
+// Metadata-only informers do not store the data of the Secrets, which keeps the memory usage low on large clusters.
+func NewMetadataCache(mgr ctrl.Manager, ctrlConfig configv1alpha1.ProjectConfig) (cache.Cache, error) {
+	opts := cache.Options{
+		Scheme:           mgr.GetScheme(),
+		Mapper:           mgr.GetRESTMapper(),
+		DefaultTransform: cache.TransformStripManagedFields(),
+	}
+	if ctrlConfig.IsNamespaceScoped() {
+		opts.DefaultNamespaces = make(map[string]cache.Config, len(ctrlConfig.WatchNamespaces))
+		for _, namespace := range ctrlConfig.WatchNamespaces {
+			opts.DefaultNamespaces[namespace] = cache.Config{}
+		}
+	}
+
+	metadataCache, err := cache.New(mgr.GetConfig(), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metadata cache: %w", err)
+	}
+	if err := mgr.Add(metadataCache); err != nil {
+		return nil, fmt.Errorf("failed to add metadata cache to the manager: %w", err)
+	}
+	return metadataCache, nil
+}
+
+// secretMetadataSource returns a source of metadata-only Secret events, which are mapped to reconcile requests by mapFn.
+func secretMetadataSource(metadataCache cache.Cache, mapFn func(context.Context, client.Object) []reconcile.Request) source.Source {
+	secret := &metav1.PartialObjectMetadata{}
+	secret.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+
+	return source.Kind(
+		metadataCache,
+		secret,
+		handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, obj *metav1.PartialObjectMetadata) []reconcile.Request {
+			return mapFn(ctx, obj)
+		}),
+		predicate.TypedResourceVersionChangedPredicate[*metav1.PartialObjectMetadata]{},
+	)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/go-logr/logr"
 	grafanav1 "github.com/grafana/grafana-operator/v5/api/v1beta1"
 	routev1 "github.com/openshift/api/route/v1"
 	cloudcredentialv1 "github.com/openshift/cloud-credential-operator/pkg/apis/cloudcredential/v1"
@@ -20,20 +22,23 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
-	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/certrotation"
+	"github.com/grafana/tempo-operator/internal/controller/tempo/internal/metrics"
+	"github.com/grafana/tempo-operator/internal/eventrecorder"
 	"github.com/grafana/tempo-operator/internal/handlers/storage"
 	"github.com/grafana/tempo-operator/internal/manifests/cloudcredentials"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/manifests/monolithic"
 	"github.com/grafana/tempo-operator/internal/status"
 	"github.com/grafana/tempo-operator/internal/tlsprofile"
+	"github.com/grafana/tempo-operator/internal/tracing"
 	"github.com/grafana/tempo-operator/internal/upgrade"
 	"github.com/grafana/tempo-operator/internal/version"
 )
@@ -45,6 +50,8 @@ type TempoMonolithicReconciler struct {
 	Recorder   events.EventRecorder
 	CtrlConfig configv1alpha1.ProjectConfig
 	Version    version.Version
+	// MetadataCache watches the metadata of the referenced Secrets, see NewMetadataCache.
+	MetadataCache cache.Cache
 }
 
 //+kubebuilder:rbac:groups=tempo.grafana.com,resources=tempomonolithics,verbs=get;list;watch;create;update;patch;delete
@@ -65,6 +72,7 @@ func (r *TempoMonolithicReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		if apierrors.IsNotFound(err) {
 			// instance is not found, metrics can be cleared
 			status.ClearMonolithicMetrics(req.Namespace, req.Name)
+			metrics.Delete(metrics.Instance{Kind: metrics.KindTempoMonolithic, Namespace: req.Namespace, Name: req.Name})
 
 			// we'll ignore not-found errors, since they can't be fixed by an immediate
 			// requeue (we'll need to wait for a new notification), and we can get them
@@ -90,8 +98,9 @@ func (r *TempoMonolithicReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	} else {
 		// The object is being deleted
 		if controllerutil.ContainsFinalizer(&tempo, v1alpha1.TempoFinalizer) {
-			// our finalizer is present, so let's handle any external dependency
-			if tempo.Spec.Management != v1alpha1.ManagementStateUnmanaged {
+			// our finalizer is present, so let's handle any external dependency.
+			// A namespace-scoped operator does not create cluster-scoped objects.
+			if tempo.Spec.Management != v1alpha1.ManagementStateUnmanaged && !r.CtrlConfig.IsNamespaceScoped() {
 				if err := finalize(ctx, r.Client, log, monolithic.ClusterScopedCommonLabels(tempo.ObjectMeta)); err != nil {
 					// if fail to delete the external dependency here, return with error
 					// so that it can be retried.
@@ -117,6 +126,15 @@ func (r *TempoMonolithicReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, nil
 	}
 
+	instance := metrics.Instance{Kind: metrics.KindTempoMonolithic, Namespace: tempo.Namespace, Name: tempo.Name}
+	reconcileStart := time.Now()
+	result, err := r.reconcileManaged(ctx, log, req, tempo)
+	metrics.ObserveReconcile(instance, time.Since(reconcileStart), err)
+	return result, err
+}
+
+// reconcileManaged reconciles a managed TempoMonolithic instance.
+func (r *TempoMonolithicReconciler) reconcileManaged(ctx context.Context, log logr.Logger, req ctrl.Request, tempo v1alpha1.TempoMonolithic) (ctrl.Result, error) {
 	// New CRs with empty OperatorVersion are ignored, as they're already up-to-date.
 	// The versions will be set when the status field is refreshed.
 	if tempo.Status.OperatorVersion != "" && tempo.Status.OperatorVersion != r.Version.OperatorVersion {
@@ -128,7 +146,7 @@ func (r *TempoMonolithicReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			Log:        log.WithName("upgrade"),
 		}.Upgrade(ctx, &tempo)
 		if err != nil {
-			return ctrl.Result{}, status.HandleTempoMonolithicStatus(ctx, r.Client, tempo, err)
+			return ctrl.Result{}, r.handleReconcileStatus(ctx, tempo, err)
 		}
 		tempo = *upgraded.(*v1alpha1.TempoMonolithic)
 	}
@@ -138,7 +156,7 @@ func (r *TempoMonolithicReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		var err error
 		certHashAnnotations, err = monolithic.CreateOrRotateCertificates(ctx, log, req, r.Client, r.Scheme, r.CtrlConfig.Gates, certrotation.MonolithicComponentCertSecretNames(req.Name))
 		if err != nil {
-			return ctrl.Result{}, status.HandleTempoMonolithicStatus(ctx, r.Client, tempo, fmt.Errorf("built in cert manager error: %w", err))
+			return ctrl.Result{}, r.handleReconcileStatus(ctx, tempo, fmt.Errorf("built in cert manager error: %w", err))
 		}
 	}
 
@@ -154,18 +172,18 @@ func (r *TempoMonolithicReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	if tokenCCOAuthEnv != nil && r.getCredentialMode(tempo) == v1alpha1.CredentialModeTokenCCO {
 		ccoObjects, err := cloudcredentials.BuildCredentialsRequest(&tempo, tempo.Spec.ServiceAccount, tokenCCOAuthEnv)
 		if err != nil {
-			return ctrl.Result{}, status.HandleTempoMonolithicStatus(ctx, r.Client, tempo, err)
+			return ctrl.Result{}, r.handleReconcileStatus(ctx, tempo, err)
 		}
 
 		ownedCCOObjects, err := r.getCCOOwnedObjects(ctx, tempo)
 		if err != nil {
-			return ctrl.Result{}, status.HandleTempoMonolithicStatus(ctx, r.Client, tempo, err)
+			return ctrl.Result{}, r.handleReconcileStatus(ctx, tempo, err)
 		}
 
-		err = reconcileManagedObjects(ctx, r.Client, &tempo, r.Scheme, ccoObjects, ownedCCOObjects)
+		err = reconcileManagedObjects(ctx, r.Client, r.Recorder, r.CtrlConfig, &tempo, r.Scheme, ccoObjects, ownedCCOObjects)
 
 		if err != nil {
-			return ctrl.Result{}, status.HandleTempoMonolithicStatus(ctx, r.Client, tempo, err)
+			return ctrl.Result{}, r.handleReconcileStatus(ctx, tempo, err)
 		}
 	} else if tokenCCOAuthEnv == nil && r.getCredentialMode(tempo) == v1alpha1.CredentialModeTokenCCO {
 		return ctrl.Result{}, status.HandleTempoMonolithicStatus(ctx, r.Client, tempo,
@@ -174,13 +192,22 @@ func (r *TempoMonolithicReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 
 	err := r.createOrUpdate(ctx, tempo, certHashAnnotations)
 	if err != nil {
-		return ctrl.Result{}, status.HandleTempoMonolithicStatus(ctx, r.Client, tempo, err)
+		return ctrl.Result{}, r.handleReconcileStatus(ctx, tempo, err)
 	}
 
 	// Note: controller-runtime will always requeue a reconcile if Reconcile() returns any error except TerminalError.
 	// Result.Requeue and Result.RequeueAfter are only respected if err == nil
 	// https://github.com/kubernetes-sigs/controller-runtime/blob/v0.15.0/pkg/internal/controller/controller.go#L315-L341
-	return ctrl.Result{}, status.HandleTempoMonolithicStatus(ctx, r.Client, tempo, nil)
+	return ctrl.Result{}, r.handleReconcileStatus(ctx, tempo, nil)
+}
+
+// handleReconcileStatus records an Event for configuration errors and updates the status of the TempoMonolithic instance.
+func (r *TempoMonolithicReconciler) handleReconcileStatus(ctx context.Context, tempo v1alpha1.TempoMonolithic, reconcileError error) error {
+	var configurationError *status.ConfigurationError
+	if errors.As(reconcileError, &configurationError) {
+		recordEvent(r.Recorder, &tempo, nil, corev1.EventTypeWarning, string(configurationError.Reason), eventrecorder.ActionReconcile, "%s", configurationError.Message)
+	}
+	return status.HandleTempoMonolithicStatus(ctx, r.Client, tempo, reconcileError)
 }
 
 func (r *TempoMonolithicReconciler) getCredentialMode(tempo v1alpha1.TempoMonolithic) v1alpha1.CredentialMode {
@@ -200,7 +227,9 @@ func (r *TempoMonolithicReconciler) createOrUpdate(ctx context.Context, tempo v1
 	}
 
 	var errs field.ErrorList
-	opts.StorageParams, errs = storage.GetStorageParamsForTempoMonolithic(ctx, r.Client, tempo)
+	storageCtx, span := tracing.Start(ctx, "GetStorageParams")
+	opts.StorageParams, errs = storage.GetStorageParamsForTempoMonolithic(storageCtx, r.Client, tempo)
+	tracing.End(span, errs.ToAggregate())
 	if len(errs) > 0 {
 		return &status.ConfigurationError{
 			Reason:  v1alpha1.ReasonInvalidStorageConfig,
@@ -210,7 +239,7 @@ func (r *TempoMonolithicReconciler) createOrUpdate(ctx context.Context, tempo v1
 
 	if tempo.Spec.Multitenancy.IsGatewayEnabled() {
 		var err error
-		opts.GatewayTenantSecret, opts.GatewayTenantsData, err = getTenantParams(ctx, r.Client, &r.CtrlConfig, tempo.Namespace, tempo.Name, tempo.Spec.Multitenancy.TenantsSpec, true)
+		opts.GatewayTenantSecret, opts.GatewayTenantsData, err = getTenantParams(ctx, r.Client, &r.CtrlConfig, tempo.Namespace, tempo.Name, tempo.Spec.Multitenancy.TenantsSpec, true, nil)
 		if err != nil {
 			return err
 		}
@@ -230,17 +259,22 @@ func (r *TempoMonolithicReconciler) createOrUpdate(ctx context.Context, tempo v1
 		}
 	}
 
+	_, span = tracing.Start(ctx, "BuildManifests")
+	buildStart := time.Now()
 	managedObjects, err := monolithic.BuildAll(opts)
+	metrics.ObserveBuild(metrics.Instance{Kind: metrics.KindTempoMonolithic, Namespace: tempo.Namespace, Name: tempo.Name}, time.Since(buildStart))
+	tracing.End(span, err)
 	if err != nil {
 		return fmt.Errorf("error building manifests: %w", err)
 	}
+	managedObjects = withoutClusterScopedObjects(ctx, r.CtrlConfig, managedObjects)
 
 	ownedObjects, err := r.getOwnedObjects(ctx, tempo)
 	if err != nil {
 		return err
 	}
 
-	return reconcileManagedObjects(ctx, r.Client, &tempo, r.Scheme, managedObjects, ownedObjects)
+	return reconcileManagedObjects(ctx, r.Client, r.Recorder, r.CtrlConfig, &tempo, r.Scheme, managedObjects, ownedObjects)
 }
 func (r *TempoMonolithicReconciler) getCCOOwnedObjects(ctx context.Context, tempo v1alpha1.TempoMonolithic) (map[types.UID]client.Object, error) {
 	ownedObjects := map[types.UID]client.Object{}
@@ -310,24 +344,26 @@ func (r *TempoMonolithicReconciler) getOwnedObjects(ctx context.Context, tempo v
 		ownedObjects[roleBindingList.Items[i].GetUID()] = &roleBindingList.Items[i]
 	}
 
-	// TokenReview and SubjectAccessReview when gateway is configured with multi-tenancy in OpenShift mode
-	clusterRoleList := &rbacv1.ClusterRoleList{}
-	err = r.List(ctx, clusterRoleList, clusterWideListOps)
-	if err != nil {
-		return nil, fmt.Errorf("error listing cluster roles: %w", err)
-	}
-	for i := range clusterRoleList.Items {
-		ownedObjects[clusterRoleList.Items[i].GetUID()] = &clusterRoleList.Items[i]
-	}
+	// TokenReview and SubjectAccessReview when gateway is configured with multi-tenancy in OpenShift mode.
+	// A namespace-scoped operator does not manage cluster-scoped objects.
+	if !r.CtrlConfig.IsNamespaceScoped() {
+		clusterRoleList := &rbacv1.ClusterRoleList{}
+		err = r.List(ctx, clusterRoleList, clusterWideListOps)
+		if err != nil {
+			return nil, fmt.Errorf("error listing cluster roles: %w", err)
+		}
+		for i := range clusterRoleList.Items {
+			ownedObjects[clusterRoleList.Items[i].GetUID()] = &clusterRoleList.Items[i]
+		}
 
-	// TokenReview and SubjectAccessReview when gateway is configured with multi-tenancy in OpenShift mode
-	clusterRoleBindingList := &rbacv1.ClusterRoleBindingList{}
-	err = r.List(ctx, clusterRoleBindingList, clusterWideListOps)
-	if err != nil {
-		return nil, fmt.Errorf("error listing cluster role bindings: %w", err)
-	}
-	for i := range clusterRoleBindingList.Items {
-		ownedObjects[clusterRoleBindingList.Items[i].GetUID()] = &clusterRoleBindingList.Items[i]
+		clusterRoleBindingList := &rbacv1.ClusterRoleBindingList{}
+		err = r.List(ctx, clusterRoleBindingList, clusterWideListOps)
+		if err != nil {
+			return nil, fmt.Errorf("error listing cluster role bindings: %w", err)
+		}
+		for i := range clusterRoleBindingList.Items {
+			ownedObjects[clusterRoleBindingList.Items[i].GetUID()] = &clusterRoleBindingList.Items[i]
+		}
 	}
 
 	if r.CtrlConfig.Gates.PrometheusOperator {
@@ -370,6 +406,15 @@ func (r *TempoMonolithicReconciler) getOwnedObjects(ctx context.Context, tempo v
 		for i := range datasourceList.Items {
 			ownedObjects[datasourceList.Items[i].GetUID()] = &datasourceList.Items[i]
 		}
+
+		dashboardList := &grafanav1.GrafanaDashboardList{}
+		err = r.List(ctx, dashboardList, listOps)
+		if err != nil {
+			return nil, fmt.Errorf("error listing dashboards: %w", err)
+		}
+		for i := range dashboardList.Items {
+			ownedObjects[dashboardList.Items[i].GetUID()] = &dashboardList.Items[i]
+		}
 	}
 
 	return ownedObjects, nil
@@ -429,15 +474,16 @@ func (r *TempoMonolithicReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&corev1.ServiceAccount{}, updateOrDeleteOnlyPred).
 		Owns(&appsv1.StatefulSet{}, updateOrDeleteWithStatusPred).
 		Owns(&networkingv1.Ingress{}, updateOrDeleteOnlyPred).
-		Owns(&rbacv1.ClusterRole{}, updateOrDeleteOnlyPred).
-		Owns(&rbacv1.ClusterRoleBinding{}, updateOrDeleteOnlyPred).
 		Owns(&rbacv1.Role{}, updateOrDeleteOnlyPred).
 		Owns(&rbacv1.RoleBinding{}, updateOrDeleteOnlyPred).
-		Watches(
-			&corev1.Secret{},
-			handler.EnqueueRequestsFromMapFunc(r.findTempoMonolithicForStorageSecret),
-			createUpdateOrDeletePred,
-		)
+		WatchesRawSource(secretMetadataSource(r.MetadataCache, r.findTempoMonolithicForStorageSecret))
+
+	// A namespace-scoped operator does not manage cluster-scoped objects.
+	if !r.CtrlConfig.IsNamespaceScoped() {
+		builder = builder.
+			Owns(&rbacv1.ClusterRole{}, updateOrDeleteOnlyPred).
+			Owns(&rbacv1.ClusterRoleBinding{}, updateOrDeleteOnlyPred)
+	}
 
 	if r.CtrlConfig.Gates.OpenShift.OpenShiftRoute {
 		builder = builder.Owns(&routev1.Route{}, updateOrDeleteOnlyPred)
@@ -450,6 +496,7 @@ func (r *TempoMonolithicReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	if r.CtrlConfig.Gates.GrafanaOperator {
 		builder = builder.Owns(&grafanav1.GrafanaDatasource{}, updateOrDeleteOnlyPred)
+		builder = builder.Owns(&grafanav1.GrafanaDashboard{}, updateOrDeleteOnlyPred)
 	}
 
 	tokenCCOAuthEnv := cloudcredentials.DiscoverTokenCCOAuthConfig()
@@ -457,7 +504,7 @@ func (r *TempoMonolithicReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		builder = builder.Owns(&cloudcredentialv1.CredentialsRequest{}, updateOrDeleteOnlyPred)
 	}
 
-	return builder.Complete(r)
+	return builder.Complete(tracing.NewReconciler("TempoMonolithic", r))
 }
 
 func matchTempoMonolithicStorageSecret(item v1alpha1.TempoMonolithic, secretName string) bool {
@@ -126,7 +126,7 @@ func TestOpenShiftModeMonolithic_finalizer(t *testing.T) {
 	reconciler := TempoMonolithicReconciler{
 		Client:   k8sClient,
 		Scheme:   testScheme,
-		Recorder: events.NewFakeRecorder(1),
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
 		CtrlConfig: configv1alpha1.ProjectConfig{
 			DefaultImages: mockDefaultImages,
 			Gates: configv1alpha1.FeatureGates{
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/go-logr/logr"
 	grafanav1 "github.com/grafana/grafana-operator/v5/api/v1beta1"
@@ -18,12 +20,16 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/events"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -31,17 +37,31 @@ import (
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/certrotation"
 	"github.com/grafana/tempo-operator/internal/certrotation/handlers"
+	"github.com/grafana/tempo-operator/internal/controller/tempo/internal/metrics"
+	"github.com/grafana/tempo-operator/internal/eventrecorder"
+	"github.com/grafana/tempo-operator/internal/handlers/tempotenant"
 	"github.com/grafana/tempo-operator/internal/manifests/cloudcredentials"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/status"
+	"github.com/grafana/tempo-operator/internal/tracing"
 	"github.com/grafana/tempo-operator/internal/upgrade"
 	"github.com/grafana/tempo-operator/internal/version"
 )
 
 const (
 	storageSecretField = ".spec.storage.secret.name" // nolint #nosec
+
+	// tenantOverridesRecheckInterval is the interval to verify again if the per-tenant overrides are loaded by Tempo.
+	// It covers the sync period of the kubelet (for ConfigMap volumes) and the reload period of Tempo.
+	tenantOverridesRecheckInterval = 30 * time.Second
+	// userConfigurableOverridesRefreshInterval is the interval to refresh the user-configurable overrides in the status.
+	// The user-configurable overrides are managed via the Tempo API, therefore changes do not trigger a reconcile.
+	userConfigurableOverridesRefreshInterval = 5 * time.Minute
 )
 
+// runtimeConfigClient is used to query the loaded runtime configuration of the Tempo pods.
+var runtimeConfigClient = &http.Client{Timeout: 5 * time.Second}
+
 // TempoStackReconciler reconciles a TempoStack object.
 type TempoStackReconciler struct {
 	client.Client
@@ -49,6 +69,8 @@ type TempoStackReconciler struct {
 	Recorder   events.EventRecorder
 	CtrlConfig configv1alpha1.ProjectConfig
 	Version    version.Version
+	// MetadataCache watches the metadata of the referenced Secrets, see NewMetadataCache.
+	MetadataCache cache.Cache
 }
 
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
@@ -59,14 +81,16 @@ type TempoStackReconciler struct {
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings;clusterroles;rolebindings;roles,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=metrics.k8s.io,resources=pods,verbs=create;get
-// +kubebuilder:rbac:groups=route.openshift.io,resources=routes;routes/custom-host,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=route.openshift.io,resources=routes;routes/custom-host,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operator.openshift.io,resources=ingresscontrollers,verbs=get;list;watch
 // +kubebuilder:rbac:groups=config.openshift.io,resources=apiservers;dnses,verbs=get;list;watch
 // +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors;prometheusrules,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=grafana.integreatly.org,resources=grafanadatasources,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=grafana.integreatly.org,resources=grafanadatasources;grafanadashboards,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch
+// The traces ClusterRoles of the kubernetes tenants mode can only be created if the operator holds their permissions.
+// +kubebuilder:rbac:groups=tempo.grafana.com,resources=*,resourceNames=traces,verbs=get;create
 
 // Upgrate for 0.11.0 to Tempo 2.5
 // +kubebuilder:rbac:groups="core",resources=persistentvolumeclaims,verbs=list;watch
@@ -75,7 +99,7 @@ type TempoStackReconciler struct {
 //+kubebuilder:rbac:groups=tempo.grafana.com,resources=tempostacks,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=tempo.grafana.com,resources=tempostacks/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=tempo.grafana.com,resources=tempostacks/finalizers,verbs=update
-// +kubebuilder:rbac:groups=cloudcredential.openshift.io,resources=credentialsrequests,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=cloudcredential.openshift.io,resources=credentialsrequests,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -91,6 +115,7 @@ func (r *TempoStackReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		if apierrors.IsNotFound(err) {
 			// instance is not found, metrics can be cleared
 			status.ClearTempoStackMetrics(req.Namespace, req.Name)
+			metrics.Delete(metrics.Instance{Kind: metrics.KindTempoStack, Namespace: req.Namespace, Name: req.Name})
 
 			// we'll ignore not-found errors, since they can't be fixed by an immediate
 			// requeue (we'll need to wait for a new notification), and we can get them
@@ -116,8 +141,9 @@ func (r *TempoStackReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	} else {
 		// The object is being deleted
 		if controllerutil.ContainsFinalizer(&tempo, v1alpha1.TempoFinalizer) {
-			// our finalizer is present, so let's handle any external dependency
-			if tempo.Spec.ManagementState != v1alpha1.ManagementStateUnmanaged {
+			// our finalizer is present, so let's handle any external dependency.
+			// A namespace-scoped operator does not create cluster-scoped objects.
+			if tempo.Spec.ManagementState != v1alpha1.ManagementStateUnmanaged && !r.CtrlConfig.IsNamespaceScoped() {
 				if err := finalize(ctx, r.Client, log, manifestutils.ClusterScopedCommonLabels(tempo.ObjectMeta)); err != nil {
 					// if fail to delete the external dependency here, return with error
 					// so that it can be retried.
@@ -144,6 +170,15 @@ func (r *TempoStackReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
+	instance := metrics.Instance{Kind: metrics.KindTempoStack, Namespace: tempo.Namespace, Name: tempo.Name}
+	reconcileStart := time.Now()
+	result, err := r.reconcileManaged(ctx, log, req, tempo)
+	metrics.ObserveReconcile(instance, time.Since(reconcileStart), err)
+	return result, err
+}
+
+// reconcileManaged reconciles a managed TempoStack instance.
+func (r *TempoStackReconciler) reconcileManaged(ctx context.Context, log logr.Logger, req ctrl.Request, tempo v1alpha1.TempoStack) (ctrl.Result, error) {
 	// New CRs with empty OperatorVersion are ignored, as they're already up-to-date.
 	// The versions will be set when the status field is refreshed.
 	if tempo.Status.OperatorVersion != "" && tempo.Status.OperatorVersion != r.Version.OperatorVersion {
@@ -186,16 +221,41 @@ func (r *TempoStackReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 //     Return a reconcile.TerminalError to indicate that human intervention is required
 //     to resolve this error, and that the reconciliation request should not be requeued.
 //
+//   - For FieldManagerConflictError: Set the status condition to Failed,
+//     the Reason to "FieldManagerConflict" and the message to the error message.
+//
 //   - For any other error: Set the status condition to Failed,
 //     the Reason to "FailedReconciliation" and the message to the error message.
-func (r *TempoStackReconciler) handleReconcileStatus(ctx context.Context, log logr.Logger, tempo v1alpha1.TempoStack, reconcileError error) (ctrl.Result, error) { //nolint:unparam
+func (r *TempoStackReconciler) handleReconcileStatus(ctx context.Context, log logr.Logger, tempo v1alpha1.TempoStack, reconcileError error) (ctrl.Result, error) {
 	// First refresh components
 	newStatus, rerr := status.GetComponentsStatus(ctx, r, tempo)
 	if rerr != nil {
 		log.Error(rerr, "could not get components status")
 	}
 
+	// Verify that the per-tenant overrides are loaded by Tempo.
+	// The overrides are reloaded at runtime, therefore the pods are not restarted when the overrides change.
+	overridesPending := false
+	if reconcileError == nil {
+		newStatus.TenantOverrides, overridesPending, rerr = status.TenantOverrides(ctx, r, runtimeConfigClient, tempo, r.CtrlConfig.Gates.HTTPEncryption)
+		if rerr != nil {
+			log.Error(rerr, "could not verify the per-tenant overrides")
+		}
+
+		userConfigurable, rerr := status.UserConfigurableOverrides(ctx, r, runtimeConfigClient, tempo, r.CtrlConfig.Gates.HTTPEncryption)
+		if rerr != nil {
+			log.Error(rerr, "could not get the user-configurable overrides")
+		}
+		if userConfigurable != nil {
+			if newStatus.TenantOverrides == nil {
+				newStatus.TenantOverrides = &v1alpha1.TenantOverridesStatus{}
+			}
+			newStatus.TenantOverrides.UserConfigurable = userConfigurable
+		}
+	}
+
 	var configurationError *status.ConfigurationError
+	var conflictError *status.FieldManagerConflictError
 	if reconcileError == nil {
 		// No error.
 	} else if errors.As(reconcileError, &configurationError) {
@@ -205,10 +265,19 @@ func (r *TempoStackReconciler) handleReconcileStatus(ctx context.Context, log lo
 			Reason:  string(configurationError.Reason),
 			Message: configurationError.Message,
 		})
+		recordEvent(r.Recorder, &tempo, nil, corev1.EventTypeWarning, string(configurationError.Reason), eventrecorder.ActionReconcile, "%s", configurationError.Message)
 
 		// wrap error in reconcile.TerminalError to indicate human intervention is required
 		// and the request should not be requeued.
 		reconcileError = reconcile.TerminalError(configurationError)
+	} else if errors.As(reconcileError, &conflictError) {
+		// Handle conflicts with other field managers (server-side apply).
+		// The request is requeued, the other field manager might release the fields.
+		newStatus.Conditions = status.UpdateCondition(tempo, metav1.Condition{
+			Type:    string(v1alpha1.ConditionFailed),
+			Reason:  string(v1alpha1.ReasonFieldManagerConflict),
+			Message: reconcileError.Error(),
+		})
 	} else {
 		// Handle all other errors (e.g. permission errors, etc.)
 		newStatus.Conditions = status.UpdateCondition(tempo, metav1.Condition{
@@ -227,6 +296,12 @@ func (r *TempoStackReconciler) handleReconcileStatus(ctx context.Context, log lo
 	// Note: controller-runtime will always reconcile if this function returns any error except TerminalError.
 	// Result.Requeue and Result.RequeueAfter are only respected if err == nil
 	// https://github.com/kubernetes-sigs/controller-runtime/blob/v0.15.0/pkg/internal/controller/controller.go#L315-L341
+	if overridesPending {
+		return ctrl.Result{RequeueAfter: tenantOverridesRecheckInterval}, reconcileError
+	}
+	if tempo.Spec.UserConfigurableOverridesEnabled() {
+		return ctrl.Result{RequeueAfter: userConfigurableOverridesRefreshInterval}, reconcileError
+	}
 	return ctrl.Result{}, reconcileError
 }
 
@@ -257,16 +332,24 @@ func (r *TempoStackReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&appsv1.Deployment{}, updateOrDeleteWithStatusPred).
 		Owns(&networkingv1.Ingress{}, updateOrDeleteOnlyPred).
 		Owns(&policyv1.PodDisruptionBudget{}, updateOrDeleteOnlyPred).
-		Owns(&rbacv1.ClusterRole{}, updateOrDeleteOnlyPred).
-		Owns(&rbacv1.ClusterRoleBinding{}, updateOrDeleteOnlyPred).
 		Owns(&rbacv1.Role{}, updateOrDeleteOnlyPred).
 		Owns(&rbacv1.RoleBinding{}, updateOrDeleteOnlyPred).
+		WatchesRawSource(secretMetadataSource(r.MetadataCache, r.findTempoStackForStorageSecret)).
 		Watches(
-			&corev1.Secret{},
-			handler.EnqueueRequestsFromMapFunc(r.findTempoStackForStorageSecret),
-			createUpdateOrDeletePred,
+			&v1alpha1.TempoTenant{},
+			handler.EnqueueRequestsFromMapFunc(r.findTempoStacksForTempoTenant),
+			tempoTenantSpecOrLabelsChangedPred,
 		)
 
+	// A namespace-scoped operator does not manage cluster-scoped objects,
+	// and does not support selecting TempoTenants by namespace labels.
+	if !r.CtrlConfig.IsNamespaceScoped() {
+		builder = builder.
+			Owns(&rbacv1.ClusterRole{}, updateOrDeleteOnlyPred).
+			Owns(&rbacv1.ClusterRoleBinding{}, updateOrDeleteOnlyPred).
+			Watches(&corev1.Namespace{}, handler.Funcs{UpdateFunc: r.enqueueTempoStacksForNamespace})
+	}
+
 	if r.CtrlConfig.Gates.OpenShift.OpenShiftRoute {
 		builder = builder.Owns(&routev1.Route{}, updateOrDeleteOnlyPred)
 	}
@@ -278,6 +361,7 @@ func (r *TempoStackReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	if r.CtrlConfig.Gates.GrafanaOperator {
 		builder = builder.Owns(&grafanav1.GrafanaDatasource{}, updateOrDeleteOnlyPred)
+		builder = builder.Owns(&grafanav1.GrafanaDashboard{}, updateOrDeleteOnlyPred)
 	}
 
 	tokenCCOAuthEnv := cloudcredentials.DiscoverTokenCCOAuthConfig()
@@ -285,7 +369,7 @@ func (r *TempoStackReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		builder = builder.Owns(&cloudcredentialv1.CredentialsRequest{}, updateOrDeleteOnlyPred)
 	}
 
-	return builder.Complete(r)
+	return builder.Complete(tracing.NewReconciler("TempoStack", r))
 }
 
 func (r *TempoStackReconciler) findTempoStackForStorageSecret(ctx context.Context, secret client.Object) []reconcile.Request {
@@ -329,6 +413,55 @@ func (r *TempoStackReconciler) findTempoStackForStorageSecret(ctx context.Contex
 	return requests
 }
 
+// findTempoStacksForTempoTenant returns all TempoStacks which select TempoTenants.
+// The TempoStack controller decides if the TempoTenant is selected, as a change of the labels
+// can also deselect a TempoTenant.
+func (r *TempoStackReconciler) findTempoStacksForTempoTenant(ctx context.Context, _ client.Object) []reconcile.Request {
+	tempostacks := &v1alpha1.TempoStackList{}
+	if err := r.List(ctx, tempostacks); err != nil {
+		return []reconcile.Request{}
+	}
+
+	requests := []reconcile.Request{}
+	for _, item := range tempostacks.Items {
+		if tempotenant.Selector(item) == nil {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
+	}
+	return requests
+}
+
+// enqueueTempoStacksForNamespace enqueues the TempoStacks whose TempoTenant namespace selector matches the old
+// or the new labels of a Namespace, as a change of the labels can select or deselect the TempoTenants of the Namespace.
+// New Namespaces do not contain TempoTenants yet, and the TempoTenants of deleted Namespaces trigger a reconcile on their own.
+func (r *TempoStackReconciler) enqueueTempoStacksForNamespace(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	oldLabels, newLabels := labels.Set(e.ObjectOld.GetLabels()), labels.Set(e.ObjectNew.GetLabels())
+	if labels.Equals(oldLabels, newLabels) {
+		return
+	}
+
+	tempostacks := &v1alpha1.TempoStackList{}
+	if err := r.List(ctx, tempostacks); err != nil {
+		return
+	}
+	for _, item := range tempostacks.Items {
+		if tempotenant.SelectsNamespace(item, oldLabels) || tempotenant.SelectsNamespace(item, newLabels) {
+			q.Add(reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      item.GetName(),
+					Namespace: item.GetNamespace(),
+				},
+			})
+		}
+	}
+}
+
 // GetPodsComponent is used for fetching component pod status and refreshing the status of the CR.
 func (r *TempoStackReconciler) GetPodsComponent(ctx context.Context, componentName string, stack v1alpha1.TempoStack) (*corev1.PodList, error) {
 	pods := &corev1.PodList{}
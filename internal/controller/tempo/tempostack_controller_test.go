@@ -14,10 +14,12 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -90,6 +92,34 @@ func createTempoCR(t *testing.T, nsn types.NamespacedName, storageSecret *corev1
 	require.NoError(t, err)
 }
 
+// eventsBufferSize is the buffer size of the fake event recorders,
+// the recorder blocks if the buffer is full.
+const eventsBufferSize = 1000
+
+// recordedEvents returns the Events recorded since the last call.
+func recordedEvents(recorder *events.FakeRecorder) []string {
+	var recorded []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			recorded = append(recorded, event)
+		default:
+			return recorded
+		}
+	}
+}
+
+// assertEvent asserts that one of the recorded Events starts with the prefix.
+func assertEvent(t *testing.T, recorded []string, prefix string) {
+	t.Helper()
+	for _, event := range recorded {
+		if strings.HasPrefix(event, prefix) {
+			return
+		}
+	}
+	assert.Fail(t, "event not recorded", "expected an event starting with %q, got %v", prefix, recorded)
+}
+
 func TestReconcile(t *testing.T) {
 	nsn := types.NamespacedName{Name: "reconcile-test", Namespace: "default"}
 	storageSecret := createSecret(t, nsn)
@@ -98,7 +128,7 @@ func TestReconcile(t *testing.T) {
 	reconciler := TempoStackReconciler{
 		Client:   k8sClient,
 		Scheme:   testScheme,
-		Recorder: events.NewFakeRecorder(1),
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
 		CtrlConfig: configv1alpha1.ProjectConfig{
 			Gates: configv1alpha1.FeatureGates{
 				TLSProfile: configv1alpha1.TLSProfileIntermediateType,
@@ -113,6 +143,12 @@ func TestReconcile(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, time.Duration(0), reconcile.RequeueAfter)
 
+	recorder := reconciler.Recorder.(*events.FakeRecorder)
+	recorded := recordedEvents(recorder)
+	assertEvent(t, recorded, "Normal Created Created ConfigMap tempo-reconcile-test")
+	assertEvent(t, recorded, "Normal Created Created StatefulSet tempo-reconcile-test-ingester")
+	assertEvent(t, recorded, "Normal Created Created Deployment tempo-reconcile-test-compactor")
+
 	// Check if objects of specific types were created and are managed by the operator
 	opts := []client.ListOption{
 		client.InNamespace(nsn.Namespace),
@@ -156,6 +192,19 @@ func TestReconcile(t *testing.T) {
 	}}, updatedTempo.Status.Conditions)
 	// make sure LastTransitionTime is recent
 	assert.InDelta(t, metav1.NewTime(time.Now()).Unix(), updatedTempo.Status.Conditions[0].LastTransitionTime.Unix(), 60)
+
+	// Scale the compactor and change the storage size of the ingester, which is an immutable field of the StatefulSet
+	updatedTempo.Spec.Template.Compactor.Replicas = ptr.To(int32(2))
+	updatedTempo.Spec.StorageSize = resource.MustParse("20Gi")
+	err = k8sClient.Update(context.Background(), &updatedTempo)
+	require.NoError(t, err)
+
+	_, err = reconciler.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	recorded = recordedEvents(recorder)
+	assertEvent(t, recorded, "Normal Updated Updated Deployment tempo-reconcile-test-compactor")
+	assertEvent(t, recorded, "Normal Recreated Deleted StatefulSet tempo-reconcile-test-ingester to change an immutable field")
 }
 
 func TestReadyToConfigurationError(t *testing.T) {
@@ -168,7 +217,7 @@ func TestReadyToConfigurationError(t *testing.T) {
 	reconciler := TempoStackReconciler{
 		Client:   k8sClient,
 		Scheme:   testScheme,
-		Recorder: events.NewFakeRecorder(1),
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
 		CtrlConfig: configv1alpha1.ProjectConfig{
 			Gates: configv1alpha1.FeatureGates{
 				TLSProfile: configv1alpha1.TLSProfileIntermediateType,
@@ -207,6 +256,10 @@ func TestReadyToConfigurationError(t *testing.T) {
 	reconcileResult, err = reconciler.Reconcile(context.Background(), req)
 	require.ErrorContains(t, err, "terminal error")
 
+	// Verify that the configuration error is recorded as Warning Event
+	assertEvent(t, recordedEvents(reconciler.Recorder.(*events.FakeRecorder)),
+		fmt.Sprintf("Warning %s \"endpoint\" field of storage secret must be a valid URL", v1alpha1.ReasonInvalidStorageConfig))
+
 	// Verify status conditions: Ready=false, ConfigurationError=true
 	updatedTempo2 := v1alpha1.TempoStack{}
 	err = k8sClient.Get(context.Background(), nsn, &updatedTempo2)
@@ -246,7 +299,7 @@ func TestConfigurationErrorToConfigurationError(t *testing.T) {
 	reconciler := TempoStackReconciler{
 		Client:   k8sClient,
 		Scheme:   testScheme,
-		Recorder: events.NewFakeRecorder(1),
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
 		CtrlConfig: configv1alpha1.ProjectConfig{
 			Gates: configv1alpha1.FeatureGates{
 				TLSProfile: configv1alpha1.TLSProfileIntermediateType,
@@ -314,7 +367,7 @@ func TestConfigurationErrorToReady(t *testing.T) {
 	reconciler := TempoStackReconciler{
 		Client:   k8sClient,
 		Scheme:   testScheme,
-		Recorder: events.NewFakeRecorder(1),
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
 		CtrlConfig: configv1alpha1.ProjectConfig{
 			Gates: configv1alpha1.FeatureGates{
 				TLSProfile: configv1alpha1.TLSProfileIntermediateType,
@@ -386,7 +439,7 @@ func TestReconcileGenericError(t *testing.T) {
 	reconciler := TempoStackReconciler{
 		Client:   k8sClient,
 		Scheme:   testScheme,
-		Recorder: events.NewFakeRecorder(1),
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
 		CtrlConfig: configv1alpha1.ProjectConfig{
 			Gates: configv1alpha1.FeatureGates{
 				TLSProfile: configv1alpha1.TLSProfileIntermediateType,
@@ -416,12 +469,129 @@ func TestReconcileGenericError(t *testing.T) {
 	assert.Contains(t, updatedTempo.Status.Conditions[0].Message, "error listing routes: no kind is registered for the type v1.RouteList")
 }
 
+func TestReconcileServerSideApply(t *testing.T) {
+	nsn := types.NamespacedName{Name: "server-side-apply", Namespace: "default"}
+	storageSecret := createSecret(t, nsn)
+	createTempoCR(t, nsn, storageSecret)
+
+	reconciler := TempoStackReconciler{
+		Client:   k8sClient,
+		Scheme:   testScheme,
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
+		CtrlConfig: configv1alpha1.ProjectConfig{
+			Gates: configv1alpha1.FeatureGates{
+				TLSProfile:      configv1alpha1.TLSProfileIntermediateType,
+				ServerSideApply: true,
+			},
+		},
+		Version: version.Get(),
+	}
+	req := ctrl.Request{
+		NamespacedName: nsn,
+	}
+	_, err := reconciler.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	opts := []client.ListOption{
+		client.InNamespace(nsn.Namespace),
+		client.MatchingLabels(map[string]string{
+			"app.kubernetes.io/instance":   nsn.Name,
+			"app.kubernetes.io/managed-by": "tempo-operator",
+		}),
+	}
+	statefulSets := &appsv1.StatefulSetList{}
+	err = k8sClient.List(context.Background(), statefulSets, opts...)
+	require.NoError(t, err)
+	require.NotEmpty(t, statefulSets.Items)
+	deployments := &appsv1.DeploymentList{}
+	err = k8sClient.List(context.Background(), deployments, opts...)
+	require.NoError(t, err)
+	require.NotEmpty(t, deployments.Items)
+
+	resourceVersions := map[string]string{}
+	for _, sts := range statefulSets.Items {
+		require.Len(t, sts.ManagedFields, 1)
+		assert.Equal(t, fieldManager, sts.ManagedFields[0].Manager)
+		assert.Equal(t, metav1.ManagedFieldsOperationApply, sts.ManagedFields[0].Operation)
+		resourceVersions[sts.Name] = sts.ResourceVersion
+	}
+	for _, deployment := range deployments.Items {
+		resourceVersions[deployment.Name] = deployment.ResourceVersion
+	}
+
+	// A reconcile without any changes must not update the objects
+	_, err = reconciler.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	err = k8sClient.List(context.Background(), statefulSets, opts...)
+	require.NoError(t, err)
+	for _, sts := range statefulSets.Items {
+		assert.Equal(t, resourceVersions[sts.Name], sts.ResourceVersion, sts.Name)
+	}
+	err = k8sClient.List(context.Background(), deployments, opts...)
+	require.NoError(t, err)
+	for _, deployment := range deployments.Items {
+		assert.Equal(t, resourceVersions[deployment.Name], deployment.ResourceVersion, deployment.Name)
+	}
+}
+
+func TestReconcileServerSideApplyConflict(t *testing.T) {
+	nsn := types.NamespacedName{Name: "server-side-apply-conflict", Namespace: "default"}
+	storageSecret := createSecret(t, nsn)
+	createTempoCR(t, nsn, storageSecret)
+
+	reconciler := TempoStackReconciler{
+		Client:   k8sClient,
+		Scheme:   testScheme,
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
+		CtrlConfig: configv1alpha1.ProjectConfig{
+			Gates: configv1alpha1.FeatureGates{
+				TLSProfile:      configv1alpha1.TLSProfileIntermediateType,
+				ServerSideApply: true,
+			},
+		},
+		Version: version.Get(),
+	}
+	req := ctrl.Request{
+		NamespacedName: nsn,
+	}
+	_, err := reconciler.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	// Another controller (e.g. an autoscaler) takes over the replicas of the ingester
+	ingester := &appsv1.StatefulSet{}
+	err = k8sClient.Get(context.Background(), types.NamespacedName{Name: "tempo-server-side-apply-conflict-ingester", Namespace: nsn.Namespace}, ingester)
+	require.NoError(t, err)
+	ingester.Spec.Replicas = ptr.To(int32(3))
+	err = k8sClient.Update(context.Background(), ingester, client.FieldOwner("test-autoscaler"))
+	require.NoError(t, err)
+
+	_, err = reconciler.Reconcile(context.Background(), req)
+	require.Error(t, err)
+	var conflictErr *status.FieldManagerConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Equal(t, "StatefulSet", conflictErr.Kind)
+	assert.Equal(t, "tempo-server-side-apply-conflict-ingester", conflictErr.Name)
+	assertEvent(t, recordedEvents(reconciler.Recorder.(*events.FakeRecorder)),
+		"Warning FailedCreateOrUpdate Failed to configure StatefulSet tempo-server-side-apply-conflict-ingester")
+
+	updatedTempo := v1alpha1.TempoStack{}
+	err = k8sClient.Get(context.Background(), nsn, &updatedTempo)
+	require.NoError(t, err)
+	condition := meta.FindStatusCondition(updatedTempo.Status.Conditions, string(v1alpha1.ConditionFailed))
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, string(v1alpha1.ReasonFieldManagerConflict), condition.Reason)
+	assert.Contains(t, condition.Message, ".spec.replicas")
+	assert.Contains(t, condition.Message, "test-autoscaler")
+}
+
 func TestStorageCustomCA(t *testing.T) {
 	nsn := types.NamespacedName{Name: "custom-ca", Namespace: "default"}
 	reconciler := TempoStackReconciler{
 		Client:   k8sClient,
 		Scheme:   testScheme,
-		Recorder: events.NewFakeRecorder(1),
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
 		CtrlConfig: configv1alpha1.ProjectConfig{
 			Gates: configv1alpha1.FeatureGates{
 				TLSProfile: configv1alpha1.TLSProfileIntermediateType,
@@ -537,7 +707,7 @@ func TestTLSEnable(t *testing.T) {
 	reconciler := TempoStackReconciler{
 		Client:   k8sClient,
 		Scheme:   testScheme,
-		Recorder: events.NewFakeRecorder(1),
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
 		CtrlConfig: configv1alpha1.ProjectConfig{
 			Gates: configv1alpha1.FeatureGates{
 				BuiltInCertManagement: configv1alpha1.BuiltInCertManagement{
@@ -653,7 +823,7 @@ func TestPruneIngress(t *testing.T) {
 	reconciler := TempoStackReconciler{
 		Client:   k8sClient,
 		Scheme:   testScheme,
-		Recorder: events.NewFakeRecorder(1),
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
 		CtrlConfig: configv1alpha1.ProjectConfig{
 			Gates: configv1alpha1.FeatureGates{
 				TLSProfile: configv1alpha1.TLSProfileIntermediateType,
@@ -690,6 +860,7 @@ func TestPruneIngress(t *testing.T) {
 	err = k8sClient.Get(context.Background(), ingressNsn, &ingress)
 	require.Error(t, err)
 	require.True(t, apierrors.IsNotFound(err))
+	assertEvent(t, recordedEvents(reconciler.Recorder.(*events.FakeRecorder)), "Normal Pruned Deleted unmanaged Ingress tempo-prune-ingress-test-query-frontend")
 }
 
 func TestPruneMetricsGenerator(t *testing.T) {
@@ -727,7 +898,7 @@ func TestPruneMetricsGenerator(t *testing.T) {
 	reconciler := TempoStackReconciler{
 		Client:   k8sClient,
 		Scheme:   testScheme,
-		Recorder: events.NewFakeRecorder(1),
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
 		CtrlConfig: configv1alpha1.ProjectConfig{
 			Gates: configv1alpha1.FeatureGates{
 				TLSProfile: configv1alpha1.TLSProfileIntermediateType,
@@ -865,7 +1036,7 @@ func TestK8SGatewaySecret(t *testing.T) {
 	reconciler := TempoStackReconciler{
 		Client:   k8sClient,
 		Scheme:   testScheme,
-		Recorder: events.NewFakeRecorder(1),
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
 		CtrlConfig: configv1alpha1.ProjectConfig{
 			Gates: configv1alpha1.FeatureGates{
 				BuiltInCertManagement: configv1alpha1.BuiltInCertManagement{
@@ -966,7 +1137,7 @@ func TestOpenShiftMode_finalizer(t *testing.T) {
 	reconciler := TempoStackReconciler{
 		Client:   k8sClient,
 		Scheme:   testScheme,
-		Recorder: events.NewFakeRecorder(1),
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
 		CtrlConfig: configv1alpha1.ProjectConfig{
 			DefaultImages: configv1alpha1.ImagesSpec{
 				TempoGatewayOpa: "opa:latest",
@@ -1115,7 +1286,7 @@ func TestCertHashAnnotationsPropagatedToPodTemplates(t *testing.T) {
 	reconciler := TempoStackReconciler{
 		Client:   k8sClient,
 		Scheme:   testScheme,
-		Recorder: events.NewFakeRecorder(1),
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
 		CtrlConfig: configv1alpha1.ProjectConfig{
 			Gates: configv1alpha1.FeatureGates{
 				BuiltInCertManagement: configv1alpha1.BuiltInCertManagement{
@@ -1197,7 +1368,7 @@ func TestUpgrade(t *testing.T) {
 	reconciler := TempoStackReconciler{
 		Client:   k8sClient,
 		Scheme:   testScheme,
-		Recorder: events.NewFakeRecorder(1),
+		Recorder: events.NewFakeRecorder(eventsBufferSize),
 		CtrlConfig: configv1alpha1.ProjectConfig{
 			DefaultImages: configv1alpha1.ImagesSpec{
 				Tempo: "docker.io/grafana/tempo:1.5.0",
@@ -3,6 +3,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	grafanav1 "github.com/grafana/grafana-operator/v5/api/v1beta1"
 	routev1 "github.com/openshift/api/route/v1"
@@ -19,13 +20,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/controller/tempo/internal/metrics"
 	"github.com/grafana/tempo-operator/internal/handlers/storage"
+	"github.com/grafana/tempo-operator/internal/handlers/tempotenant"
 	"github.com/grafana/tempo-operator/internal/manifests"
 	"github.com/grafana/tempo-operator/internal/manifests/cloudcredentials"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/manifests/networkpolicies"
 	"github.com/grafana/tempo-operator/internal/status"
 	"github.com/grafana/tempo-operator/internal/tlsprofile"
+	"github.com/grafana/tempo-operator/internal/tracing"
 )
 
 func (r *TempoStackReconciler) createOrUpdate(ctx context.Context, tempo v1alpha1.TempoStack, certHashAnnotations map[string]string) error {
@@ -51,7 +55,7 @@ func (r *TempoStackReconciler) createOrUpdate(ctx context.Context, tempo v1alpha
 			return err
 		}
 
-		err = reconcileManagedObjects(ctx, r.Client, &tempo, r.Scheme, ccoObjects, ownedCCOObjects)
+		err = reconcileManagedObjects(ctx, r.Client, r.Recorder, r.CtrlConfig, &tempo, r.Scheme, ccoObjects, ownedCCOObjects)
 
 		if err != nil {
 			return err
@@ -71,7 +75,9 @@ func (r *TempoStackReconciler) createOrUpdate(ctx context.Context, tempo v1alpha
 	}
 
 	var errs field.ErrorList
-	params.StorageParams, errs = storage.GetStorageParamsForTempoStack(ctx, r.Client, tempo)
+	storageCtx, span := tracing.Start(ctx, "GetStorageParams")
+	params.StorageParams, errs = storage.GetStorageParamsForTempoStack(storageCtx, r.Client, tempo)
+	tracing.End(span, errs.ToAggregate())
 	params.StorageParams.CloudCredentials.Environment = tokenCCOAuthEnv
 
 	if len(errs) > 0 {
@@ -81,9 +87,30 @@ func (r *TempoStackReconciler) createOrUpdate(ctx context.Context, tempo v1alpha
 		}
 	}
 
+	// Add the TempoTenants selected by the TempoStack to the tenants.
+	// The status of the TempoTenants is updated by the TempoTenant controller.
+	var secretNamespaces map[string]string
+	if tempotenant.Selector(tempo) != nil {
+		tempoTenants, err := tempotenant.GetSelectedTempoTenants(ctx, r.Client, tempo)
+		if err != nil {
+			return err
+		}
+		merged := tempotenant.Merge(tempo, tempoTenants)
+		tempo = merged.TempoStack
+		params.Tempo = tempo
+		secretNamespaces = merged.SecretNamespaces
+
+		if tempo.Spec.Template.Gateway.Enabled && len(tempo.Spec.Tenants.Authentication) == 0 {
+			return &status.ConfigurationError{
+				Message: "No tenants configured: spec.tenants.authentication is empty and no TempoTenant is accepted",
+				Reason:  v1alpha1.ReasonInvalidTenantsConfiguration,
+			}
+		}
+	}
+
 	if tempo.Spec.Tenants != nil {
 		var err error
-		params.GatewayTenantSecret, params.GatewayTenantsData, err = getTenantParams(ctx, r.Client, &r.CtrlConfig, tempo.Namespace, tempo.Name, *tempo.Spec.Tenants, tempo.Spec.Template.Gateway.Enabled)
+		params.GatewayTenantSecret, params.GatewayTenantsData, err = getTenantParams(ctx, r.Client, &r.CtrlConfig, tempo.Namespace, tempo.Name, *tempo.Spec.Tenants, tempo.Spec.Template.Gateway.Enabled, secretNamespaces)
 		if err != nil {
 			return err
 		}
@@ -108,11 +135,16 @@ func (r *TempoStackReconciler) createOrUpdate(ctx context.Context, tempo v1alpha
 	// Discover Kubernetes API server endpoints for NetworkPolicies
 	params.KubeAPIServer = networkpolicies.DiscoverKubernetesAPIServer(ctx, r.Client)
 
+	_, span = tracing.Start(ctx, "BuildManifests")
+	buildStart := time.Now()
 	managedObjects, err := manifests.BuildAll(params)
+	metrics.ObserveBuild(metrics.Instance{Kind: metrics.KindTempoStack, Namespace: tempo.Namespace, Name: tempo.Name}, time.Since(buildStart))
+	tracing.End(span, err)
 	// TODO (pavolloffay) check error type and change return appropriately
 	if err != nil {
 		return fmt.Errorf("error building manifests: %w", err)
 	}
+	managedObjects = withoutClusterScopedObjects(ctx, r.CtrlConfig, managedObjects)
 
 	// Collect all objects owned by the operator, to be able to prune objects
 	// which exist in the cluster but are not managed by the operator anymore.
@@ -123,7 +155,7 @@ func (r *TempoStackReconciler) createOrUpdate(ctx context.Context, tempo v1alpha
 		return err
 	}
 
-	err = reconcileManagedObjects(ctx, r.Client, &tempo, r.Scheme, managedObjects, ownedObjects)
+	err = reconcileManagedObjects(ctx, r.Client, r.Recorder, r.CtrlConfig, &tempo, r.Scheme, managedObjects, ownedObjects)
 	if err != nil {
 		return err
 	}
@@ -241,24 +273,26 @@ func (r *TempoStackReconciler) findObjectsOwnedByTempoOperator(ctx context.Conte
 		ownedObjects[roleBindingList.Items[i].GetUID()] = &roleBindingList.Items[i]
 	}
 
-	// TokenReview and SubjectAccessReview when gateway is configured with multi-tenancy in OpenShift mode
-	clusterRoleList := &rbacv1.ClusterRoleList{}
-	err = r.List(ctx, clusterRoleList, clusterWideListOps)
-	if err != nil {
-		return nil, fmt.Errorf("error listing cluster roles: %w", err)
-	}
-	for i := range clusterRoleList.Items {
-		ownedObjects[clusterRoleList.Items[i].GetUID()] = &clusterRoleList.Items[i]
-	}
+	// TokenReview and SubjectAccessReview when gateway is configured with multi-tenancy in OpenShift mode.
+	// A namespace-scoped operator does not manage cluster-scoped objects.
+	if !r.CtrlConfig.IsNamespaceScoped() {
+		clusterRoleList := &rbacv1.ClusterRoleList{}
+		err = r.List(ctx, clusterRoleList, clusterWideListOps)
+		if err != nil {
+			return nil, fmt.Errorf("error listing cluster roles: %w", err)
+		}
+		for i := range clusterRoleList.Items {
+			ownedObjects[clusterRoleList.Items[i].GetUID()] = &clusterRoleList.Items[i]
+		}
 
-	// TokenReview and SubjectAccessReview when gateway is configured with multi-tenancy in OpenShift mode
-	clusterRoleBindingList := &rbacv1.ClusterRoleBindingList{}
-	err = r.List(ctx, clusterRoleBindingList, clusterWideListOps)
-	if err != nil {
-		return nil, fmt.Errorf("error listing cluster role bindings: %w", err)
-	}
-	for i := range clusterRoleBindingList.Items {
-		ownedObjects[clusterRoleBindingList.Items[i].GetUID()] = &clusterRoleBindingList.Items[i]
+		clusterRoleBindingList := &rbacv1.ClusterRoleBindingList{}
+		err = r.List(ctx, clusterRoleBindingList, clusterWideListOps)
+		if err != nil {
+			return nil, fmt.Errorf("error listing cluster role bindings: %w", err)
+		}
+		for i := range clusterRoleBindingList.Items {
+			ownedObjects[clusterRoleBindingList.Items[i].GetUID()] = &clusterRoleBindingList.Items[i]
+		}
 	}
 
 	if r.CtrlConfig.Gates.PrometheusOperator {
@@ -301,6 +335,15 @@ func (r *TempoStackReconciler) findObjectsOwnedByTempoOperator(ctx context.Conte
 		for i := range datasourceList.Items {
 			ownedObjects[datasourceList.Items[i].GetUID()] = &datasourceList.Items[i]
 		}
+
+		dashboardList := &grafanav1.GrafanaDashboardList{}
+		err = r.List(ctx, dashboardList, listOps)
+		if err != nil {
+			return nil, fmt.Errorf("error listing dashboards: %w", err)
+		}
+		for i := range dashboardList.Items {
+			ownedObjects[dashboardList.Items[i].GetUID()] = &dashboardList.Items[i]
+		}
 	}
 
 	return ownedObjects, nil
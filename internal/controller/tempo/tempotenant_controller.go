@@ -0,0 +1,163 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/handlers/tempotenant"
+)
+
+// tempoTenantSpecOrLabelsChangedPred only lets events through which can change the selection or the content of a TempoTenant.
+// In particular, status updates of the TempoTenant are ignored.
+var tempoTenantSpecOrLabelsChangedPred = ctrlbuilder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}))
+
+// TempoTenantReconciler reports which TempoStacks serve a TempoTenant in the TempoTenant status.
+// The tenants are added to the TempoStacks by the TempoStack controller.
+type TempoTenantReconciler struct {
+	client.Client
+}
+
+// +kubebuilder:rbac:groups=tempo.grafana.com,resources=tempotenants,verbs=get;list;watch
+// +kubebuilder:rbac:groups=tempo.grafana.com,resources=tempotenants/status,verbs=get;update;patch
+
+// Reconcile updates the status of a TempoTenant.
+func (r *TempoTenantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx).WithName("tempotenant-reconcile")
+
+	tenant := v1alpha1.TempoTenant{}
+	if err := r.Get(ctx, req.NamespacedName, &tenant); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("could not fetch TempoTenant: %w", err)
+	}
+
+	if !tenant.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	tempostacks := &v1alpha1.TempoStackList{}
+	if err := r.List(ctx, tempostacks); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error listing TempoStacks: %w", err)
+	}
+
+	var accepted []string
+	var rejected []string
+	for _, stack := range tempostacks.Items {
+		if tempotenant.Selector(stack) == nil {
+			continue
+		}
+
+		selected, err := tempotenant.GetSelectedTempoTenants(ctx, r.Client, stack)
+		if err != nil {
+			log.Error(err, "could not get selected TempoTenants", "tempostack", client.ObjectKeyFromObject(&stack))
+			continue
+		}
+
+		stackName := fmt.Sprintf("%s/%s", stack.Namespace, stack.Name)
+		result := tempotenant.Merge(stack, selected)
+		if reason, ok := result.Rejected[req.NamespacedName]; ok {
+			rejected = append(rejected, fmt.Sprintf("%s: %s", stackName, reason))
+		}
+		for _, key := range result.Accepted {
+			if key == req.NamespacedName {
+				accepted = append(accepted, stackName)
+			}
+		}
+	}
+
+	newStatus := tenantStatus(tenant, accepted, rejected)
+	if equality.Semantic.DeepEqual(newStatus, tenant.Status) {
+		return ctrl.Result{}, nil
+	}
+
+	tenant.Status = newStatus
+	if err := r.Status().Update(ctx, &tenant); err != nil {
+		return ctrl.Result{}, fmt.Errorf("could not update TempoTenant status: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+// tenantStatus returns the status of a TempoTenant, given the TempoStacks which accepted or rejected the TempoTenant.
+func tenantStatus(tenant v1alpha1.TempoTenant, accepted []string, rejected []string) v1alpha1.TempoTenantStatus {
+	sort.Strings(accepted)
+	sort.Strings(rejected)
+
+	status := *tenant.Status.DeepCopy()
+	status.TempoStacks = accepted
+
+	condition := metav1.Condition{
+		Type:               string(v1alpha1.ConditionAccepted),
+		ObservedGeneration: tenant.Generation,
+	}
+	switch {
+	case len(rejected) > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(v1alpha1.ReasonTenantConflict)
+		condition.Message = fmt.Sprintf("Rejected by TempoStacks: %s", strings.Join(rejected, "; "))
+	case len(accepted) > 0:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = string(v1alpha1.ReasonTenantAccepted)
+		condition.Message = fmt.Sprintf("Tenant is served by TempoStacks: %s", strings.Join(accepted, ", "))
+	default:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(v1alpha1.ReasonTenantNotSelected)
+		condition.Message = "No TempoStack selects this TempoTenant"
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+	return status
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TempoTenantReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("tempotenant").
+		For(&v1alpha1.TempoTenant{}, tempoTenantSpecOrLabelsChangedPred).
+		// A TempoTenant can conflict with other TempoTenants, e.g. if it uses the same tenant name.
+		Watches(
+			&v1alpha1.TempoTenant{},
+			handler.EnqueueRequestsFromMapFunc(r.findTempoTenants),
+			tempoTenantSpecOrLabelsChangedPred,
+		).
+		Watches(
+			&v1alpha1.TempoStack{},
+			handler.EnqueueRequestsFromMapFunc(r.findTempoTenants),
+			ctrlbuilder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Complete(r)
+}
+
+// findTempoTenants returns all TempoTenants, as a change of a TempoStack or TempoTenant
+// can select, deselect or reject any TempoTenant.
+func (r *TempoTenantReconciler) findTempoTenants(ctx context.Context, _ client.Object) []reconcile.Request {
+	tenants := &v1alpha1.TempoTenantList{}
+	if err := r.List(ctx, tenants); err != nil {
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, 0, len(tenants.Items))
+	for _, item := range tenants.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
+	}
+	return requests
+}
@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+func tenantStack(name string, authentication ...v1alpha1.AuthenticationSpec) *v1alpha1.TempoStack {
+	return &v1alpha1.TempoStack{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "observability"},
+		Spec: v1alpha1.TempoStackSpec{
+			Tenants: &v1alpha1.TenantsSpec{
+				Mode:                v1alpha1.ModeStatic,
+				Authentication:      authentication,
+				TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{},
+			},
+		},
+	}
+}
+
+func TestTempoTenantStatus(t *testing.T) {
+	tenant := &v1alpha1.TempoTenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "team-a",
+			Namespace:         "observability",
+			Generation:        2,
+			CreationTimestamp: metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		Spec: v1alpha1.TempoTenantSpec{
+			TenantName: "team-a",
+			TenantID:   "a",
+			OIDC: v1alpha1.OIDCSpec{
+				Secret: &v1alpha1.TenantSecretSpec{Name: "team-a-oidc"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		stacks      []client.Object
+		tempoStacks []string
+		status      metav1.ConditionStatus
+		reason      v1alpha1.ConditionReason
+		message     string
+	}{
+		{
+			name:    "not selected",
+			status:  metav1.ConditionFalse,
+			reason:  v1alpha1.ReasonTenantNotSelected,
+			message: "No TempoStack selects this TempoTenant",
+		},
+		{
+			name:        "accepted",
+			stacks:      []client.Object{tenantStack("b"), tenantStack("a")},
+			tempoStacks: []string{"observability/a", "observability/b"},
+			status:      metav1.ConditionTrue,
+			reason:      v1alpha1.ReasonTenantAccepted,
+			message:     "Tenant is served by TempoStacks: observability/a, observability/b",
+		},
+		{
+			name: "conflict",
+			stacks: []client.Object{
+				tenantStack("a"),
+				tenantStack("b", v1alpha1.AuthenticationSpec{TenantName: "team-a", TenantID: "other"}),
+			},
+			tempoStacks: []string{"observability/a"},
+			status:      metav1.ConditionFalse,
+			reason:      v1alpha1.ReasonTenantConflict,
+			message:     `Rejected by TempoStacks: observability/b: tenant name "team-a" is already in use`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			objs := append([]client.Object{tenant.DeepCopy()}, tc.stacks...)
+			r := &TempoTenantReconciler{
+				Client: fake.NewClientBuilder().
+					WithScheme(testScheme).
+					WithObjects(objs...).
+					WithStatusSubresource(&v1alpha1.TempoTenant{}).
+					Build(),
+			}
+
+			nsn := types.NamespacedName{Name: "team-a", Namespace: "observability"}
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: nsn})
+			require.NoError(t, err)
+
+			updated := &v1alpha1.TempoTenant{}
+			require.NoError(t, r.Get(context.Background(), nsn, updated))
+			assert.Equal(t, tc.tempoStacks, updated.Status.TempoStacks)
+
+			condition := meta.FindStatusCondition(updated.Status.Conditions, string(v1alpha1.ConditionAccepted))
+			require.NotNil(t, condition)
+			assert.Equal(t, tc.status, condition.Status)
+			assert.Equal(t, string(tc.reason), condition.Reason)
+			assert.Equal(t, tc.message, condition.Message)
+			assert.Equal(t, int64(2), condition.ObservedGeneration)
+		})
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
 )
 
 // Bootstrap configures the OpenTelemetry meter provider with the Prometheus exporter.
-func Bootstrap(client client.Client) error {
+func Bootstrap(client client.Client, ctrlConfig configv1alpha1.ProjectConfig) error {
 	exporter, err := prometheus.New(prometheus.WithRegisterer(metrics.Registry))
 	if err != nil {
 		return err
@@ -19,5 +21,9 @@ func Bootstrap(client client.Client) error {
 	// Create metrics
 	tempoStackMetrics := newTempoStackMetrics(client)
 	err = tempoStackMetrics.Setup()
-	return err
+	if err != nil {
+		return err
+	}
+	tempoMonolithicMetrics := newTempoMonolithicMetrics(client, ctrlConfig)
+	return tempoMonolithicMetrics.Setup()
 }
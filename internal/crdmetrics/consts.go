@@ -7,9 +7,18 @@ const (
 // Metric labels
 
 const (
-	tempoStackMetricsPrefix = "tempo_operator_tempostack"
-	storageBackendMetric    = "storage_backend"
-	managedMetric           = "managed"
-	jaegerUIUsage           = "jaeger_ui"
-	multitenancy            = "multi_tenancy"
+	tempoStackMetricsPrefix      = "tempo_operator_tempostack"
+	tempoMonolithicMetricsPrefix = "tempo_operator_tempomonolithic"
+	storageBackendMetric         = "storage_backend"
+	managedMetric                = "managed"
+	jaegerUIUsage                = "jaeger_ui"
+	jaegerUIRouteUsage           = "jaeger_ui_route"
+	multitenancy                 = "multi_tenancy"
+	sizeMetric                   = "size"
+	metricsGeneratorUsage        = "metrics_generator"
+	credentialModeMetric         = "credential_mode"
+	gatewayUsage                 = "gateway"
+	otlpGRPCIngestion            = "ingestion_otlp_grpc"
+	otlpHTTPIngestion            = "ingestion_otlp_http"
+	mcpServerUsage               = "mcp_server"
 )
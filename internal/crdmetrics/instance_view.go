@@ -1,6 +1,8 @@
 package crdmetrics
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,7 +41,11 @@ func (i *instancesView) Report(observer metric.Observer) {
 	}
 }
 
-func newObservation(meter metric.Meter, name, desc, label string, keyFn countFn) (instancesView, error) {
+func instanceMetricName(prefix string, name string) string {
+	return fmt.Sprintf("%s_%s", prefix, name)
+}
+
+func newObservation(meter metric.Meter, prefix, name, desc, label string, keyFn countFn) (instancesView, error) {
 	observation := instancesView{
 		Name:  name,
 		Count: make(map[string]int),
@@ -47,7 +53,7 @@ func newObservation(meter metric.Meter, name, desc, label string, keyFn countFn)
 		Label: label,
 	}
 
-	g, err := meter.Int64ObservableGauge(instanceMetricName(name), metric.WithDescription(desc))
+	g, err := meter.Int64ObservableGauge(instanceMetricName(prefix, name), metric.WithDescription(desc))
 	if err != nil {
 		return instancesView{}, err
 	}
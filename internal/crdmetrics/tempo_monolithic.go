@@ -0,0 +1,184 @@
+package crdmetrics
+
+import (
+	"context"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+type tempoMonolithicMetrics struct {
+	client       client.Client
+	ctrlConfig   configv1alpha1.ProjectConfig
+	observations []instancesView
+}
+
+func newTempoMonolithicMetrics(client client.Client, ctrlConfig configv1alpha1.ProjectConfig) *tempoMonolithicMetrics {
+	return &tempoMonolithicMetrics{
+		client:     client,
+		ctrlConfig: ctrlConfig,
+	}
+}
+
+// ingestionState returns disabled, plaintext or tls for an ingestion protocol.
+func ingestionState(enabled bool, tls *v1alpha1.TLSSpec) string {
+	switch {
+	case !enabled:
+		return "disabled"
+	case tls != nil && tls.Enabled:
+		return "tls"
+	default:
+		return "plaintext"
+	}
+}
+
+func (i *tempoMonolithicMetrics) Setup() error {
+	meter := otel.Meter(meterName)
+
+	obs, err := newObservation(meter, tempoMonolithicMetricsPrefix,
+		storageBackendMetric,
+		"Number of instances per storage backend",
+		"type",
+		func(instance client.Object) (string, bool) {
+			tempo := instance.(*v1alpha1.TempoMonolithic)
+			return string(tempo.Spec.Storage.Traces.Backend), true
+		})
+	if err != nil {
+		return err
+	}
+	i.observations = append(i.observations, obs)
+
+	obs, err = newObservation(meter, tempoMonolithicMetricsPrefix,
+		managedMetric,
+		"Instances managed by the operator",
+		"state",
+		func(instance client.Object) (string, bool) {
+			tempo := instance.(*v1alpha1.TempoMonolithic)
+			return string(tempo.Spec.Management), true
+		})
+	if err != nil {
+		return err
+	}
+	i.observations = append(i.observations, obs)
+
+	obs, err = newObservation(meter, tempoMonolithicMetricsPrefix,
+		otlpGRPCIngestion,
+		"Instances with OTLP/gRPC ingestion disabled/plaintext/tls",
+		"state",
+		func(instance client.Object) (string, bool) {
+			grpc := instance.(*v1alpha1.TempoMonolithic).Spec.Ingestion.OTLP.GRPC
+			return ingestionState(grpc.Enabled, grpc.TLS), true
+		})
+	if err != nil {
+		return err
+	}
+	i.observations = append(i.observations, obs)
+
+	obs, err = newObservation(meter, tempoMonolithicMetricsPrefix,
+		otlpHTTPIngestion,
+		"Instances with OTLP/HTTP ingestion disabled/plaintext/tls",
+		"state",
+		func(instance client.Object) (string, bool) {
+			http := instance.(*v1alpha1.TempoMonolithic).Spec.Ingestion.OTLP.HTTP
+			return ingestionState(http.Enabled, http.TLS), true
+		})
+	if err != nil {
+		return err
+	}
+	i.observations = append(i.observations, obs)
+
+	obs, err = newObservation(meter, tempoMonolithicMetricsPrefix,
+		multitenancy,
+		"Instances with multi-tenancy mode static/openshift/disabled",
+		"type",
+		func(instance client.Object) (string, bool) {
+			tempo := instance.(*v1alpha1.TempoMonolithic)
+			if tempo.Spec.Multitenancy != nil && tempo.Spec.Multitenancy.Enabled && tempo.Spec.Multitenancy.Mode != "" {
+				return string(tempo.Spec.Multitenancy.Mode), true
+			}
+			return "disabled", true
+		})
+	if err != nil {
+		return err
+	}
+	i.observations = append(i.observations, obs)
+
+	obs, err = newObservation(meter, tempoMonolithicMetricsPrefix,
+		jaegerUIUsage,
+		"Instances with jaeger UI enabled/disabled",
+		"enabled",
+		func(instance client.Object) (string, bool) {
+			tempo := instance.(*v1alpha1.TempoMonolithic)
+			return strconv.FormatBool(tempo.Spec.JaegerUI != nil && tempo.Spec.JaegerUI.Enabled), true
+		})
+	if err != nil {
+		return err
+	}
+	i.observations = append(i.observations, obs)
+
+	obs, err = newObservation(meter, tempoMonolithicMetricsPrefix,
+		jaegerUIRouteUsage,
+		"Instances with jaeger UI route enabled/disabled",
+		"enabled",
+		func(instance client.Object) (string, bool) {
+			tempo := instance.(*v1alpha1.TempoMonolithic)
+			jaegerUI := tempo.Spec.JaegerUI
+			return strconv.FormatBool(jaegerUI != nil && jaegerUI.Enabled && jaegerUI.Route != nil && jaegerUI.Route.Enabled), true
+		})
+	if err != nil {
+		return err
+	}
+	i.observations = append(i.observations, obs)
+
+	obs, err = newObservation(meter, tempoMonolithicMetricsPrefix,
+		mcpServerUsage,
+		"Instances with MCP server enabled/disabled",
+		"enabled",
+		func(instance client.Object) (string, bool) {
+			tempo := instance.(*v1alpha1.TempoMonolithic)
+			return strconv.FormatBool(tempo.Spec.Query != nil && tempo.Spec.Query.MCPServer != nil && tempo.Spec.Query.MCPServer.Enabled), true
+		})
+	if err != nil {
+		return err
+	}
+	i.observations = append(i.observations, obs)
+
+	instruments := make([]metric.Observable, 0, len(i.observations))
+	for _, o := range i.observations {
+		instruments = append(instruments, o.Gauge)
+	}
+	_, err = meter.RegisterCallback(i.callback, instruments...)
+	return err
+}
+
+func (i *tempoMonolithicMetrics) callback(ctx context.Context, observer metric.Observer) error {
+	instances := &v1alpha1.TempoMonolithicList{}
+	if err := i.client.List(ctx, instances); err == nil {
+
+		// Reset observations
+		for _, o := range i.observations {
+			o.reset()
+		}
+
+		for k := range instances.Items {
+			tempo := instances.Items[k]
+			// The defaults are not stored in the cluster, apply them to report the effective settings.
+			tempo.Default(i.ctrlConfig)
+			for _, o := range i.observations {
+				o.Record(&tempo)
+			}
+		}
+	}
+
+	// Report metrics
+	for _, o := range i.observations {
+		o.Report(observer)
+	}
+
+	return nil
+}
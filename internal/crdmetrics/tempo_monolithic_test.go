@@ -0,0 +1,124 @@
+package crdmetrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+func newExpectedMonolithicMetric(name string, keyPair attribute.KeyValue, value int64) expectedMetric {
+	return expectedMetric{
+		name:   instanceMetricName(tempoMonolithicMetricsPrefix, name),
+		labels: []attribute.KeyValue{keyPair},
+		value:  value,
+	}
+}
+
+func TestMonolithicObservedMetrics(t *testing.T) {
+	s := scheme.Scheme
+	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.TempoMonolithic{}, &v1alpha1.TempoMonolithicList{})
+
+	defaults := v1alpha1.TempoMonolithic{
+		ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: "test"},
+	}
+	s3 := v1alpha1.TempoMonolithic{
+		ObjectMeta: metav1.ObjectMeta{Name: "s3", Namespace: "test"},
+		Spec: v1alpha1.TempoMonolithicSpec{
+			Management: v1alpha1.ManagementStateUnmanaged,
+			Storage: &v1alpha1.MonolithicStorageSpec{
+				Traces: v1alpha1.MonolithicTracesStorageSpec{Backend: v1alpha1.MonolithicTracesStorageBackendS3},
+			},
+			Ingestion: &v1alpha1.MonolithicIngestionSpec{
+				OTLP: &v1alpha1.MonolithicIngestionOTLPSpec{
+					GRPC: &v1alpha1.MonolithicIngestionOTLPProtocolsGRPCSpec{
+						Enabled: true,
+						TLS:     &v1alpha1.TLSSpec{Enabled: true},
+					},
+					HTTP: &v1alpha1.MonolithicIngestionOTLPProtocolsHTTPSpec{Enabled: false},
+				},
+			},
+			JaegerUI: &v1alpha1.MonolithicJaegerUISpec{
+				Enabled: true,
+				Route:   &v1alpha1.MonolithicJaegerUIRouteSpec{Enabled: true},
+			},
+			Multitenancy: &v1alpha1.MonolithicMultitenancySpec{
+				Enabled:     true,
+				TenantsSpec: v1alpha1.TenantsSpec{Mode: v1alpha1.ModeOpenShift},
+			},
+			Query: &v1alpha1.MonolithicQuerySpec{
+				MCPServer: &v1alpha1.MCPServerSpec{Enabled: true},
+			},
+		},
+	}
+	jaegerUI := v1alpha1.TempoMonolithic{
+		ObjectMeta: metav1.ObjectMeta{Name: "jaeger-ui", Namespace: "test"},
+		Spec: v1alpha1.TempoMonolithicSpec{
+			JaegerUI: &v1alpha1.MonolithicJaegerUISpec{Enabled: true},
+		},
+	}
+
+	objs := []runtime.Object{&defaults, &s3, &jaegerUI}
+	expected := []expectedMetric{
+		newExpectedMonolithicMetric(storageBackendMetric, attribute.String("type", string(v1alpha1.MonolithicTracesStorageBackendMemory)), 2),
+		newExpectedMonolithicMetric(storageBackendMetric, attribute.String("type", string(v1alpha1.MonolithicTracesStorageBackendS3)), 1),
+		newExpectedMonolithicMetric(managedMetric, attribute.String("state", string(v1alpha1.ManagementStateManaged)), 2),
+		newExpectedMonolithicMetric(managedMetric, attribute.String("state", string(v1alpha1.ManagementStateUnmanaged)), 1),
+		newExpectedMonolithicMetric(otlpGRPCIngestion, attribute.String("state", "plaintext"), 2),
+		newExpectedMonolithicMetric(otlpGRPCIngestion, attribute.String("state", "tls"), 1),
+		newExpectedMonolithicMetric(otlpHTTPIngestion, attribute.String("state", "plaintext"), 2),
+		newExpectedMonolithicMetric(otlpHTTPIngestion, attribute.String("state", "disabled"), 1),
+		newExpectedMonolithicMetric(multitenancy, attribute.String("type", "disabled"), 2),
+		newExpectedMonolithicMetric(multitenancy, attribute.String("type", string(v1alpha1.ModeOpenShift)), 1),
+		newExpectedMonolithicMetric(jaegerUIUsage, attribute.String("enabled", "true"), 2),
+		newExpectedMonolithicMetric(jaegerUIUsage, attribute.String("enabled", "false"), 1),
+		newExpectedMonolithicMetric(jaegerUIRouteUsage, attribute.String("enabled", "true"), 1),
+		newExpectedMonolithicMetric(jaegerUIRouteUsage, attribute.String("enabled", "false"), 2),
+		newExpectedMonolithicMetric(mcpServerUsage, attribute.String("enabled", "true"), 1),
+		newExpectedMonolithicMetric(mcpServerUsage, attribute.String("enabled", "false"), 2),
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
+
+	reader := metric.NewManualReader()
+	provider := metric.NewMeterProvider(metric.WithReader(reader))
+	otel.SetMeterProvider(provider)
+
+	err := newTempoMonolithicMetrics(cl, configv1alpha1.ProjectConfig{}).Setup()
+	require.NoError(t, err)
+
+	metrics := metricdata.ResourceMetrics{}
+	err = reader.Collect(context.Background(), &metrics)
+	require.NoError(t, err)
+	for _, e := range expected {
+		assertLabelAndValues(t, e.name, metrics, e.labels, e.value)
+	}
+
+	// Test deleting the S3 instance
+	err = cl.Delete(context.Background(), &s3)
+	require.NoError(t, err)
+
+	metrics = metricdata.ResourceMetrics{}
+	err = reader.Collect(context.Background(), &metrics)
+	require.NoError(t, err)
+
+	expected = []expectedMetric{
+		newExpectedMonolithicMetric(storageBackendMetric, attribute.String("type", string(v1alpha1.MonolithicTracesStorageBackendS3)), 0),
+		newExpectedMonolithicMetric(mcpServerUsage, attribute.String("enabled", "true"), 0),
+		newExpectedMonolithicMetric(mcpServerUsage, attribute.String("enabled", "false"), 2),
+	}
+	for _, e := range expected {
+		assertLabelAndValues(t, e.name, metrics, e.labels, e.value)
+	}
+}
@@ -2,7 +2,6 @@ package crdmetrics
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"go.opentelemetry.io/otel"
@@ -17,10 +16,6 @@ type tempoStackMetrics struct {
 	observations []instancesView
 }
 
-func instanceMetricName(name string) string {
-	return fmt.Sprintf("%s_%s", tempoStackMetricsPrefix, name)
-}
-
 func newTempoStackMetrics(client client.Client) *tempoStackMetrics {
 	return &tempoStackMetrics{
 		client: client,
@@ -30,7 +25,7 @@ func newTempoStackMetrics(client client.Client) *tempoStackMetrics {
 func (i *tempoStackMetrics) Setup() error {
 	meter := otel.Meter(meterName)
 
-	obs, err := newObservation(meter,
+	obs, err := newObservation(meter, tempoStackMetricsPrefix,
 		storageBackendMetric,
 		"Number of instances per storage type",
 		"type",
@@ -43,7 +38,7 @@ func (i *tempoStackMetrics) Setup() error {
 	}
 	i.observations = append(i.observations, obs)
 
-	obs, err = newObservation(meter,
+	obs, err = newObservation(meter, tempoStackMetricsPrefix,
 		managedMetric,
 		"Instances managed by the operator",
 		"state",
@@ -56,7 +51,7 @@ func (i *tempoStackMetrics) Setup() error {
 	}
 	i.observations = append(i.observations, obs)
 
-	obs, err = newObservation(meter,
+	obs, err = newObservation(meter, tempoStackMetricsPrefix,
 		jaegerUIUsage,
 		"Instances with jaeger UI enabled/disabled",
 		"enabled",
@@ -69,7 +64,7 @@ func (i *tempoStackMetrics) Setup() error {
 	}
 	i.observations = append(i.observations, obs)
 
-	obs, err = newObservation(meter,
+	obs, err = newObservation(meter, tempoStackMetricsPrefix,
 		multitenancy,
 		"Instances with multi-tenancy mode static/openshift/disabled",
 		"type",
@@ -85,6 +80,64 @@ func (i *tempoStackMetrics) Setup() error {
 	}
 	i.observations = append(i.observations, obs)
 
+	obs, err = newObservation(meter, tempoStackMetricsPrefix,
+		sizeMetric,
+		"Instances per size profile",
+		"size",
+		func(instance client.Object) (string, bool) {
+			tempoStack := instance.(*v1alpha1.TempoStack)
+			if tempoStack.Spec.Size == "" {
+				return "none", true
+			}
+			return string(tempoStack.Spec.Size), true
+		})
+	if err != nil {
+		return err
+	}
+	i.observations = append(i.observations, obs)
+
+	obs, err = newObservation(meter, tempoStackMetricsPrefix,
+		metricsGeneratorUsage,
+		"Instances with metrics-generator enabled/disabled",
+		"enabled",
+		func(instance client.Object) (string, bool) {
+			tempoStack := instance.(*v1alpha1.TempoStack)
+			return strconv.FormatBool(tempoStack.Spec.Template.MetricsGenerator.Enabled), true
+		})
+	if err != nil {
+		return err
+	}
+	i.observations = append(i.observations, obs)
+
+	obs, err = newObservation(meter, tempoStackMetricsPrefix,
+		credentialModeMetric,
+		"Instances per object storage credential mode",
+		"mode",
+		func(instance client.Object) (string, bool) {
+			tempoStack := instance.(*v1alpha1.TempoStack)
+			if tempoStack.Spec.Storage.Secret.CredentialMode == "" {
+				return "inferred", true
+			}
+			return string(tempoStack.Spec.Storage.Secret.CredentialMode), true
+		})
+	if err != nil {
+		return err
+	}
+	i.observations = append(i.observations, obs)
+
+	obs, err = newObservation(meter, tempoStackMetricsPrefix,
+		gatewayUsage,
+		"Instances with gateway enabled/disabled",
+		"enabled",
+		func(instance client.Object) (string, bool) {
+			tempoStack := instance.(*v1alpha1.TempoStack)
+			return strconv.FormatBool(tempoStack.Spec.Template.Gateway.Enabled), true
+		})
+	if err != nil {
+		return err
+	}
+	i.observations = append(i.observations, obs)
+
 	instruments := make([]metric.Observable, 0, len(i.observations))
 	for _, o := range i.observations {
 		instruments = append(instruments, o.Gauge)
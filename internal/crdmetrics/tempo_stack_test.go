@@ -112,7 +112,7 @@ func newTempoStackInstanceWithTenant(nsn types.NamespacedName,
 
 func newExpectedMetric(name string, keyPair attribute.KeyValue, value int64) expectedMetric {
 	return expectedMetric{
-		name: instanceMetricName(name),
+		name: instanceMetricName(tempoStackMetricsPrefix, name),
 		labels: []attribute.KeyValue{
 			keyPair,
 		},
@@ -131,6 +131,10 @@ func TestValueObservedMetrics(t *testing.T) {
 		Name:      "my-tempo-gcs",
 		Namespace: "test",
 	}, v1alpha1.ManagementStateManaged, v1alpha1.ObjectStorageSecretGCS, true)
+	tempoGCS.Spec.Size = v1alpha1.SizeSmall
+	tempoGCS.Spec.Storage.Secret.CredentialMode = v1alpha1.CredentialModeToken
+	tempoGCS.Spec.Template.MetricsGenerator.Enabled = true
+	tempoGCS.Spec.Template.Gateway.Enabled = true
 
 	tempoS3 := newTempoStackInstance(types.NamespacedName{
 		Name:      "my-jaeger-s3",
@@ -182,6 +186,14 @@ func TestValueObservedMetrics(t *testing.T) {
 		newExpectedMetric(multitenancy, attribute.String("type", string(v1alpha1.ModeStatic)), 1),
 		newExpectedMetric(jaegerUIUsage, attribute.String("enabled", "true"), 1),
 		newExpectedMetric(jaegerUIUsage, attribute.String("enabled", "false"), 6),
+		newExpectedMetric(sizeMetric, attribute.String("size", string(v1alpha1.SizeSmall)), 1),
+		newExpectedMetric(sizeMetric, attribute.String("size", "none"), 6),
+		newExpectedMetric(metricsGeneratorUsage, attribute.String("enabled", "true"), 1),
+		newExpectedMetric(metricsGeneratorUsage, attribute.String("enabled", "false"), 6),
+		newExpectedMetric(credentialModeMetric, attribute.String("mode", string(v1alpha1.CredentialModeToken)), 1),
+		newExpectedMetric(credentialModeMetric, attribute.String("mode", "inferred"), 6),
+		newExpectedMetric(gatewayUsage, attribute.String("enabled", "true"), 1),
+		newExpectedMetric(gatewayUsage, attribute.String("enabled", "false"), 6),
 	}
 
 	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
@@ -224,6 +236,8 @@ func TestValueObservedMetrics(t *testing.T) {
 		newExpectedMetric(multitenancy, attribute.String("type", string(v1alpha1.ModeStatic)), 1),
 		newExpectedMetric(jaegerUIUsage, attribute.String("enabled", "true"), 0),
 		newExpectedMetric(jaegerUIUsage, attribute.String("enabled", "false"), 6),
+		newExpectedMetric(sizeMetric, attribute.String("size", string(v1alpha1.SizeSmall)), 0),
+		newExpectedMetric(gatewayUsage, attribute.String("enabled", "true"), 0),
 	}
 	for _, e := range expected {
 		assertLabelAndValues(t, e.name, metrics, e.labels, e.value)
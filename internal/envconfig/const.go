@@ -57,7 +57,7 @@ const (
 	envLeaderElectionRetryPeriod = "LEADER_ELECTION_RETRY_PERIOD"
 )
 
-// Controller manager settings (metrics, health, webhook).
+// Controller manager settings (metrics, health, webhook, watched namespaces).
 const (
 	// envMetricsBindAddress sets the metrics server bind address (e.g., ":8080").
 	envMetricsBindAddress = "METRICS_BIND_ADDRESS"
@@ -67,6 +67,9 @@ const (
 	envHealthProbeBindAddress = "HEALTH_PROBE_BIND_ADDRESS"
 	// envWebhookPort sets the webhook server port (e.g., "9443").
 	envWebhookPort = "WEBHOOK_PORT"
+	// envWatchNamespaces restricts the operator to a comma-separated list of namespaces (e.g., "team-a,team-b").
+	// An empty value watches all namespaces.
+	envWatchNamespaces = "WATCH_NAMESPACES"
 )
 
 // =============================================================================
@@ -97,6 +100,7 @@ const (
 	// Other feature gates.
 	featureGateNetworkPolicies       = "networkPolicies"
 	featureGateBuiltInCertManagement = "builtInCertManagement"
+	featureGateServerSideApply       = "serverSideApply"
 )
 
 // =============================================================================
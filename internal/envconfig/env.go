@@ -70,6 +70,9 @@ var featureGates = []featureGateDef{
 	{featureGateBuiltInCertManagement, func(cfg *configv1alpha1.ProjectConfig, enabled bool) {
 		cfg.Gates.BuiltInCertManagement.Enabled = enabled
 	}},
+	{featureGateServerSideApply, func(cfg *configv1alpha1.ProjectConfig, enabled bool) {
+		cfg.Gates.ServerSideApply = enabled
+	}},
 }
 
 // featureGateSetters is a lookup map built from featureGates for efficient access.
@@ -133,7 +136,7 @@ func applyGatesEnvVars(cfg *configv1alpha1.ProjectConfig) {
 	}
 }
 
-// applyControllerManagerEnvVars applies metrics, health, and webhook env vars.
+// applyControllerManagerEnvVars applies metrics, health, webhook, and watched namespaces env vars.
 func applyControllerManagerEnvVars(cfg *configv1alpha1.ProjectConfig) {
 	if val, ok := os.LookupEnv(envMetricsBindAddress); ok {
 		cfg.Metrics.BindAddress = val
@@ -151,6 +154,9 @@ func applyControllerManagerEnvVars(cfg *configv1alpha1.ProjectConfig) {
 			setupLog.Error(err, "invalid value for environment variable, ignoring", "env", envWebhookPort, "value", val)
 		}
 	}
+	if val, ok := os.LookupEnv(envWatchNamespaces); ok {
+		cfg.WatchNamespaces = parseNamespaces(val)
+	}
 }
 
 // parseFeatureGate parses a feature gate string and returns the gate name and enabled state.
@@ -133,7 +133,7 @@ func TestApplyEnvVars(t *testing.T) {
 		{
 			name: "feature gates - all boolean gates",
 			envVars: map[string]string{
-				envFeatureGates: "openshift.route,openshift.servingCertsService,openshift.oauthProxy,httpEncryption,grpcEncryption,prometheusOperator,grafanaOperator,observability.metrics.createServiceMonitors,observability.metrics.createPrometheusRules,networkPolicies,builtInCertManagement",
+				envFeatureGates: "openshift.route,openshift.servingCertsService,openshift.oauthProxy,httpEncryption,grpcEncryption,prometheusOperator,grafanaOperator,observability.metrics.createServiceMonitors,observability.metrics.createPrometheusRules,networkPolicies,builtInCertManagement,serverSideApply",
 			},
 			initial: configv1alpha1.ProjectConfig{},
 			expected: configv1alpha1.ProjectConfig{
@@ -159,6 +159,7 @@ func TestApplyEnvVars(t *testing.T) {
 					BuiltInCertManagement: configv1alpha1.BuiltInCertManagement{
 						Enabled: true,
 					},
+					ServerSideApply: true,
 				},
 			},
 		},
@@ -522,6 +523,26 @@ func TestApplyEnvVars(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "watch namespaces",
+			envVars: map[string]string{
+				envWatchNamespaces: "team-a, team-b",
+			},
+			initial: configv1alpha1.ProjectConfig{},
+			expected: configv1alpha1.ProjectConfig{
+				WatchNamespaces: []string{"team-a", "team-b"},
+			},
+		},
+		{
+			name: "empty watch namespaces watches all namespaces",
+			envVars: map[string]string{
+				envWatchNamespaces: "",
+			},
+			initial: configv1alpha1.ProjectConfig{
+				WatchNamespaces: []string{"team-a"},
+			},
+			expected: configv1alpha1.ProjectConfig{},
+		},
 		{
 			name: "override existing metrics bind address",
 			envVars: map[string]string{
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -72,3 +73,17 @@ func parsePodSecurityContext(val string) (*corev1.PodSecurityContext, error) {
 	}
 	return psc, nil
 }
+
+// parseNamespaces parses a comma-separated list of namespaces.
+// Whitespace around the namespaces and empty entries are ignored.
+// Returns nil if the list is empty, i.e. all namespaces are watched.
+func parseNamespaces(val string) []string {
+	var namespaces []string
+	for namespace := range strings.SplitSeq(val, ",") {
+		namespace = strings.TrimSpace(namespace)
+		if namespace != "" {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces
+}
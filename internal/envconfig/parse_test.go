@@ -160,3 +160,38 @@ func TestParsePodSecurityContext(t *testing.T) {
 		})
 	}
 }
+
+func TestParseNamespaces(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "single namespace",
+			input:    "observability",
+			expected: []string{"observability"},
+		},
+		{
+			name:     "multiple namespaces",
+			input:    "team-a,team-b",
+			expected: []string{"team-a", "team-b"},
+		},
+		{
+			name:     "whitespace and empty entries",
+			input:    " team-a , ,team-b,",
+			expected: []string{"team-a", "team-b"},
+		},
+		{
+			name:     "empty list",
+			input:    "",
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, parseNamespaces(test.input))
+		})
+	}
+}
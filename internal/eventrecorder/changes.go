@@ -0,0 +1,75 @@
+package eventrecorder
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// maxChangedFields is the maximum number of changed fields listed in the note of an Event.
+const maxChangedFields = 10
+
+// ignoredFields are set by the API server and change with every update.
+var ignoredFields = map[string]bool{
+	"status":                     true,
+	"metadata.resourceVersion":   true,
+	"metadata.generation":        true,
+	"metadata.managedFields":     true,
+	"metadata.creationTimestamp": true,
+	"metadata.uid":               true,
+}
+
+// ChangedFields returns the sorted paths of the fields which differ between two versions of an object.
+// The paths are truncated after the second level, e.g. a change of a container image is reported as spec.template.
+func ChangedFields(before runtime.Object, after runtime.Object) ([]string, error) {
+	beforeContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(before)
+	if err != nil {
+		return nil, err
+	}
+	afterContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(after)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := changedFields(beforeContent, afterContent, "", 2)
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func changedFields(before map[string]interface{}, after map[string]interface{}, prefix string, depth int) []string {
+	fields := []string{}
+	keys := map[string]bool{}
+	for key := range before {
+		keys[key] = true
+	}
+	for key := range after {
+		keys[key] = true
+	}
+
+	for key := range keys {
+		path := prefix + key
+		if ignoredFields[path] || reflect.DeepEqual(before[key], after[key]) {
+			continue
+		}
+
+		beforeMap, beforeIsMap := before[key].(map[string]interface{})
+		afterMap, afterIsMap := after[key].(map[string]interface{})
+		if depth > 1 && beforeIsMap && afterIsMap {
+			fields = append(fields, changedFields(beforeMap, afterMap, path+".", depth-1)...)
+		} else {
+			fields = append(fields, path)
+		}
+	}
+	return fields
+}
+
+// SummarizeFields joins the changed fields for the note of an Event.
+func SummarizeFields(fields []string) string {
+	if len(fields) <= maxChangedFields {
+		return strings.Join(fields, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(fields[:maxChangedFields], ", "), len(fields)-maxChangedFields)
+}
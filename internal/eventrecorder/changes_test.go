@@ -0,0 +1,86 @@
+package eventrecorder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestChangedFields(t *testing.T) {
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "tempo-simplest-ingester",
+			ResourceVersion: "1",
+			Labels:          map[string]string{"app.kubernetes.io/name": "tempo"},
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(int32(1)),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "tempo", Image: "tempo:2.9.0"}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		update   func(*appsv1.StatefulSet)
+		expected []string
+	}{
+		{
+			name:     "no changes",
+			update:   func(*appsv1.StatefulSet) {},
+			expected: []string{},
+		},
+		{
+			name: "server-side fields are ignored",
+			update: func(sts *appsv1.StatefulSet) {
+				sts.ResourceVersion = "2"
+				sts.Generation = 2
+				sts.Status.Replicas = 1
+			},
+			expected: []string{},
+		},
+		{
+			name: "changed fields",
+			update: func(sts *appsv1.StatefulSet) {
+				sts.Labels["app.kubernetes.io/version"] = "2.9.1"
+				sts.Spec.Replicas = ptr.To(int32(2))
+				sts.Spec.Template.Spec.Containers[0].Image = "tempo:2.9.1"
+			},
+			expected: []string{"metadata.labels", "spec.replicas", "spec.template"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			updated := statefulSet.DeepCopy()
+			test.update(updated)
+
+			fields, err := ChangedFields(statefulSet, updated)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, fields)
+		})
+	}
+}
+
+func TestChangedFieldsConfigMap(t *testing.T) {
+	before := &corev1.ConfigMap{Data: map[string]string{"tempo.yaml": "a", "overrides.yaml": "b"}}
+	after := &corev1.ConfigMap{Data: map[string]string{"tempo.yaml": "c"}}
+
+	fields, err := ChangedFields(before, after)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"data.overrides.yaml", "data.tempo.yaml"}, fields)
+}
+
+func TestSummarizeFields(t *testing.T) {
+	assert.Equal(t, "data.tempo.yaml, spec.template", SummarizeFields([]string{"data.tempo.yaml", "spec.template"}))
+	assert.Equal(t, "a, b, c, d, e, f, g, h, i, j and 2 more",
+		SummarizeFields([]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"}))
+}
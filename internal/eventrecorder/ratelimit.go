@@ -0,0 +1,77 @@
+package eventrecorder
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+)
+
+const (
+	// DefaultBurst allows recording an Event for every object created in the first reconcile of an instance.
+	DefaultBurst = 50
+	// DefaultInterval is the interval after which another Event with the same reason can be recorded, once the burst is exhausted.
+	DefaultInterval = 10 * time.Second
+
+	// maxLimiters bounds the memory used for the rate limiters, the limiters are reset when the limit is reached.
+	maxLimiters = 4096
+)
+
+type limiterKey struct {
+	uid    types.UID
+	reason string
+}
+
+type rateLimitedRecorder struct {
+	recorder events.EventRecorder
+	limit    rate.Limit
+	burst    int
+
+	mu       sync.Mutex
+	limiters map[limiterKey]*rate.Limiter
+}
+
+// NewRateLimited returns an EventRecorder which drops the Events exceeding the rate limit.
+// The rate limit applies separately to each reason of each regarding object,
+// i.e. a series of updates cannot suppress a configuration error of the same instance.
+func NewRateLimited(recorder events.EventRecorder, interval time.Duration, burst int) events.EventRecorder {
+	return &rateLimitedRecorder{
+		recorder: recorder,
+		limit:    rate.Every(interval),
+		burst:    burst,
+		limiters: map[limiterKey]*rate.Limiter{},
+	}
+}
+
+// Eventf records the Event if the rate limit of the regarding object and reason is not exceeded.
+func (r *rateLimitedRecorder) Eventf(regarding runtime.Object, related runtime.Object, eventtype, reason, action, note string, args ...interface{}) {
+	if !r.allow(regarding, reason) {
+		return
+	}
+	r.recorder.Eventf(regarding, related, eventtype, reason, action, note, args...)
+}
+
+func (r *rateLimitedRecorder) allow(regarding runtime.Object, reason string) bool {
+	accessor, err := meta.Accessor(regarding)
+	if err != nil {
+		return true
+	}
+	key := limiterKey{uid: accessor.GetUID(), reason: reason}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	limiter, ok := r.limiters[key]
+	if !ok {
+		if len(r.limiters) >= maxLimiters {
+			r.limiters = map[limiterKey]*rate.Limiter{}
+		}
+		limiter = rate.NewLimiter(r.limit, r.burst)
+		r.limiters[key] = limiter
+	}
+	return limiter.Allow()
+}
@@ -0,0 +1,39 @@
+package eventrecorder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+func TestRateLimitedRecorder(t *testing.T) {
+	fake := events.NewFakeRecorder(10)
+	recorder := NewRateLimited(fake, time.Hour, 2)
+
+	simplest := &v1alpha1.TempoStack{ObjectMeta: metav1.ObjectMeta{Name: "simplest", UID: "uid-1"}}
+	other := &v1alpha1.TempoStack{ObjectMeta: metav1.ObjectMeta{Name: "other", UID: "uid-2"}}
+
+	for i := 0; i < 3; i++ {
+		recorder.Eventf(simplest, nil, corev1.EventTypeNormal, ReasonCreated, ActionCreate, "Created ConfigMap %d", i)
+	}
+	recorder.Eventf(simplest, nil, corev1.EventTypeWarning, ReasonFailedPrune, ActionDelete, "Failed to delete unmanaged ConfigMap")
+	recorder.Eventf(other, nil, corev1.EventTypeNormal, ReasonCreated, ActionCreate, "Created ConfigMap")
+	close(fake.Events)
+
+	recorded := []string{}
+	for event := range fake.Events {
+		recorded = append(recorded, event)
+	}
+	assert.Equal(t, []string{
+		"Normal Created Created ConfigMap 0",
+		"Normal Created Created ConfigMap 1",
+		"Warning FailedPrune Failed to delete unmanaged ConfigMap",
+		"Normal Created Created ConfigMap",
+	}, recorded)
+}
@@ -0,0 +1,29 @@
+package eventrecorder
+
+// Reasons of the Events recorded on TempoStack and TempoMonolithic instances.
+const (
+	// ReasonCreated is recorded when a managed object is created.
+	ReasonCreated = "Created"
+	// ReasonUpdated is recorded when a managed object is updated.
+	ReasonUpdated = "Updated"
+	// ReasonRecreated is recorded when a managed object is deleted because of a change in an immutable field.
+	// The object is created again in the next reconcile.
+	ReasonRecreated = "Recreated"
+	// ReasonPruned is recorded when an object is not managed anymore and is deleted.
+	ReasonPruned = "Pruned"
+	// ReasonFailedCreateOrUpdate is recorded when a managed object cannot be created or updated.
+	ReasonFailedCreateOrUpdate = "FailedCreateOrUpdate"
+	// ReasonFailedPrune is recorded when an object which is not managed anymore cannot be deleted.
+	ReasonFailedPrune = "FailedPrune"
+	// ReasonCertificateRotationRequired is recorded when the built-in certificates expired and are rotated.
+	ReasonCertificateRotationRequired = "CertificateRotationRequired"
+)
+
+// Actions of the Events recorded on TempoStack and TempoMonolithic instances.
+const (
+	ActionCreate    = "Create"
+	ActionUpdate    = "Update"
+	ActionDelete    = "Delete"
+	ActionRotate    = "Rotate"
+	ActionReconcile = "Reconcile"
+)
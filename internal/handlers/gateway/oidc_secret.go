@@ -15,12 +15,14 @@ import (
 
 // GetOIDCTenantSecrets returns the list to gateway tenant secrets for a tenant mode.
 // For the static mode, the secrets are fetched from externally provided secrets.
-// All secrets live in the same namespace as the tempostack request.
+// The secrets live in the same namespace as the tempostack request, except for tenants
+// listed in secretNamespaces (i.e. tenants defined by TempoTenant resources).
 func GetOIDCTenantSecrets(
 	ctx context.Context,
 	k8sClient client.Client,
 	namespace string,
 	tenants v1alpha1.TenantsSpec,
+	secretNamespaces map[string]string,
 ) ([]*manifestutils.GatewayTenantOIDCSecret, error) {
 	var (
 		tenantSecrets []*manifestutils.GatewayTenantOIDCSecret
@@ -28,7 +30,15 @@ func GetOIDCTenantSecrets(
 	)
 
 	for _, tenant := range tenants.Authentication {
+		// Tenants using mTLS authentication don't have an OIDC secret.
+		if tenant.OIDC == nil {
+			continue
+		}
+
 		key := client.ObjectKey{Name: tenant.OIDC.Secret.Name, Namespace: namespace}
+		if ns, ok := secretNamespaces[tenant.TenantName]; ok {
+			key.Namespace = ns
+		}
 		if err := k8sClient.Get(ctx, key, &gatewaySecret); err != nil {
 			if apierrors.IsNotFound(err) {
 				return nil, &status.ConfigurationError{
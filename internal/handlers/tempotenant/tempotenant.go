@@ -0,0 +1,231 @@
+package tempotenant
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+// MergeResult is the result of adding TempoTenants to the tenants of a TempoStack.
+type MergeResult struct {
+	// TempoStack contains the tenants of the TempoStack spec and all accepted TempoTenants.
+	TempoStack v1alpha1.TempoStack
+	// Accepted contains the TempoTenants which are added to the TempoStack.
+	Accepted []types.NamespacedName
+	// Rejected contains the TempoTenants which cannot be added to the TempoStack, and the reason.
+	Rejected map[types.NamespacedName]string
+	// SecretNamespaces maps the tenant name of accepted TempoTenants to the namespace of the OIDC secret.
+	// The OIDC secrets of all other tenants live in the namespace of the TempoStack.
+	SecretNamespaces map[string]string
+}
+
+// Selector returns the TempoTenant selector of the TempoStack, or nil if the TempoStack does not select TempoTenants.
+// TempoTenants are only supported in static mode.
+func Selector(tempo v1alpha1.TempoStack) *v1alpha1.TempoTenantSelectorSpec {
+	if tempo.Spec.Tenants == nil || tempo.Spec.Tenants.Mode != v1alpha1.ModeStatic {
+		return nil
+	}
+	return tempo.Spec.Tenants.TempoTenantSelector
+}
+
+// SelectsNamespace returns true if the TempoStack selects the TempoTenants of namespaces by labels,
+// and the namespace selector matches the labels of the namespace.
+func SelectsNamespace(tempo v1alpha1.TempoStack, namespaceLabels labels.Set) bool {
+	spec := Selector(tempo)
+	if spec == nil || spec.NamespaceSelector == nil {
+		return false
+	}
+	namespaceSelector, err := metav1.LabelSelectorAsSelector(spec.NamespaceSelector)
+	if err != nil {
+		return false
+	}
+	return namespaceSelector.Matches(namespaceLabels)
+}
+
+// GetSelectedTempoTenants returns the TempoTenants selected by the TempoStack, ordered by creation time.
+func GetSelectedTempoTenants(ctx context.Context, k client.Client, tempo v1alpha1.TempoStack) ([]v1alpha1.TempoTenant, error) {
+	spec := Selector(tempo)
+	if spec == nil {
+		return nil, nil
+	}
+
+	selector := labels.Everything()
+	if spec.Selector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid TempoTenant selector: %w", err)
+		}
+	}
+
+	namespaces := []string{tempo.Namespace}
+	if spec.NamespaceSelector != nil {
+		namespaceSelector, err := metav1.LabelSelectorAsSelector(spec.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid TempoTenant namespace selector: %w", err)
+		}
+
+		namespaceList := &corev1.NamespaceList{}
+		if err := k.List(ctx, namespaceList, &client.ListOptions{LabelSelector: namespaceSelector}); err != nil {
+			return nil, fmt.Errorf("error listing namespaces: %w", err)
+		}
+		namespaces = make([]string, 0, len(namespaceList.Items))
+		for _, ns := range namespaceList.Items {
+			namespaces = append(namespaces, ns.Name)
+		}
+	}
+
+	var tenants []v1alpha1.TempoTenant
+	for _, ns := range namespaces {
+		tenantList := &v1alpha1.TempoTenantList{}
+		if err := k.List(ctx, tenantList, &client.ListOptions{Namespace: ns, LabelSelector: selector}); err != nil {
+			return nil, fmt.Errorf("error listing TempoTenants: %w", err)
+		}
+		for _, tenant := range tenantList.Items {
+			if tenant.DeletionTimestamp.IsZero() {
+				tenants = append(tenants, tenant)
+			}
+		}
+	}
+
+	// Older TempoTenants take precedence in case of conflicts.
+	sort.SliceStable(tenants, func(i, j int) bool {
+		if !tenants[i].CreationTimestamp.Equal(&tenants[j].CreationTimestamp) {
+			return tenants[i].CreationTimestamp.Before(&tenants[j].CreationTimestamp)
+		}
+		if tenants[i].Namespace != tenants[j].Namespace {
+			return tenants[i].Namespace < tenants[j].Namespace
+		}
+		return tenants[i].Name < tenants[j].Name
+	})
+	return tenants, nil
+}
+
+// Merge adds the TempoTenants to the tenants, authorization, limits and retention of the TempoStack.
+// TempoTenants which conflict with the tenants of the TempoStack or a previous TempoTenant are rejected.
+// The roles of a TempoTenant only grant permissions on the tenant of the TempoTenant.
+func Merge(tempo v1alpha1.TempoStack, tempoTenants []v1alpha1.TempoTenant) MergeResult {
+	result := MergeResult{
+		TempoStack:       *tempo.DeepCopy(),
+		Rejected:         map[types.NamespacedName]string{},
+		SecretNamespaces: map[string]string{},
+	}
+	spec := &result.TempoStack.Spec
+	if spec.Tenants == nil {
+		return result
+	}
+	// The authorization is optional in the TempoStack if TempoTenants are selected.
+	if spec.Tenants.Authorization == nil {
+		spec.Tenants.Authorization = &v1alpha1.AuthorizationSpec{}
+	}
+
+	tenantNames := map[string]bool{}
+	tenantIDs := map[string]bool{}
+	for _, auth := range spec.Tenants.Authentication {
+		tenantNames[auth.TenantName] = true
+		tenantIDs[auth.TenantID] = true
+	}
+
+	for _, tenant := range tempoTenants {
+		key := types.NamespacedName{Namespace: tenant.Namespace, Name: tenant.Name}
+		if tenant.Spec.OIDC.Secret == nil || tenant.Spec.OIDC.Secret.Name == "" {
+			result.Rejected[key] = "spec.oidc.secret is required"
+			continue
+		}
+		if tenantNames[tenant.Spec.TenantName] {
+			result.Rejected[key] = fmt.Sprintf("tenant name %q is already in use", tenant.Spec.TenantName)
+			continue
+		}
+		if tenantIDs[tenant.Spec.TenantID] {
+			result.Rejected[key] = fmt.Sprintf("tenant ID %q is already in use", tenant.Spec.TenantID)
+			continue
+		}
+
+		roles, roleBindings, err := authorization(tenant)
+		if err != nil {
+			result.Rejected[key] = err.Error()
+			continue
+		}
+
+		tenantNames[tenant.Spec.TenantName] = true
+		tenantIDs[tenant.Spec.TenantID] = true
+		result.Accepted = append(result.Accepted, key)
+		result.SecretNamespaces[tenant.Spec.TenantName] = tenant.Namespace
+
+		spec.Tenants.Authentication = append(spec.Tenants.Authentication, v1alpha1.AuthenticationSpec{
+			TenantName: tenant.Spec.TenantName,
+			TenantID:   tenant.Spec.TenantID,
+			OIDC:       tenant.Spec.OIDC.DeepCopy(),
+		})
+		spec.Tenants.Authorization.Roles = append(spec.Tenants.Authorization.Roles, roles...)
+		spec.Tenants.Authorization.RoleBindings = append(spec.Tenants.Authorization.RoleBindings, roleBindings...)
+
+		// Limits and retention defined in the TempoStack take precedence.
+		if tenant.Spec.Limits != nil {
+			if spec.LimitSpec.PerTenant == nil {
+				spec.LimitSpec.PerTenant = map[string]v1alpha1.RateLimitSpec{}
+			}
+			if _, ok := spec.LimitSpec.PerTenant[tenant.Spec.TenantName]; !ok {
+				spec.LimitSpec.PerTenant[tenant.Spec.TenantName] = *tenant.Spec.Limits.DeepCopy()
+			}
+		}
+		if tenant.Spec.Retention != nil {
+			if spec.Retention.PerTenant == nil {
+				spec.Retention.PerTenant = map[string]v1alpha1.RetentionConfig{}
+			}
+			if _, ok := spec.Retention.PerTenant[tenant.Spec.TenantName]; !ok {
+				spec.Retention.PerTenant[tenant.Spec.TenantName] = *tenant.Spec.Retention
+			}
+		}
+	}
+
+	return result
+}
+
+// authorization returns the roles and role bindings of a TempoTenant.
+// The names are prefixed with the namespace and name of the TempoTenant to avoid conflicts.
+func authorization(tenant v1alpha1.TempoTenant) ([]v1alpha1.RoleSpec, []v1alpha1.RoleBindingsSpec, error) {
+	if tenant.Spec.Authorization == nil {
+		return nil, nil, nil
+	}
+
+	prefix := fmt.Sprintf("%s-%s-", tenant.Namespace, tenant.Name)
+	roleNames := map[string]bool{}
+	roles := make([]v1alpha1.RoleSpec, 0, len(tenant.Spec.Authorization.Roles))
+	for _, role := range tenant.Spec.Authorization.Roles {
+		roleNames[role.Name] = true
+		roles = append(roles, v1alpha1.RoleSpec{
+			Name:        prefix + role.Name,
+			Resources:   role.Resources,
+			Tenants:     []string{tenant.Spec.TenantName},
+			Permissions: role.Permissions,
+		})
+	}
+
+	roleBindings := make([]v1alpha1.RoleBindingsSpec, 0, len(tenant.Spec.Authorization.RoleBindings))
+	for _, binding := range tenant.Spec.Authorization.RoleBindings {
+		bindingRoles := make([]string, 0, len(binding.Roles))
+		for _, role := range binding.Roles {
+			// Role bindings can only reference the roles of the same TempoTenant,
+			// otherwise a TempoTenant could grant permissions on other tenants.
+			if !roleNames[role] {
+				return nil, nil, fmt.Errorf("role binding %q references role %q, which is not defined in the TempoTenant", binding.Name, role)
+			}
+			bindingRoles = append(bindingRoles, prefix+role)
+		}
+		roleBindings = append(roleBindings, v1alpha1.RoleBindingsSpec{
+			Name:     prefix + binding.Name,
+			Subjects: binding.Subjects,
+			Roles:    bindingRoles,
+		})
+	}
+	return roles, roleBindings, nil
+}
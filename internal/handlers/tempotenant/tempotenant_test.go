@@ -0,0 +1,296 @@
+package tempotenant
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+func tempoTenant(namespace, name, tenantName, tenantID string, created time.Time) v1alpha1.TempoTenant {
+	return v1alpha1.TempoTenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: v1alpha1.TempoTenantSpec{
+			TenantName: tenantName,
+			TenantID:   tenantID,
+			OIDC: v1alpha1.OIDCSpec{
+				IssuerURL: "https://dex.example.com",
+				Secret:    &v1alpha1.TenantSecretSpec{Name: name + "-oidc"},
+			},
+		},
+	}
+}
+
+func TestGetSelectedTempoTenants(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	objects := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "observability"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"tempo": "approved"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"tempo": "approved"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-c"}},
+	}
+	for _, tenant := range []v1alpha1.TempoTenant{
+		tempoTenant("observability", "platform", "platform", "platform", now),
+		tempoTenant("team-a", "team-a", "team-a", "a", now.Add(2*time.Hour)),
+		tempoTenant("team-b", "team-b", "team-b", "b", now.Add(time.Hour)),
+		tempoTenant("team-c", "team-c", "team-c", "c", now),
+	} {
+		tenant.Labels = map[string]string{"team": tenant.Name}
+		objects = append(objects, tenant.DeepCopy())
+	}
+
+	s := runtime.NewScheme()
+	require.NoError(t, scheme.AddToScheme(s))
+	require.NoError(t, v1alpha1.AddToScheme(s))
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(objects...).Build()
+
+	tests := []struct {
+		name     string
+		tenants  *v1alpha1.TenantsSpec
+		expected []string
+		err      bool
+	}{
+		{
+			name:    "no selector",
+			tenants: &v1alpha1.TenantsSpec{Mode: v1alpha1.ModeStatic},
+		},
+		{
+			name: "openshift mode",
+			tenants: &v1alpha1.TenantsSpec{
+				Mode:                v1alpha1.ModeOpenShift,
+				TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{},
+			},
+		},
+		{
+			name: "namespace of the TempoStack",
+			tenants: &v1alpha1.TenantsSpec{
+				Mode:                v1alpha1.ModeStatic,
+				TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{},
+			},
+			expected: []string{"observability/platform"},
+		},
+		{
+			name: "namespace selector",
+			tenants: &v1alpha1.TenantsSpec{
+				Mode: v1alpha1.ModeStatic,
+				TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tempo": "approved"}},
+				},
+			},
+			// ordered by creation time
+			expected: []string{"team-b/team-b", "team-a/team-a"},
+		},
+		{
+			name: "namespace and label selector",
+			tenants: &v1alpha1.TenantsSpec{
+				Mode: v1alpha1.ModeStatic,
+				TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{
+					NamespaceSelector: &metav1.LabelSelector{},
+					Selector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "team", Operator: metav1.LabelSelectorOpIn, Values: []string{"team-a", "team-c"}},
+						},
+					},
+				},
+			},
+			expected: []string{"team-c/team-c", "team-a/team-a"},
+		},
+		{
+			name: "invalid selector",
+			tenants: &v1alpha1.TenantsSpec{
+				Mode: v1alpha1.ModeStatic,
+				TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{
+					Selector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "team", Operator: "invalid"},
+						},
+					},
+				},
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tempo := v1alpha1.TempoStack{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "observability", Name: "simplest"},
+				Spec:       v1alpha1.TempoStackSpec{Tenants: tc.tenants},
+			}
+			tenants, err := GetSelectedTempoTenants(context.Background(), cl, tempo)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var names []string
+			for _, tenant := range tenants {
+				names = append(names, tenant.Namespace+"/"+tenant.Name)
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestSelectsNamespace(t *testing.T) {
+	tests := []struct {
+		name     string
+		tenants  *v1alpha1.TenantsSpec
+		labels   labels.Set
+		expected bool
+	}{
+		{
+			name:    "no selector",
+			tenants: &v1alpha1.TenantsSpec{Mode: v1alpha1.ModeStatic},
+			labels:  labels.Set{"tempo": "approved"},
+		},
+		{
+			name: "namespace of the TempoStack",
+			tenants: &v1alpha1.TenantsSpec{
+				Mode:                v1alpha1.ModeStatic,
+				TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{},
+			},
+			labels: labels.Set{"tempo": "approved"},
+		},
+		{
+			name: "matching namespace",
+			tenants: &v1alpha1.TenantsSpec{
+				Mode: v1alpha1.ModeStatic,
+				TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tempo": "approved"}},
+				},
+			},
+			labels:   labels.Set{"tempo": "approved", "team": "a"},
+			expected: true,
+		},
+		{
+			name: "other namespace",
+			tenants: &v1alpha1.TenantsSpec{
+				Mode: v1alpha1.ModeStatic,
+				TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tempo": "approved"}},
+				},
+			},
+			labels: labels.Set{"team": "a"},
+		},
+		{
+			name: "invalid namespace selector",
+			tenants: &v1alpha1.TenantsSpec{
+				Mode: v1alpha1.ModeStatic,
+				TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "tempo", Operator: "invalid"},
+						},
+					},
+				},
+			},
+			labels: labels.Set{"tempo": "approved"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tempo := v1alpha1.TempoStack{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "observability", Name: "simplest"},
+				Spec:       v1alpha1.TempoStackSpec{Tenants: tc.tenants},
+			}
+			assert.Equal(t, tc.expected, SelectsNamespace(tempo, tc.labels))
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tempo := v1alpha1.TempoStack{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "observability", Name: "simplest"},
+		Spec: v1alpha1.TempoStackSpec{
+			Tenants: &v1alpha1.TenantsSpec{
+				Mode: v1alpha1.ModeStatic,
+				Authentication: []v1alpha1.AuthenticationSpec{
+					{TenantName: "platform", TenantID: "platform"},
+				},
+				TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{},
+			},
+			LimitSpec: v1alpha1.LimitSpec{
+				PerTenant: map[string]v1alpha1.RateLimitSpec{
+					"team-a": {Ingestion: v1alpha1.IngestionLimitSpec{IngestionRateLimitBytes: ptr.To(100)}},
+				},
+			},
+		},
+	}
+
+	teamA := tempoTenant("team-a", "tenant", "team-a", "a", now)
+	teamA.Spec.Authorization = &v1alpha1.TempoTenantAuthorizationSpec{
+		Roles: []v1alpha1.TempoTenantRoleSpec{
+			{Name: "read", Resources: []string{"traces"}, Permissions: []v1alpha1.PermissionType{v1alpha1.Read}},
+		},
+		RoleBindings: []v1alpha1.RoleBindingsSpec{
+			{Name: "readers", Roles: []string{"read"}, Subjects: []v1alpha1.Subject{{Name: "team-a", Kind: v1alpha1.Group}}},
+		},
+	}
+	teamA.Spec.Limits = &v1alpha1.RateLimitSpec{Ingestion: v1alpha1.IngestionLimitSpec{IngestionRateLimitBytes: ptr.To(200)}}
+	teamA.Spec.Retention = &v1alpha1.RetentionConfig{Traces: metav1.Duration{Duration: 24 * time.Hour}}
+
+	duplicateName := tempoTenant("team-b", "tenant", "platform", "b", now)
+	duplicateID := tempoTenant("team-c", "tenant", "team-c", "a", now)
+	otherRole := tempoTenant("team-d", "tenant", "team-d", "d", now)
+	otherRole.Spec.Authorization = &v1alpha1.TempoTenantAuthorizationSpec{
+		RoleBindings: []v1alpha1.RoleBindingsSpec{
+			{Name: "admins", Roles: []string{"platform-admin"}, Subjects: []v1alpha1.Subject{{Name: "team-d", Kind: v1alpha1.Group}}},
+		},
+	}
+	missingSecret := tempoTenant("team-e", "tenant", "team-e", "e", now)
+	missingSecret.Spec.OIDC.Secret = nil
+
+	result := Merge(tempo, []v1alpha1.TempoTenant{teamA, duplicateName, duplicateID, otherRole, missingSecret})
+
+	assert.Equal(t, []types.NamespacedName{{Namespace: "team-a", Name: "tenant"}}, result.Accepted)
+	assert.Equal(t, map[types.NamespacedName]string{
+		{Namespace: "team-b", Name: "tenant"}: `tenant name "platform" is already in use`,
+		{Namespace: "team-c", Name: "tenant"}: `tenant ID "a" is already in use`,
+		{Namespace: "team-d", Name: "tenant"}: `role binding "admins" references role "platform-admin", which is not defined in the TempoTenant`,
+		{Namespace: "team-e", Name: "tenant"}: "spec.oidc.secret is required",
+	}, result.Rejected)
+	assert.Equal(t, map[string]string{"team-a": "team-a"}, result.SecretNamespaces)
+
+	tenants := result.TempoStack.Spec.Tenants
+	assert.Equal(t, []v1alpha1.AuthenticationSpec{
+		{TenantName: "platform", TenantID: "platform"},
+		{TenantName: "team-a", TenantID: "a", OIDC: &teamA.Spec.OIDC},
+	}, tenants.Authentication)
+	assert.Equal(t, &v1alpha1.AuthorizationSpec{
+		Roles: []v1alpha1.RoleSpec{
+			{Name: "team-a-tenant-read", Resources: []string{"traces"}, Tenants: []string{"team-a"}, Permissions: []v1alpha1.PermissionType{v1alpha1.Read}},
+		},
+		RoleBindings: []v1alpha1.RoleBindingsSpec{
+			{Name: "team-a-tenant-readers", Roles: []string{"team-a-tenant-read"}, Subjects: []v1alpha1.Subject{{Name: "team-a", Kind: v1alpha1.Group}}},
+		},
+	}, tenants.Authorization)
+
+	// limits of the TempoStack take precedence
+	assert.Equal(t, ptr.To(100), result.TempoStack.Spec.LimitSpec.PerTenant["team-a"].Ingestion.IngestionRateLimitBytes)
+	assert.Equal(t, map[string]v1alpha1.RetentionConfig{"team-a": *teamA.Spec.Retention}, result.TempoStack.Spec.Retention.PerTenant)
+
+	// the original TempoStack is not modified
+	assert.Len(t, tempo.Spec.Tenants.Authentication, 1)
+	assert.Nil(t, tempo.Spec.Tenants.Authorization)
+}
@@ -35,6 +35,15 @@ func build(opts Options) (*monitoringv1.PrometheusRuleSpec, error) {
 		return nil, kverrors.Wrap(err, "failed to create prometheus rules")
 	}
 
+	for i := range alerts.Groups {
+		alerts.Groups[i].Rules = customize(alerts.Groups[i].Rules, opts.Alerts)
+	}
+	if len(alerts.Groups) > 0 {
+		// the custom rules are appended once, to the last group of the built-in alerts
+		last := &alerts.Groups[len(alerts.Groups)-1]
+		last.Rules = append(last.Rules, customRules(opts.Alerts)...)
+	}
+
 	spec := alerts.DeepCopy()
 	spec.Groups = append(alerts.Groups, recordingRules.Groups...)
 
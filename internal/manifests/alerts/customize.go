@@ -0,0 +1,85 @@
+package alerts
+
+import (
+	"maps"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+const severityLabel = "severity"
+
+// customize applies the rule overrides of the alerts spec to the built-in alerts.
+func customize(rules []monitoringv1.Rule, spec *v1alpha1.AlertsSpec) []monitoringv1.Rule {
+	if spec == nil {
+		return rules
+	}
+
+	overrides := make(map[string]v1alpha1.AlertRuleOverrideSpec, len(spec.Rules))
+	for _, override := range spec.Rules {
+		overrides[override.Alert] = override
+	}
+
+	result := make([]monitoringv1.Rule, 0, len(rules))
+	for _, rule := range rules {
+		override, ok := overrides[rule.Alert]
+		if !ok {
+			result = append(result, rule)
+			continue
+		}
+		if override.Disabled {
+			continue
+		}
+
+		if override.For != nil {
+			rule.For = duration(override.For)
+		}
+		rule.Labels = merge(rule.Labels, override.ExtraLabels)
+		if override.Severity != "" {
+			rule.Labels[severityLabel] = override.Severity
+		}
+		rule.Annotations = merge(rule.Annotations, override.ExtraAnnotations)
+		result = append(result, rule)
+	}
+	return result
+}
+
+// customRules returns the custom rules of the alerts spec.
+func customRules(spec *v1alpha1.AlertsSpec) []monitoringv1.Rule {
+	if spec == nil {
+		return nil
+	}
+
+	result := make([]monitoringv1.Rule, 0, len(spec.CustomRules))
+	for _, custom := range spec.CustomRules {
+		rule := monitoringv1.Rule{
+			Alert:       custom.Alert,
+			Expr:        intstr.FromString(custom.Expr),
+			Labels:      maps.Clone(custom.Labels),
+			Annotations: maps.Clone(custom.Annotations),
+		}
+		if custom.For != nil {
+			rule.For = duration(custom.For)
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
+// duration converts a duration to the format of Prometheus, e.g. 15m instead of 15m0s.
+func duration(d *metav1.Duration) *monitoringv1.Duration {
+	result := monitoringv1.Duration(model.Duration(d.Duration).String())
+	return &result
+}
+
+// merge returns a copy of base with the values of extra, without modifying base.
+func merge(base, extra map[string]string) map[string]string {
+	result := make(map[string]string, len(base)+len(extra))
+	maps.Copy(result, base)
+	maps.Copy(result, extra)
+	return result
+}
@@ -0,0 +1,110 @@
+package alerts
+
+import (
+	"testing"
+	"time"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+func findRule(rules []monitoringv1.Rule, alert string) *monitoringv1.Rule {
+	for i := range rules {
+		if rules[i].Alert == alert {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+func TestBuildCustomizedAlerts(t *testing.T) {
+	spec, err := build(Options{
+		RunbookURL: "https://wiki.example.com/tempo",
+		Namespace:  "default",
+		Cluster:    "test",
+		Alerts: &v1alpha1.AlertsSpec{
+			Rules: []v1alpha1.AlertRuleOverrideSpec{
+				{
+					Alert:    "TempoCompactorsTooManyOutstandingBlocks",
+					Disabled: true,
+				},
+				{
+					Alert:            "TempoRequestLatency",
+					For:              &metav1.Duration{Duration: 30 * time.Minute},
+					Threshold:        "5",
+					Severity:         "warning",
+					ExtraLabels:      map[string]string{"team": "tracing", "severity": "ignored"},
+					ExtraAnnotations: map[string]string{"dashboard": "https://grafana.example.com/d/tempo"},
+				},
+			},
+			CustomRules: []v1alpha1.CustomAlertRuleSpec{
+				{
+					Alert:  "TempoDiscardedSpans",
+					Expr:   `sum by (cluster, namespace) (rate(tempo_discarded_spans_total[5m])) > 0`,
+					For:    &metav1.Duration{Duration: time.Hour},
+					Labels: map[string]string{"severity": "info"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	rules := spec.Groups[0].Rules
+	assert.Len(t, rules, 14)
+	assert.Nil(t, findRule(rules, "TempoCompactorsTooManyOutstandingBlocks"))
+
+	latency := findRule(rules, "TempoRequestLatency")
+	require.NotNil(t, latency)
+	assert.Contains(t, latency.Expr.String(), `route!~"metrics|/frontend.Frontend/Process|debug_pprof"} > 5`)
+	assert.Equal(t, monitoringv1.Duration("30m"), *latency.For)
+	assert.Equal(t, map[string]string{"severity": "warning", "team": "tracing"}, latency.Labels)
+	assert.Equal(t, "https://wiki.example.com/tempo#TempoRequestLatency", latency.Annotations["runbook_url"])
+	assert.Equal(t, "https://grafana.example.com/d/tempo", latency.Annotations["dashboard"])
+
+	unchanged := findRule(rules, "TempoCompactionsFailing")
+	require.NotNil(t, unchanged)
+	assert.Equal(t, map[string]string{"severity": "critical"}, unchanged.Labels)
+
+	assert.Equal(t, monitoringv1.Rule{
+		Alert:  "TempoDiscardedSpans",
+		Expr:   intstr.FromString(`sum by (cluster, namespace) (rate(tempo_discarded_spans_total[5m])) > 0`),
+		For:    ptrDuration("1h"),
+		Labels: map[string]string{"severity": "info"},
+	}, rules[len(rules)-1])
+
+	count := 0
+	for _, group := range spec.Groups {
+		for _, rule := range group.Rules {
+			if rule.Alert == "TempoDiscardedSpans" {
+				count++
+			}
+		}
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestBuiltinAlertExpressions(t *testing.T) {
+	spec, err := build(Options{
+		RunbookURL: RunbookDefaultURL,
+		Namespace:  "default",
+		Cluster:    "test",
+	})
+	require.NoError(t, err)
+
+	for _, group := range spec.Groups {
+		for _, rule := range group.Rules {
+			_, err := parser.ParseExpr(rule.Expr.String())
+			assert.NoError(t, err, "rule %s%s", rule.Alert, rule.Record)
+		}
+	}
+}
+
+func ptrDuration(d monitoringv1.Duration) *monitoringv1.Duration {
+	return &d
+}
@@ -1,8 +1,40 @@
 package alerts
 
+import "github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+
 // Options is used to configure Prometheus Alerts.
 type Options struct {
 	RunbookURL string
 	Cluster    string
 	Namespace  string
+	Alerts     *v1alpha1.AlertsSpec
+}
+
+// Threshold returns the threshold of an alert.
+func (o Options) Threshold(alert string) string {
+	if o.Alerts != nil {
+		for _, rule := range o.Alerts.Rules {
+			if rule.Alert == alert && rule.Threshold != "" {
+				return rule.Threshold
+			}
+		}
+	}
+	return defaultThresholds[alert]
+}
+
+// defaultThresholds are the thresholds of the built-in alerts.
+// Alerts without a threshold, e.g. TempoBadOverrides, are not listed.
+var defaultThresholds = map[string]string{
+	"TempoRequestLatency":                     "3",
+	"TempoCompactorUnhealthy":                 "0",
+	"TempoDistributorUnhealthy":               "0",
+	"TempoCompactionsFailing":                 "2",
+	"TempoIngesterFlushesUnhealthy":           "2",
+	"TempoIngesterFlushesFailing":             "2",
+	"TempoPollsFailing":                       "2",
+	"TempoTenantIndexFailures":                "2",
+	"TempoTenantIndexTooOld":                  "600",
+	"TempoProvisioningTooManyWrites":          "30",
+	"TempoCompactorsTooManyOutstandingBlocks": "100",
+	"TempoIngesterReplayErrors":               "0",
 }
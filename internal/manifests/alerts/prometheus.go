@@ -6,6 +6,7 @@ import (
 	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/manifests/naming"
 )
@@ -19,7 +20,8 @@ const (
 func BuildPrometheusRule(params manifestutils.Params) ([]client.Object, error) {
 	labels := manifestutils.CommonLabels(params.Tempo.Name)
 	extraLabels := params.Tempo.Spec.Observability.Metrics.ExtraPrometheusRuleLabels
-	prometheusRule, err := NewPrometheusRule(params.Tempo.Name, params.Tempo.Namespace, k8slabels.Merge(extraLabels, labels))
+	prometheusRule, err := NewPrometheusRule(params.Tempo.Name, params.Tempo.Namespace, k8slabels.Merge(extraLabels, labels),
+		params.Tempo.Spec.Observability.Metrics.Alerts)
 	if err != nil {
 		return nil, err
 	}
@@ -30,7 +32,8 @@ func BuildPrometheusRule(params manifestutils.Params) ([]client.Object, error) {
 }
 
 // NewPrometheusRule build a PrometheusRule.
-func NewPrometheusRule(stackName, namespace string, labels k8slabels.Set) (*monitoringv1.PrometheusRule, error) {
+// The built-in alerts are customized with the alerts spec, if set.
+func NewPrometheusRule(stackName, namespace string, labels k8slabels.Set, alerts *v1alpha1.AlertsSpec) (*monitoringv1.PrometheusRule, error) {
 	promRulelabels := map[string]string{
 		"openshift.io/prometheus-rule-evaluation-scope": "leaf-prometheus",
 	}
@@ -39,6 +42,10 @@ func NewPrometheusRule(stackName, namespace string, labels k8slabels.Set) (*moni
 		RunbookURL: RunbookDefaultURL,
 		Cluster:    stackName,
 		Namespace:  namespace,
+		Alerts:     alerts,
+	}
+	if alerts != nil && alerts.RunbookURL != "" {
+		alertOpts.RunbookURL = alerts.RunbookURL
 	}
 
 	spec, err := build(alertOpts)
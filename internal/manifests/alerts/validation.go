@@ -0,0 +1,70 @@
+package alerts
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/prometheus/prometheus/promql/parser"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+// Validate validates the customization of the alerts.
+func Validate(spec *v1alpha1.AlertsSpec, path *field.Path) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+
+	builtinAlerts, err := alertNames()
+	if err != nil {
+		return field.ErrorList{field.InternalError(path, err)}
+	}
+
+	var errs field.ErrorList
+	for i, rule := range spec.Rules {
+		rulePath := path.Child("rules").Index(i)
+		if !builtinAlerts.Has(rule.Alert) {
+			errs = append(errs, field.NotSupported(rulePath.Child("alert"), rule.Alert, sets.List(builtinAlerts)))
+			continue
+		}
+
+		if rule.Threshold != "" {
+			if _, ok := defaultThresholds[rule.Alert]; !ok {
+				errs = append(errs, field.Invalid(rulePath.Child("threshold"), rule.Threshold,
+					fmt.Sprintf("the alert %s has no threshold", rule.Alert)))
+			} else if _, err := strconv.ParseFloat(rule.Threshold, 64); err != nil {
+				errs = append(errs, field.Invalid(rulePath.Child("threshold"), rule.Threshold, "must be a number"))
+			}
+		}
+	}
+
+	for i, rule := range spec.CustomRules {
+		rulePath := path.Child("customRules").Index(i)
+		if builtinAlerts.Has(rule.Alert) {
+			errs = append(errs, field.Invalid(rulePath.Child("alert"), rule.Alert,
+				"must not be the name of a built-in alert, use rules to customize a built-in alert"))
+		}
+		if _, err := parser.ParseExpr(rule.Expr); err != nil {
+			errs = append(errs, field.Invalid(rulePath.Child("expr"), rule.Expr, fmt.Sprintf("invalid PromQL expression: %s", err)))
+		}
+	}
+	return errs
+}
+
+// alertNames returns the names of the built-in alerts.
+func alertNames() (sets.Set[string], error) {
+	spec, err := ruleSpec("prometheus-alerts.yaml", alertsYAMLTmpl, Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	names := sets.New[string]()
+	for _, group := range spec.Groups {
+		for _, rule := range group.Rules {
+			names.Insert(rule.Alert)
+		}
+	}
+	return names, nil
+}
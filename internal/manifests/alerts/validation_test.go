@@ -0,0 +1,76 @@
+package alerts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+func TestValidate(t *testing.T) {
+	path := field.NewPath("spec", "observability", "metrics", "alerts")
+
+	tests := []struct {
+		name     string
+		spec     *v1alpha1.AlertsSpec
+		expected string
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name: "valid customization",
+			spec: &v1alpha1.AlertsSpec{
+				Rules: []v1alpha1.AlertRuleOverrideSpec{
+					{Alert: "TempoRequestLatency", Threshold: "2.5"},
+					{Alert: "TempoBadOverrides", Disabled: true},
+				},
+				CustomRules: []v1alpha1.CustomAlertRuleSpec{
+					{Alert: "TempoDiscardedSpans", Expr: "sum(rate(tempo_discarded_spans_total[5m])) > 0"},
+				},
+			},
+		},
+		{
+			name: "unknown alert",
+			spec: &v1alpha1.AlertsSpec{
+				Rules: []v1alpha1.AlertRuleOverrideSpec{{Alert: "TempoUnknown", Disabled: true}},
+			},
+			expected: `spec.observability.metrics.alerts.rules[0].alert: Unsupported value: "TempoUnknown"`,
+		},
+		{
+			name: "invalid thresholds",
+			spec: &v1alpha1.AlertsSpec{
+				Rules: []v1alpha1.AlertRuleOverrideSpec{
+					{Alert: "TempoRequestLatency", Threshold: "3s"},
+					{Alert: "TempoBadOverrides", Threshold: "1"},
+				},
+			},
+			expected: `[spec.observability.metrics.alerts.rules[0].threshold: Invalid value: "3s": must be a number, ` +
+				`spec.observability.metrics.alerts.rules[1].threshold: Invalid value: "1": the alert TempoBadOverrides has no threshold]`,
+		},
+		{
+			name: "invalid custom rules",
+			spec: &v1alpha1.AlertsSpec{
+				CustomRules: []v1alpha1.CustomAlertRuleSpec{
+					{Alert: "TempoRequestLatency", Expr: "up == 0"},
+					{Alert: "TempoDiscardedSpans", Expr: "sum(rate(tempo_discarded_spans_total[5m]) > 0"},
+				},
+			},
+			expected: `[spec.observability.metrics.alerts.customRules[0].alert: Invalid value: "TempoRequestLatency": must not be the name of a built-in alert, use rules to customize a built-in alert, ` +
+				`spec.observability.metrics.alerts.customRules[1].expr: Invalid value: "sum(rate(tempo_discarded_spans_total[5m]) > 0": invalid PromQL expression`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := Validate(tc.spec, path)
+			if tc.expected == "" {
+				assert.Empty(t, errs)
+				return
+			}
+			assert.Contains(t, errs.ToAggregate().Error(), tc.expected)
+		})
+	}
+}
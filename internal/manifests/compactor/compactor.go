@@ -21,7 +21,7 @@ func BuildCompactor(params manifestutils.Params) ([]client.Object, error) {
 	if err != nil {
 		return nil, err
 	}
-	d.Spec.Template, err = manifestutils.PatchTracingEnvConfiguration(params.Tempo, d.Spec.Template)
+	d.Spec.Template, err = manifestutils.PatchTracingEnvConfiguration(params, d.Spec.Template)
 	if err != nil {
 		return nil, err
 	}
@@ -44,6 +44,7 @@ func BuildCompactor(params manifestutils.Params) ([]client.Object, error) {
 	}
 
 	manifestutils.PatchEnvVars(&d.Spec.Template.Spec, "tempo", tempo.Spec.Env, tempo.Spec.EnvFrom)
+	manifestutils.PatchExtraArgs(&d.Spec.Template.Spec, "tempo", tempo.Spec.Template.Compactor.ExtraArgs)
 
 	return []client.Object{d, service(tempo)}, nil
 }
@@ -58,7 +59,7 @@ func resources(tempo v1alpha1.TempoStack) corev1.ResourceRequirements {
 func deployment(params manifestutils.Params) (*v1.Deployment, error) {
 	tempo := params.Tempo
 	labels := manifestutils.ComponentLabels(manifestutils.CompactorComponentName, tempo.Name)
-	annotations := manifestutils.CommonAnnotations(params)
+	annotations := manifestutils.ComponentAnnotations(params, manifestutils.CompactorComponentName)
 	annotations = manifestutils.StorageSecretHash(params.StorageParams, annotations)
 	cfg := tempo.Spec.Template.Compactor
 	image := tempo.Spec.Images.Tempo
@@ -97,7 +98,7 @@ func deployment(params manifestutils.Params) (*v1.Deployment, error) {
 							Env:   proxy.ReadProxyVarsFromEnv(),
 							Args: []string{
 								"-target=compactor",
-								"-config.file=/conf/tempo.yaml",
+								"-config.file=" + manifestutils.TempoConfigFile(params, manifestutils.CompactorComponentName),
 								"-log.level=info",
 								"-config.expand-env=true",
 							},
@@ -120,6 +121,7 @@ func deployment(params manifestutils.Params) (*v1.Deployment, error) {
 									MountPath: "/conf",
 									ReadOnly:  true,
 								},
+								manifestutils.TenantOverridesVolumeMount(),
 								{
 									Name:      manifestutils.TmpStorageVolumeName,
 									MountPath: manifestutils.TmpTempoStoragePath,
@@ -141,6 +143,7 @@ func deployment(params manifestutils.Params) (*v1.Deployment, error) {
 								},
 							},
 						},
+						manifestutils.TenantOverridesVolume(tempo.Name),
 						{
 							Name: manifestutils.TmpStorageVolumeName,
 							VolumeSource: corev1.VolumeSource{
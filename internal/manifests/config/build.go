@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
@@ -33,6 +34,10 @@ var (
 	tempoQueryYAMLTmpl     = template.Must(template.ParseFS(tempoQueryYAMLTmplFile, "tempo-query.yaml"))
 )
 
+// defaultUserConfigurableOverridesPollInterval is the default interval at which Tempo
+// reloads the user-configurable overrides from the object storage.
+const defaultUserConfigurableOverridesPollInterval = time.Minute
+
 func fromRateLimitSpecToTenantOverrides(spec v1alpha1.RateLimitSpec, retention *time.Duration) tenantOverrides {
 	return tenantOverrides{
 		IngestionRateLimitBytes: spec.Ingestion.IngestionRateLimitBytes,
@@ -85,6 +90,85 @@ func applyRateLimitDefaults(spec v1alpha1.RateLimitSpec, size v1alpha1.TempoStac
 	return spec
 }
 
+// applyTuningDefaults applies size-based tuning defaults to a TuningSpec.
+// User-specified values (non-nil fields) always take precedence over size defaults.
+// The returned spec has all sections (compaction, ingestion and query) initialized.
+func applyTuningDefaults(spec *v1alpha1.TuningSpec, size v1alpha1.TempoStackSize) v1alpha1.TuningSpec {
+	tuning := v1alpha1.TuningSpec{}
+	if spec != nil {
+		spec.DeepCopyInto(&tuning)
+	}
+	if tuning.Compaction == nil {
+		tuning.Compaction = &v1alpha1.CompactionTuningSpec{}
+	}
+	if tuning.Ingestion == nil {
+		tuning.Ingestion = &v1alpha1.IngestionTuningSpec{}
+	}
+	if tuning.Query == nil {
+		tuning.Query = &v1alpha1.QueryTuningSpec{}
+	}
+
+	profile := manifestutils.GetTuningProfile(size)
+	if profile == nil {
+		return tuning
+	}
+
+	// Apply defaults only for nil (unset) fields - user values take precedence
+	if tuning.Compaction.CompactionWindow == nil && profile.CompactionWindow != nil {
+		tuning.Compaction.CompactionWindow = &metav1.Duration{Duration: *profile.CompactionWindow}
+	}
+	if tuning.Ingestion.MaxBlockBytes == nil && profile.IngesterMaxBlockBytes != nil {
+		tuning.Ingestion.MaxBlockBytes = profile.IngesterMaxBlockBytes
+	}
+	if tuning.Query.SearchConcurrentJobs == nil && profile.SearchConcurrentJobs != nil {
+		tuning.Query.SearchConcurrentJobs = profile.SearchConcurrentJobs
+	}
+	if tuning.Query.TraceByIDQueryShards == nil && profile.TraceByIDQueryShards != nil {
+		tuning.Query.TraceByIDQueryShards = profile.TraceByIDQueryShards
+	}
+	if tuning.Query.MaxOutstandingPerTenant == nil && profile.MaxOutstandingPerTenant != nil {
+		tuning.Query.MaxOutstandingPerTenant = profile.MaxOutstandingPerTenant
+	}
+	if tuning.Query.MaxConcurrentQueries == nil && profile.MaxConcurrentQueries != nil {
+		tuning.Query.MaxConcurrentQueries = profile.MaxConcurrentQueries
+	}
+
+	return tuning
+}
+
+func fromCompactionTuningToOptions(spec *v1alpha1.CompactionTuningSpec) compactionOptions {
+	options := compactionOptions{
+		MaxCompactionObjects: ptr.Deref(spec.MaxCompactionObjects, 0),
+		MaxBlockBytes:        ptr.Deref(spec.MaxBlockBytes, 0),
+	}
+	if spec.CompactionWindow != nil {
+		options.CompactionWindow = spec.CompactionWindow.Duration.String()
+	}
+	return options
+}
+
+func fromIngestionTuningToOptions(spec *v1alpha1.IngestionTuningSpec) ingestionOptions {
+	options := ingestionOptions{
+		MaxBlockDuration: DefaultMaxBlockDuration,
+		MaxBlockBytes:    ptr.Deref(spec.MaxBlockBytes, 0),
+	}
+	if spec.MaxBlockDuration != nil {
+		options.MaxBlockDuration = spec.MaxBlockDuration.Duration.String()
+	}
+	if spec.TraceIdlePeriod != nil {
+		options.TraceIdlePeriod = spec.TraceIdlePeriod.Duration.String()
+	}
+	return options
+}
+
+func fromQueryTuningToOptions(spec *v1alpha1.QueryTuningSpec) queryOptions {
+	return queryOptions{
+		TraceByIDQueryShards:    ptr.Deref(spec.TraceByIDQueryShards, 0),
+		MetricsConcurrentJobs:   ptr.Deref(spec.MetricsConcurrentJobs, 0),
+		MaxOutstandingPerTenant: ptr.Deref(spec.MaxOutstandingPerTenant, 0),
+	}
+}
+
 func buildQueryFrontEndConfig(params manifestutils.Params) ([]byte, error) {
 	if !params.Tempo.Spec.Template.Gateway.Enabled {
 		params.CtrlConfig.Gates.HTTPEncryption = false
@@ -130,8 +214,30 @@ func buildConfiguration(params manifestutils.Params) ([]byte, error) {
 		},
 	}
 
-	if isTenantOverridesConfigRequired(tempo.Spec.LimitSpec, tempo.Spec.Retention) {
-		opts.TenantRateLimitsPath = tenantOverridesMountPath
+	tuning := applyTuningDefaults(tempo.Spec.Tuning, tempo.Spec.Size)
+	opts.Compaction = fromCompactionTuningToOptions(tuning.Compaction)
+	opts.Ingestion = fromIngestionTuningToOptions(tuning.Ingestion)
+	opts.Query = fromQueryTuningToOptions(tuning.Query)
+	if tuning.Query.SearchConcurrentJobs != nil {
+		opts.Search.ConcurrentJobs = *tuning.Query.SearchConcurrentJobs
+	}
+	if tuning.Query.MaxConcurrentQueries != nil {
+		opts.Search.MaxConcurrentQueries = *tuning.Query.MaxConcurrentQueries
+	}
+
+	// The per-tenant overrides file is always configured, so that adding or removing
+	// per-tenant limits does not change the main configuration file and restart the pods.
+	opts.TenantRateLimitsPath = manifestutils.TenantOverridesFile
+
+	if tempo.Spec.UserConfigurableOverridesEnabled() {
+		uco := tempo.Spec.UserConfigurableOverrides
+		opts.UserConfigurableOverrides = userConfigurableOverridesOptions{
+			Enabled:      true,
+			PollInterval: defaultUserConfigurableOverridesPollInterval.String(),
+		}
+		if uco.PollInterval != nil {
+			opts.UserConfigurableOverrides.PollInterval = uco.PollInterval.Duration.String()
+		}
 	}
 
 	if tempo.Spec.Template.MetricsGenerator.Enabled {
@@ -145,10 +251,6 @@ func buildConfiguration(params manifestutils.Params) ([]byte, error) {
 	return renderTemplate(opts)
 }
 
-func isTenantOverridesConfigRequired(limitSpec v1alpha1.LimitSpec, retentionSpec v1alpha1.RetentionSpec) bool {
-	return len(limitSpec.PerTenant) > 0 || len(retentionSpec.PerTenant) > 0
-}
-
 func buildTenantOverrides(tempo v1alpha1.TempoStack) ([]byte, error) {
 	return renderTenantOverridesTemplate(tenantOptions{
 		TenantOverrides: fromRateLimitSpecToRateLimitOptionsMap(tempo.Spec.LimitSpec.PerTenant, tempo.Spec.Retention.PerTenant),
@@ -65,6 +65,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -179,6 +181,140 @@ func TestBuildConfiguration_MetricsGeneratorProcessors(t *testing.T) {
 	require.Equal(t, []interface{}{"service-graphs", "span-metrics"}, overrides["metrics_generator_processors"])
 }
 
+func TestBuildConfiguration_UserConfigurableOverrides(t *testing.T) {
+	tests := []struct {
+		name          string
+		spec          *v1alpha1.UserConfigurableOverridesSpec
+		storageType   v1alpha1.ObjectStorageSecretType
+		storageParams manifestutils.StorageParams
+		expected      interface{}
+	}{
+		{
+			name:        "disabled",
+			storageType: v1alpha1.ObjectStorageSecretS3,
+			storageParams: manifestutils.StorageParams{
+				CredentialMode: v1alpha1.CredentialModeStatic,
+				S3:             &manifestutils.S3{Endpoint: "minio:9000", Bucket: "tempo"},
+			},
+		},
+		{
+			name:        "s3 static credentials",
+			spec:        &v1alpha1.UserConfigurableOverridesSpec{Enabled: true},
+			storageType: v1alpha1.ObjectStorageSecretS3,
+			storageParams: manifestutils.StorageParams{
+				CredentialMode: v1alpha1.CredentialModeStatic,
+				S3:             &manifestutils.S3{Endpoint: "minio:9000", Bucket: "tempo", Insecure: true},
+			},
+			expected: map[string]interface{}{
+				"enabled":       true,
+				"poll_interval": "1m0s",
+				"client": map[string]interface{}{
+					"backend": "s3",
+					"s3": map[string]interface{}{
+						"bucket":     "tempo",
+						"endpoint":   "minio:9000",
+						"access_key": "${S3_ACCESS_KEY}",
+						"secret_key": "${S3_SECRET_KEY}",
+						"insecure":   true,
+					},
+				},
+			},
+		},
+		{
+			name:        "s3 short-lived token",
+			spec:        &v1alpha1.UserConfigurableOverridesSpec{Enabled: true, PollInterval: &metav1.Duration{Duration: 30 * time.Second}},
+			storageType: v1alpha1.ObjectStorageSecretS3,
+			storageParams: manifestutils.StorageParams{
+				CredentialMode: v1alpha1.CredentialModeToken,
+				S3:             &manifestutils.S3{Bucket: "tempo", Region: "us-east-1"},
+			},
+			expected: map[string]interface{}{
+				"enabled":       true,
+				"poll_interval": "30s",
+				"client": map[string]interface{}{
+					"backend": "s3",
+					"s3": map[string]interface{}{
+						"bucket":   "tempo",
+						"endpoint": "s3.us-east-1.amazonaws.com",
+						"insecure": false,
+					},
+				},
+			},
+		},
+		{
+			name:        "azure static credentials",
+			spec:        &v1alpha1.UserConfigurableOverridesSpec{Enabled: true},
+			storageType: v1alpha1.ObjectStorageSecretAzure,
+			storageParams: manifestutils.StorageParams{
+				CredentialMode: v1alpha1.CredentialModeStatic,
+				AzureStorage:   &manifestutils.AzureStorage{Container: "tempo"},
+			},
+			expected: map[string]interface{}{
+				"enabled":       true,
+				"poll_interval": "1m0s",
+				"client": map[string]interface{}{
+					"backend": "azure",
+					"azure": map[string]interface{}{
+						"container_name":       "tempo",
+						"storage_account_name": "${AZURE_ACCOUNT_NAME}",
+						"storage_account_key":  "${AZURE_ACCOUNT_KEY}",
+					},
+				},
+			},
+		},
+		{
+			name:        "gcs",
+			spec:        &v1alpha1.UserConfigurableOverridesSpec{Enabled: true},
+			storageType: v1alpha1.ObjectStorageSecretGCS,
+			storageParams: manifestutils.StorageParams{
+				CredentialMode: v1alpha1.CredentialModeStatic,
+				GCS:            &manifestutils.GCS{Bucket: "tempo"},
+			},
+			expected: map[string]interface{}{
+				"enabled":       true,
+				"poll_interval": "1m0s",
+				"client": map[string]interface{}{
+					"backend": "gcs",
+					"gcs": map[string]interface{}{
+						"bucket_name": "tempo",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := buildConfiguration(manifestutils.Params{
+				Tempo: v1alpha1.TempoStack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test",
+					},
+					Spec: v1alpha1.TempoStackSpec{
+						Timeout: metav1.Duration{Duration: time.Second * 30},
+						Storage: v1alpha1.ObjectStorageSpec{
+							Secret: v1alpha1.ObjectStorageSecretSpec{
+								Type: tc.storageType,
+							},
+						},
+						ReplicationFactor:         1,
+						UserConfigurableOverrides: tc.spec,
+					},
+				},
+				StorageParams: tc.storageParams,
+			})
+			require.NoError(t, err)
+
+			var rendered map[string]interface{}
+			require.NoError(t, yaml.Unmarshal(cfg, &rendered))
+
+			overrides, ok := rendered["overrides"].(map[string]interface{})
+			require.True(t, ok)
+			require.Equal(t, tc.expected, overrides["user_configurable_overrides"])
+		})
+	}
+}
+
 func TestBuildConfiguration_RateLimits(t *testing.T) {
 
 	testCases := []struct {
@@ -233,6 +369,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -320,6 +458,7 @@ memberlist:
 multitenancy_enabled: false
 overrides:
   ingestion_rate_limit_bytes: 100
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -406,6 +545,7 @@ memberlist:
 multitenancy_enabled: false
 overrides:
   ingestion_burst_size_bytes: 100
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -493,6 +633,7 @@ memberlist:
 multitenancy_enabled: false
 overrides:
   max_bytes_per_trace: 100
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -580,6 +721,7 @@ memberlist:
 multitenancy_enabled: false
 overrides:
   max_traces_per_user: 100
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -667,6 +809,7 @@ memberlist:
 multitenancy_enabled: false
 overrides:
   max_bytes_per_tag_values_query: 100
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -754,6 +897,7 @@ memberlist:
 multitenancy_enabled: false
 overrides:
   max_search_duration: 24h0m0s
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -853,6 +997,7 @@ overrides:
   max_bytes_per_trace: 400
   max_bytes_per_tag_values_query: 500
   max_search_duration: 24h0m0s
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -949,7 +1094,7 @@ memberlist:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
 overrides:
-  per_tenant_override_config: /conf/overrides.yaml
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -1140,6 +1285,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -1253,6 +1400,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -1356,6 +1505,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: true
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -1484,6 +1635,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -1603,6 +1756,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -1763,6 +1918,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -1942,6 +2099,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -2048,6 +2207,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -2160,6 +2321,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -2272,6 +2435,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -2403,6 +2568,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -2484,6 +2651,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -2606,6 +2775,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -2718,6 +2889,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -2833,6 +3006,8 @@ memberlist:
     - tempo-test-gossip-ring
   advertise_addr: ${HASH_RING_INSTANCE_ADDR}
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -2950,6 +3125,8 @@ memberlist:
   join_members:
     - tempo-test-gossip-ring
 multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
 querier:
   max_concurrent_queries: 20
   frontend_worker:
@@ -3159,3 +3336,230 @@ func TestApplyRateLimitDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildConfiguration_Tuning(t *testing.T) {
+	expCfg := `
+---
+compactor:
+  compaction:
+    block_retention: 48h0m0s
+    compaction_window: 30m0s
+    max_compaction_objects: 500000
+    max_block_bytes: 2147483648
+  ring:
+    kvstore:
+      store: memberlist
+distributor:
+  receivers:
+    jaeger:
+      protocols:
+        thrift_http:
+          endpoint: 0.0.0.0:14268
+        thrift_binary:
+          endpoint: 0.0.0.0:6832
+        thrift_compact:
+          endpoint: 0.0.0.0:6831
+        grpc:
+          endpoint: 0.0.0.0:14250
+    zipkin:
+      endpoint: 0.0.0.0:9411
+    otlp:
+      protocols:
+        grpc:
+          endpoint: "0.0.0.0:4317"
+        http:
+          endpoint: "0.0.0.0:4318"
+  ring:
+    kvstore:
+      store: memberlist
+ingester:
+  lifecycler:
+    ring:
+      kvstore:
+        store: memberlist
+      replication_factor: 1
+    tokens_file_path: /var/tempo/tokens.json
+  max_block_duration: 5m0s
+  max_block_bytes: 104857600
+  trace_idle_period: 20s
+memberlist:
+  abort_if_cluster_join_fails: false
+  join_members:
+    - tempo-test-gossip-ring
+multitenancy_enabled: false
+overrides:
+  per_tenant_override_config: /overrides/overrides.yaml
+querier:
+  max_concurrent_queries: 15
+  frontend_worker:
+    frontend_address: "tempo-test-query-frontend-discovery:9095"
+server:
+  grpc_server_max_recv_msg_size: 4194304
+  grpc_server_max_send_msg_size: 4194304
+  http_listen_port: 3200
+  http_server_read_timeout: 30s
+  http_server_write_timeout: 30s
+  log_format: logfmt
+storage:
+  trace:
+    backend: s3
+    blocklist_poll: 5m
+    local:
+      path: /var/tempo/traces
+    s3:
+      bucket: tempo
+      endpoint: "minio:9000"
+      insecure: true
+    wal:
+      path: /var/tempo/wal
+usage_report:
+  reporting_enabled: false
+query_frontend:
+  max_outstanding_per_tenant: 3000
+  trace_by_id:
+    query_shards: 100
+  metrics:
+    concurrent_jobs: 500
+  search:
+    concurrent_jobs: 1500
+    max_duration: 0s
+    max_spans_per_span_set: 0
+`
+	cfg, err := buildConfiguration(manifestutils.Params{
+		Tempo: v1alpha1.TempoStack{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: v1alpha1.TempoStackSpec{
+				Timeout: metav1.Duration{Duration: time.Second * 30},
+				Storage: v1alpha1.ObjectStorageSpec{
+					Secret: v1alpha1.ObjectStorageSecretSpec{
+						Type: v1alpha1.ObjectStorageSecretS3,
+					},
+				},
+				ReplicationFactor: 1,
+				Retention: v1alpha1.RetentionSpec{
+					Global: v1alpha1.RetentionConfig{
+						Traces: metav1.Duration{Duration: 48 * time.Hour},
+					},
+				},
+				Tuning: &v1alpha1.TuningSpec{
+					Compaction: &v1alpha1.CompactionTuningSpec{
+						CompactionWindow:     &metav1.Duration{Duration: 30 * time.Minute},
+						MaxCompactionObjects: intToPointer(500000),
+						MaxBlockBytes:        intToPointer(2147483648),
+					},
+					Ingestion: &v1alpha1.IngestionTuningSpec{
+						MaxBlockDuration: &metav1.Duration{Duration: 5 * time.Minute},
+						MaxBlockBytes:    intToPointer(104857600),
+						TraceIdlePeriod:  &metav1.Duration{Duration: 20 * time.Second},
+					},
+					Query: &v1alpha1.QueryTuningSpec{
+						TraceByIDQueryShards:    intToPointer(100),
+						SearchConcurrentJobs:    intToPointer(1500),
+						MetricsConcurrentJobs:   intToPointer(500),
+						MaxOutstandingPerTenant: intToPointer(3000),
+						MaxConcurrentQueries:    intToPointer(15),
+					},
+				},
+			},
+		},
+		StorageParams: manifestutils.StorageParams{
+			CredentialMode: v1alpha1.CredentialModeStatic,
+			S3: &manifestutils.S3{
+				Insecure: true,
+				Endpoint: "minio:9000",
+				Bucket:   "tempo",
+			},
+		},
+		TLSProfile: tlsprofile.TLSProfileOptions{
+			MinTLSVersion: string(openshiftconfigv1.VersionTLS13),
+		},
+	})
+	require.NoError(t, err)
+	require.YAMLEq(t, expCfg, string(cfg))
+}
+
+func TestApplyTuningDefaults(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     *v1alpha1.TuningSpec
+		size     v1alpha1.TempoStackSize
+		expected v1alpha1.TuningSpec
+	}{
+		{
+			name: "no size and no spec",
+			expected: v1alpha1.TuningSpec{
+				Compaction: &v1alpha1.CompactionTuningSpec{},
+				Ingestion:  &v1alpha1.IngestionTuningSpec{},
+				Query:      &v1alpha1.QueryTuningSpec{},
+			},
+		},
+		{
+			name: "demo size has no defaults",
+			size: v1alpha1.SizeDemo,
+			expected: v1alpha1.TuningSpec{
+				Compaction: &v1alpha1.CompactionTuningSpec{},
+				Ingestion:  &v1alpha1.IngestionTuningSpec{},
+				Query:      &v1alpha1.QueryTuningSpec{},
+			},
+		},
+		{
+			name: "medium size applies defaults",
+			size: v1alpha1.SizeMedium,
+			expected: v1alpha1.TuningSpec{
+				Compaction: &v1alpha1.CompactionTuningSpec{
+					CompactionWindow: &metav1.Duration{Duration: 30 * time.Minute},
+				},
+				Ingestion: &v1alpha1.IngestionTuningSpec{
+					MaxBlockBytes: intToPointer(1_000_000_000),
+				},
+				Query: &v1alpha1.QueryTuningSpec{
+					SearchConcurrentJobs:    intToPointer(4000),
+					TraceByIDQueryShards:    intToPointer(100),
+					MaxOutstandingPerTenant: intToPointer(8000),
+					MaxConcurrentQueries:    intToPointer(40),
+				},
+			},
+		},
+		{
+			name: "user values override size defaults",
+			size: v1alpha1.SizeMedium,
+			spec: &v1alpha1.TuningSpec{
+				Compaction: &v1alpha1.CompactionTuningSpec{
+					CompactionWindow: &metav1.Duration{Duration: 2 * time.Hour},
+				},
+				Query: &v1alpha1.QueryTuningSpec{
+					SearchConcurrentJobs: intToPointer(10),
+				},
+			},
+			expected: v1alpha1.TuningSpec{
+				Compaction: &v1alpha1.CompactionTuningSpec{
+					CompactionWindow: &metav1.Duration{Duration: 2 * time.Hour},
+				},
+				Ingestion: &v1alpha1.IngestionTuningSpec{
+					MaxBlockBytes: intToPointer(1_000_000_000),
+				},
+				Query: &v1alpha1.QueryTuningSpec{
+					SearchConcurrentJobs:    intToPointer(10),
+					TraceByIDQueryShards:    intToPointer(100),
+					MaxOutstandingPerTenant: intToPointer(8000),
+					MaxConcurrentQueries:    intToPointer(40),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var original *v1alpha1.TuningSpec
+			if tt.spec != nil {
+				original = tt.spec.DeepCopy()
+			}
+			result := applyTuningDefaults(tt.spec, tt.size)
+			require.Equal(t, tt.expected, result)
+			// the spec of the CR must not be modified
+			require.Equal(t, original, tt.spec)
+		})
+	}
+}
@@ -7,48 +7,53 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/manifests/naming"
 )
 
-const tenantOverridesMountPath = "/conf/overrides.yaml"
 const tempoConfigKey = "tempo.yaml"
 const tempoQueryFrontendConfigKey = "tempo-query-frontend.yaml"
 const tempoQueryConfigKey = "tempo-query.yaml"
-const overridesConfigKey = "overrides.yaml"
 
-// BuildConfigMap builds the tempo configuration file and the tenant-specific overrides configuration.
-// It returns a ConfigMap containing both configuration files and the checksum of the main configuration file
-// (the tenant-specific configuration gets reloaded automatically, therefore no checksum is required).
-func BuildConfigMap(params manifestutils.Params) (*corev1.ConfigMap, string, error) {
+// DefaultMaxBlockDuration is the max_block_duration of the ingesters if the maxBlockDuration tuning option is not set.
+const DefaultMaxBlockDuration = "10m"
+
+// Checksums holds the checksums of the generated Tempo configuration files.
+type Checksums struct {
+	// Config is the checksum of the main configuration file shared by all components.
+	Config string
+	// Components holds the checksums of the component-specific configuration files, keyed by component name.
+	Components map[string]string
+}
+
+// BuildConfigMap builds the tempo configuration file.
+// It returns a ConfigMap containing the configuration files and the checksum of the main configuration file
+// and of the component-specific configuration files.
+func BuildConfigMap(params manifestutils.Params) (*corev1.ConfigMap, Checksums, error) {
 	tempo := params.Tempo
 
 	config, err := buildConfiguration(params)
 	if err != nil {
-		return nil, "", err
-	}
-
-	overridesConfig, err := buildTenantOverrides(tempo)
-	if err != nil {
-		return nil, "", err
+		return nil, Checksums{}, err
 	}
 
 	frontendConfig, err := buildQueryFrontEndConfig(params)
 	if err != nil {
-		return nil, "", err
+		return nil, Checksums{}, err
 	}
 
 	if params.Tempo.Spec.ExtraConfig != nil {
 		// For we only support tempo for now.
 		config, err = MergeExtraConfigWithConfig(params.Tempo.Spec.ExtraConfig.Tempo, config)
 		if err != nil {
-			return nil, "", err
+			return nil, Checksums{}, err
 		}
 
 		// Is the same tempo config with certain TLS fields disabled.
 		frontendConfig, err = MergeExtraConfigWithConfig(params.Tempo.Spec.ExtraConfig.Tempo, frontendConfig)
 		if err != nil {
-			return nil, "", err
+			return nil, Checksums{}, err
 		}
 	}
 
@@ -60,23 +65,80 @@ func BuildConfigMap(params manifestutils.Params) (*corev1.ConfigMap, string, err
 			Labels:    labels,
 		},
 		Data: map[string]string{
-			tempoConfigKey:              string(config),
-			tempoQueryFrontendConfigKey: string(frontendConfig),
-			overridesConfigKey:          string(overridesConfig),
+			tempoConfigKey: string(config),
 		},
 	}
 	if tempo.Spec.Template.QueryFrontend.JaegerQuery.Enabled {
 		tempoQueryConfig, err := buildTempoQueryConfig(params)
 		if err != nil {
-			return nil, "", err
+			return nil, Checksums{}, err
 		}
 		configMap.Data[tempoQueryConfigKey] = string(tempoQueryConfig)
 	}
 
-	// We only need to hash the main ConfigMap, the per-tenant overrides
-	// is reloaded by tempo without requiring a restart
-	h := sha256.Sum256(config)
-	checksum := fmt.Sprintf("%x", h)
+	checksums := Checksums{
+		Config:     checksum(config),
+		Components: map[string]string{},
+	}
+
+	// Components with extra configuration get their own configuration file, which is layered on top
+	// of the main configuration file. The checksum of this file is used for the pods of this component only.
+	components := map[string]*v1alpha1.ExtraConfigSpec{
+		manifestutils.DistributorComponentName:      tempo.Spec.Template.Distributor.ExtraConfig,
+		manifestutils.IngesterComponentName:         tempo.Spec.Template.Ingester.ExtraConfig,
+		manifestutils.QuerierComponentName:          tempo.Spec.Template.Querier.ExtraConfig,
+		manifestutils.CompactorComponentName:        tempo.Spec.Template.Compactor.ExtraConfig,
+		manifestutils.MetricsGeneratorComponentName: tempo.Spec.Template.MetricsGenerator.ExtraConfig,
+	}
+	for component, extraConfig := range components {
+		if extraConfig == nil || len(extraConfig.Tempo.Raw) == 0 {
+			continue
+		}
+
+		componentConfig, err := MergeExtraConfigWithConfig(extraConfig.Tempo, config)
+		if err != nil {
+			return nil, Checksums{}, err
+		}
+		configMap.Data[manifestutils.ComponentConfigFileName(component)] = string(componentConfig)
+		checksums.Components[component] = checksum(componentConfig)
+	}
+
+	// The query-frontend always uses its own configuration file.
+	if extraConfig := tempo.Spec.Template.QueryFrontend.ExtraConfig; extraConfig != nil && len(extraConfig.Tempo.Raw) > 0 {
+		frontendConfig, err = MergeExtraConfigWithConfig(extraConfig.Tempo, frontendConfig)
+		if err != nil {
+			return nil, Checksums{}, err
+		}
+		checksums.Components[manifestutils.QueryFrontendComponentName] = checksum(frontendConfig)
+	}
+	configMap.Data[tempoQueryFrontendConfigKey] = string(frontendConfig)
+
+	return configMap, checksums, nil
+}
 
-	return configMap, checksum, nil
+func checksum(data []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+// BuildTenantOverridesConfigMap builds the ConfigMap containing the per-tenant overrides.
+// The overrides are stored in a separate ConfigMap and are not part of any checksum,
+// because Tempo reloads the per-tenant overrides periodically without requiring a restart.
+func BuildTenantOverridesConfigMap(params manifestutils.Params) (*corev1.ConfigMap, error) {
+	tempo := params.Tempo
+
+	overridesConfig, err := buildTenantOverrides(tempo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      manifestutils.TenantOverridesConfigMapName(tempo.Name),
+			Namespace: tempo.Namespace,
+			Labels:    manifestutils.ComponentLabels("overrides", tempo.Name),
+		},
+		Data: map[string]string{
+			manifestutils.TenantOverridesFileName: string(overridesConfig),
+		},
+	}, nil
 }
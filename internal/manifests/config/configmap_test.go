@@ -8,7 +8,10 @@ import (
 
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
@@ -16,7 +19,7 @@ import (
 )
 
 func TestConfigmap(t *testing.T) {
-	cm, checksum, err := BuildConfigMap(manifestutils.Params{
+	cm, checksums, err := BuildConfigMap(manifestutils.Params{
 		Tempo: v1alpha1.TempoStack{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "test",
@@ -43,6 +46,126 @@ func TestConfigmap(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, cm.Data)
 	require.NotNil(t, cm.Data["tempo.yaml"])
-	require.NotNil(t, cm.Data["overrides.yaml"])
-	require.Equal(t, fmt.Sprintf("%x", sha256.Sum256([]byte(cm.Data["tempo.yaml"]))), checksum)
+	require.NotContains(t, cm.Data, "overrides.yaml")
+	require.Equal(t, fmt.Sprintf("%x", sha256.Sum256([]byte(cm.Data["tempo.yaml"]))), checksums.Config)
+	require.Empty(t, checksums.Components)
+}
+
+func TestTenantOverridesConfigMap(t *testing.T) {
+	params := manifestutils.Params{
+		Tempo: v1alpha1.TempoStack{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "nstest",
+			},
+		},
+		StorageParams: manifestutils.StorageParams{
+			S3: &manifestutils.S3{
+				Endpoint: "http://minio:9000",
+				Bucket:   "tempo",
+			},
+		},
+	}
+	_, checksumsBefore, err := BuildConfigMap(params)
+	require.NoError(t, err)
+
+	params.Tempo.Spec.LimitSpec.PerTenant = map[string]v1alpha1.RateLimitSpec{
+		"tenant-a": {
+			Ingestion: v1alpha1.IngestionLimitSpec{
+				IngestionRateLimitBytes: ptr.To(1000),
+			},
+		},
+	}
+	params.Tempo.Spec.Retention.PerTenant = map[string]v1alpha1.RetentionConfig{
+		"tenant-a": {
+			Traces: metav1.Duration{Duration: 24 * time.Hour},
+		},
+	}
+
+	cm, err := BuildTenantOverridesConfigMap(params)
+	require.NoError(t, err)
+	require.Equal(t, "tempo-test-overrides", cm.Name)
+	require.Equal(t, "nstest", cm.Namespace)
+	require.YAMLEq(t, `
+overrides:
+  "tenant-a":
+    ingestion:
+      rate_limit_bytes: 1000
+    read:
+    compaction:
+      block_retention: 24h0m0s
+`, cm.Data["overrides.yaml"])
+
+	// Changing the per-tenant overrides must not restart the pods.
+	_, checksumsAfter, err := BuildConfigMap(params)
+	require.NoError(t, err)
+	require.Equal(t, checksumsBefore, checksumsAfter)
+}
+
+func TestConfigmap_ComponentExtraConfig(t *testing.T) {
+	params := manifestutils.Params{
+		Tempo: v1alpha1.TempoStack{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: v1alpha1.TempoStackSpec{
+				ExtraConfig: &v1alpha1.ExtraConfigSpec{
+					Tempo: apiextensionsv1.JSON{Raw: []byte(`{"querier": {"max_concurrent_queries": 10}}`)},
+				},
+				Template: v1alpha1.TempoTemplateSpec{
+					Querier: v1alpha1.TempoComponentSpec{
+						ExtraConfig: &v1alpha1.ExtraConfigSpec{
+							Tempo: apiextensionsv1.JSON{Raw: []byte(`{"querier": {"max_concurrent_queries": 50}}`)},
+						},
+					},
+					QueryFrontend: v1alpha1.TempoQueryFrontendSpec{
+						TempoComponentSpec: v1alpha1.TempoComponentSpec{
+							ExtraConfig: &v1alpha1.ExtraConfigSpec{
+								Tempo: apiextensionsv1.JSON{Raw: []byte(`{"query_frontend": {"max_retries": 5}}`)},
+							},
+						},
+					},
+					Compactor: v1alpha1.TempoComponentSpec{
+						ExtraConfig: &v1alpha1.ExtraConfigSpec{},
+					},
+				},
+			},
+		},
+		StorageParams: manifestutils.StorageParams{
+			S3: &manifestutils.S3{
+				Endpoint: "http://minio:9000",
+				Bucket:   "tempo",
+			},
+		},
+	}
+
+	cm, checksums, err := BuildConfigMap(params)
+	require.NoError(t, err)
+
+	config := map[string]any{}
+	require.NoError(t, yaml.Unmarshal([]byte(cm.Data["tempo.yaml"]), &config))
+	require.Equal(t, 10, config["querier"].(map[string]any)["max_concurrent_queries"])
+
+	querierConfig := map[string]any{}
+	require.NoError(t, yaml.Unmarshal([]byte(cm.Data["tempo-querier.yaml"]), &querierConfig))
+	require.Equal(t, 50, querierConfig["querier"].(map[string]any)["max_concurrent_queries"])
+
+	frontendConfig := map[string]any{}
+	require.NoError(t, yaml.Unmarshal([]byte(cm.Data["tempo-query-frontend.yaml"]), &frontendConfig))
+	require.Equal(t, 5, frontendConfig["query_frontend"].(map[string]any)["max_retries"])
+
+	require.NotContains(t, cm.Data, "tempo-compactor.yaml")
+	require.Equal(t, fmt.Sprintf("%x", sha256.Sum256([]byte(cm.Data["tempo.yaml"]))), checksums.Config)
+	require.Equal(t, map[string]string{
+		"querier":        fmt.Sprintf("%x", sha256.Sum256([]byte(cm.Data["tempo-querier.yaml"]))),
+		"query-frontend": fmt.Sprintf("%x", sha256.Sum256([]byte(cm.Data["tempo-query-frontend.yaml"]))),
+	}, checksums.Components)
+
+	// changing the querier configuration must not change the checksum of other components
+	params.Tempo.Spec.Template.Querier.ExtraConfig.Tempo.Raw = []byte(`{"querier": {"max_concurrent_queries": 100}}`)
+	_, newChecksums, err := BuildConfigMap(params)
+	require.NoError(t, err)
+	require.Equal(t, checksums.Config, newChecksums.Config)
+	require.Equal(t, checksums.Components["query-frontend"], newChecksums.Components["query-frontend"])
+	require.NotEqual(t, checksums.Components["querier"], newChecksums.Components["querier"])
 }
@@ -14,10 +14,12 @@ type options struct {
 	StorageParams          manifestutils.StorageParams
 	GlobalRateLimits       tenantOverrides
 	TenantRateLimitsPath   string
-	TLS                    tlsOptions
-	MemberList             memberlistOptions
-	Search                 searchOptions
-	ReplicationFactor      int
+	// UserConfigurableOverrides configures the user-configurable overrides module, which stores the overrides in the object storage.
+	UserConfigurableOverrides userConfigurableOverridesOptions
+	TLS                       tlsOptions
+	MemberList                memberlistOptions
+	Search                    searchOptions
+	ReplicationFactor         int
 	// EnableInstanceAvailabilityZone enables zone-aware replication of the ingester ring.
 	EnableInstanceAvailabilityZone bool
 	Multitenancy                   bool
@@ -28,6 +30,32 @@ type options struct {
 	Timeout                        time.Duration
 	MCPServer                      mcpserverOptions
 	MetricsGenerator               metricsGeneratorOptions
+	Compaction                     compactionOptions
+	Ingestion                      ingestionOptions
+	Query                          queryOptions
+}
+
+type compactionOptions struct {
+	CompactionWindow     string
+	MaxCompactionObjects int
+	MaxBlockBytes        int
+}
+
+type ingestionOptions struct {
+	MaxBlockDuration string
+	MaxBlockBytes    int
+	TraceIdlePeriod  string
+}
+
+type queryOptions struct {
+	TraceByIDQueryShards    int
+	MetricsConcurrentJobs   int
+	MaxOutstandingPerTenant int
+}
+
+type userConfigurableOverridesOptions struct {
+	Enabled      bool
+	PollInterval string
 }
 
 type metricsGeneratorOptions struct {
@@ -0,0 +1,284 @@
+package config
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	schemaTypeString   = "string"
+	schemaTypeInt      = "int"
+	schemaTypeFloat    = "float"
+	schemaTypeBool     = "bool"
+	schemaTypeDuration = "duration"
+	schemaTypeMap      = "map"
+	schemaTypeList     = "list"
+	schemaTypeAny      = "any"
+
+	schemaWildcardKey = "*"
+)
+
+// managedConfigKeys lists the keys of the Tempo configuration which are generated by the operator,
+// together with the area of concern which is shown to the user when extraConfig overrides them.
+var managedConfigKeys = []struct {
+	path    []string
+	concern string
+}{
+	{path: []string{"server", "http_listen_port"}, concern: "ports"},
+	{path: []string{"server", "grpc_listen_port"}, concern: "ports"},
+	{path: []string{"server", "http_tls_config"}, concern: "TLS"},
+	{path: []string{"server", "grpc_tls_config"}, concern: "TLS"},
+	{path: []string{"server", "tls_min_version"}, concern: "TLS"},
+	{path: []string{"server", "tls_cipher_suites"}, concern: "TLS"},
+	{path: []string{"internal_server"}, concern: "TLS"},
+	{path: []string{"ingester_client", "grpc_client_config"}, concern: "TLS"},
+	{path: []string{"metrics_generator_client", "grpc_client_config"}, concern: "TLS"},
+	{path: []string{"querier", "frontend_worker"}, concern: "TLS"},
+	{path: []string{"distributor", "receivers"}, concern: "receivers"},
+	{path: []string{"storage", "trace", "backend"}, concern: "storage"},
+	{path: []string{"storage", "trace", "s3"}, concern: "storage"},
+	{path: []string{"storage", "trace", "gcs"}, concern: "storage"},
+	{path: []string{"storage", "trace", "azure"}, concern: "storage"},
+	{path: []string{"storage", "trace", "local"}, concern: "storage"},
+	{path: []string{"storage", "trace", "wal"}, concern: "storage"},
+	{path: []string{"memberlist", "join_members"}, concern: "cluster membership"},
+	{path: []string{"ingester", "lifecycler", "ring", "replication_factor"}, concern: "replication"},
+	{path: []string{"multitenancy_enabled"}, concern: "multi-tenancy"},
+	{path: []string{"overrides", "per_tenant_override_config"}, concern: "per-tenant overrides"},
+}
+
+var (
+	//go:embed tempo-config-schema.yaml
+	tempoConfigSchemaYAML []byte
+	tempoConfigSchema     = mustParseSchema(tempoConfigSchemaYAML)
+)
+
+func mustParseSchema(data []byte) interface{} {
+	var root map[string]interface{}
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		panic(fmt.Sprintf("invalid Tempo configuration schema: %v", err))
+	}
+	schema, ok := root["tempo"]
+	if !ok {
+		panic("invalid Tempo configuration schema: missing root key 'tempo'")
+	}
+	return schema
+}
+
+// ValidateExtraConfig validates the extra Tempo configuration against the configuration schema of the bundled Tempo version.
+// Type mismatches are returned as errors. Unknown keys are returned as warnings, because the schema does not cover every
+// key of Tempo (e.g. experimental settings), and keys which override configuration managed by the operator are returned
+// as warnings.
+func ValidateExtraConfig(extraConfig apiextensionsv1.JSON, path *field.Path) ([]string, field.ErrorList) {
+	if len(extraConfig.Raw) == 0 {
+		return nil, nil
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(extraConfig.Raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, field.ErrorList{field.Invalid(path, string(extraConfig.Raw), fmt.Sprintf("invalid JSON: %v", err))}
+	}
+	if value == nil {
+		return nil, nil
+	}
+
+	warnings, errs := validateSchema(tempoConfigSchema, value, path)
+	return append(warnings, managedKeyWarnings(value, path)...), errs
+}
+
+// validateSchema returns a warning for every unknown key and an error for every type mismatch.
+func validateSchema(schema interface{}, value interface{}, path *field.Path) ([]string, field.ErrorList) {
+	// null values are ignored when merging the extra configuration
+	if value == nil {
+		return nil, nil
+	}
+
+	switch s := schema.(type) {
+	case string:
+		return nil, validateType(s, value, path)
+
+	case []interface{}:
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, field.ErrorList{field.Invalid(path, value, "must be a list")}
+		}
+		var warnings []string
+		var errs field.ErrorList
+		for i, item := range items {
+			w, e := validateSchema(s[0], item, path.Index(i))
+			warnings = append(warnings, w...)
+			errs = append(errs, e...)
+		}
+		return warnings, errs
+
+	case map[string]interface{}:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, field.ErrorList{field.Invalid(path, value, "must be an object")}
+		}
+
+		var warnings []string
+		var errs field.ErrorList
+		for _, key := range sortedKeys(object) {
+			keySchema, ok := s[key]
+			if !ok {
+				keySchema, ok = s[schemaWildcardKey]
+			}
+			if !ok {
+				warnings = append(warnings, fmt.Sprintf("%s: %s", path.Child(key), unknownKeyMessage(key, s)))
+				continue
+			}
+			w, e := validateSchema(keySchema, object[key], path.Child(key))
+			warnings = append(warnings, w...)
+			errs = append(errs, e...)
+		}
+		return warnings, errs
+	}
+
+	return nil, nil
+}
+
+func validateType(typ string, value interface{}, path *field.Path) field.ErrorList {
+	valid := true
+	switch typ {
+	case schemaTypeString:
+		_, valid = value.(string)
+	case schemaTypeInt:
+		n, ok := value.(json.Number)
+		if valid = ok; ok {
+			_, err := n.Int64()
+			valid = err == nil
+		}
+	case schemaTypeFloat:
+		_, valid = value.(json.Number)
+	case schemaTypeBool:
+		_, valid = value.(bool)
+	case schemaTypeDuration:
+		switch v := value.(type) {
+		case string:
+			_, err := time.ParseDuration(v)
+			valid = err == nil
+		case json.Number:
+			_, err := v.Int64()
+			valid = err == nil
+		default:
+			valid = false
+		}
+	case schemaTypeMap:
+		_, valid = value.(map[string]interface{})
+	case schemaTypeList:
+		_, valid = value.([]interface{})
+	}
+
+	if !valid {
+		return field.ErrorList{field.Invalid(path, value, typeMismatchMessage(typ))}
+	}
+	return nil
+}
+
+func typeMismatchMessage(typ string) string {
+	switch typ {
+	case schemaTypeInt:
+		return "must be an integer"
+	case schemaTypeFloat:
+		return "must be a number"
+	case schemaTypeBool:
+		return "must be a boolean"
+	case schemaTypeDuration:
+		return "must be a duration (e.g. 30s, 5m, 1h)"
+	case schemaTypeMap:
+		return "must be an object"
+	case schemaTypeList:
+		return "must be a list"
+	default:
+		return fmt.Sprintf("must be a %s", typ)
+	}
+}
+
+func unknownKeyMessage(key string, schema map[string]interface{}) string {
+	msg := "unknown Tempo configuration key"
+
+	suggestion := ""
+	best := len(key)/3 + 1
+	for _, candidate := range sortedKeys(schema) {
+		if candidate == schemaWildcardKey {
+			continue
+		}
+		if d := levenshtein(key, candidate); d < best {
+			best = d
+			suggestion = candidate
+		}
+	}
+	if suggestion != "" {
+		msg = fmt.Sprintf("%s, did you mean %q?", msg, suggestion)
+	}
+	return msg
+}
+
+func managedKeyWarnings(value interface{}, path *field.Path) []string {
+	var warnings []string
+	for _, managed := range managedConfigKeys {
+		current := value
+		found := true
+		for _, key := range managed.path {
+			object, ok := current.(map[string]interface{})
+			if !ok {
+				found = false
+				break
+			}
+			if current, ok = object[key]; !ok {
+				found = false
+				break
+			}
+		}
+		if found {
+			warnings = append(warnings, fmt.Sprintf(
+				"%s overrides configuration managed by the operator (%s)",
+				path.Child(managed.path[0], managed.path[1:]...), managed.concern,
+			))
+		}
+	}
+	return warnings
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
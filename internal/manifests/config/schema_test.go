@@ -0,0 +1,222 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+	"github.com/grafana/tempo-operator/internal/tlsprofile"
+)
+
+func TestValidateExtraConfig(t *testing.T) {
+	path := field.NewPath("spec", "extraConfig", "tempo")
+
+	tests := []struct {
+		name     string
+		input    string
+		warnings []string
+		errors   field.ErrorList
+	}{
+		{
+			name:  "empty",
+			input: ``,
+		},
+		{
+			name:  "empty object",
+			input: `{}`,
+		},
+		{
+			name: "valid config",
+			input: `{
+				"server": {"http_server_write_timeout": "10m", "http_server_read_timeout": "10m", "log_level": "debug"},
+				"querier": {"search": {"query_timeout": "180s"}, "max_concurrent_queries": 10},
+				"query_frontend": {"max_retries": 3, "search": {"concurrent_jobs": 2000}},
+				"compactor": {"compaction": {"block_retention": "48h"}},
+				"overrides": {"defaults": {"ingestion": {"rate_limit_bytes": 1000}}},
+				"distributor": {"receivers": {"otlp": {"protocols": {"grpc": {"endpoint": "0.0.0.0:4317"}}}}}
+			}`,
+			warnings: []string{
+				"spec.extraConfig.tempo.distributor.receivers overrides configuration managed by the operator (receivers)",
+			},
+		},
+		{
+			name:  "internal server inherits the server keys",
+			input: `{"internal_server": {"enable": true, "http_server_read_timeout": "1m"}}`,
+			warnings: []string{
+				"spec.extraConfig.tempo.internal_server overrides configuration managed by the operator (TLS)",
+			},
+		},
+		{
+			name:  "unknown top-level key",
+			input: `{"querrier": {"max_concurrent_queries": 10}}`,
+			warnings: []string{
+				`spec.extraConfig.tempo.querrier: unknown Tempo configuration key, did you mean "querier"?`,
+			},
+		},
+		{
+			name:  "unknown nested key",
+			input: `{"query_frontend": {"search": {"foo": 1}}}`,
+			warnings: []string{
+				"spec.extraConfig.tempo.query_frontend.search.foo: unknown Tempo configuration key",
+			},
+		},
+		{
+			name: "type mismatch",
+			input: `{
+				"server": {"http_listen_port": "3200", "http_server_write_timeout": "10 minutes"},
+				"multitenancy_enabled": "true",
+				"querier": {"max_concurrent_queries": 1.5},
+				"memberlist": {"join_members": "tempo-gossip-ring"},
+				"storage": "s3"
+			}`,
+			errors: field.ErrorList{
+				field.Invalid(path.Child("memberlist", "join_members"), "tempo-gossip-ring", "must be a list"),
+				field.Invalid(path.Child("multitenancy_enabled"), "true", "must be a boolean"),
+				field.Invalid(path.Child("querier", "max_concurrent_queries"), json.Number("1.5"), "must be an integer"),
+				field.Invalid(path.Child("server", "http_listen_port"), "3200", "must be an integer"),
+				field.Invalid(path.Child("server", "http_server_write_timeout"), "10 minutes", "must be a duration (e.g. 30s, 5m, 1h)"),
+				field.Invalid(path.Child("storage"), "s3", "must be an object"),
+			},
+			warnings: []string{
+				"spec.extraConfig.tempo.server.http_listen_port overrides configuration managed by the operator (ports)",
+				"spec.extraConfig.tempo.memberlist.join_members overrides configuration managed by the operator (cluster membership)",
+				"spec.extraConfig.tempo.multitenancy_enabled overrides configuration managed by the operator (multi-tenancy)",
+			},
+		},
+		{
+			name:  "list items",
+			input: `{"memberlist": {"bind_addr": ["0.0.0.0", 1]}}`,
+			errors: field.ErrorList{
+				field.Invalid(path.Child("memberlist", "bind_addr").Index(1), json.Number("1"), "must be a string"),
+			},
+		},
+		{
+			name: "operator managed keys",
+			input: `{
+				"server": {"http_tls_config": {"cert_file": "/tls/cert"}},
+				"storage": {"trace": {"backend": "local", "s3": {"bucket": "tempo"}}}
+			}`,
+			warnings: []string{
+				"spec.extraConfig.tempo.server.http_tls_config overrides configuration managed by the operator (TLS)",
+				"spec.extraConfig.tempo.storage.trace.backend overrides configuration managed by the operator (storage)",
+				"spec.extraConfig.tempo.storage.trace.s3 overrides configuration managed by the operator (storage)",
+			},
+		},
+		{
+			name:  "invalid JSON",
+			input: `{"server": `,
+			errors: field.ErrorList{
+				field.Invalid(path, `{"server": `, "invalid JSON: unexpected EOF"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings, errs := ValidateExtraConfig(apiextensionsv1.JSON{Raw: []byte(tc.input)}, path)
+			require.Equal(t, tc.errors, errs)
+			require.Equal(t, tc.warnings, warnings)
+		})
+	}
+}
+
+func TestTempoConfigSchemaTypes(t *testing.T) {
+	knownTypes := map[string]bool{
+		schemaTypeString:   true,
+		schemaTypeInt:      true,
+		schemaTypeFloat:    true,
+		schemaTypeBool:     true,
+		schemaTypeDuration: true,
+		schemaTypeMap:      true,
+		schemaTypeList:     true,
+		schemaTypeAny:      true,
+	}
+
+	var walk func(schema interface{}, path string)
+	walk = func(schema interface{}, path string) {
+		switch s := schema.(type) {
+		case string:
+			require.True(t, knownTypes[s], "unknown type %q at %s", s, path)
+		case []interface{}:
+			require.Len(t, s, 1, "list schema at %s must have exactly one item", path)
+			walk(s[0], path+"[]")
+		case map[string]interface{}:
+			for key, value := range s {
+				walk(value, path+"."+key)
+			}
+		default:
+			require.Failf(t, "invalid schema", "unexpected schema %v at %s", s, path)
+		}
+	}
+	walk(tempoConfigSchema, "tempo")
+}
+
+func TestGeneratedConfigMatchesSchema(t *testing.T) {
+	// The configuration generated by the operator must always be accepted by the schema.
+	cfg, err := buildConfiguration(manifestutils.Params{
+		Tempo: v1alpha1.TempoStack{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: v1alpha1.TempoStackSpec{
+				Timeout: metav1.Duration{Duration: time.Second * 30},
+				Storage: v1alpha1.ObjectStorageSpec{
+					Secret: v1alpha1.ObjectStorageSecretSpec{
+						Type: v1alpha1.ObjectStorageSecretS3,
+					},
+				},
+				ReplicationFactor: 1,
+				Size:              v1alpha1.SizeMedium,
+				Template: v1alpha1.TempoTemplateSpec{
+					QueryFrontend: v1alpha1.TempoQueryFrontendSpec{
+						MCPServer: v1alpha1.MCPServerSpec{
+							Enabled: true,
+						},
+					},
+					MetricsGenerator: v1alpha1.TempoMetricsGeneratorSpec{
+						Enabled:         true,
+						RemoteWriteURLs: []string{"http://prometheus:9090/api/v1/write"},
+					},
+				},
+			},
+		},
+		StorageParams: manifestutils.StorageParams{
+			CredentialMode: v1alpha1.CredentialModeStatic,
+			S3: &manifestutils.S3{
+				Endpoint: "minio:9000",
+				Bucket:   "tempo",
+			},
+		},
+		CtrlConfig: configv1alpha1.ProjectConfig{
+			Gates: configv1alpha1.FeatureGates{
+				HTTPEncryption: true,
+				GRPCEncryption: true,
+			},
+		},
+		TLSProfile: tlsprofile.TLSProfileOptions{
+			MinTLSVersion: string(openshiftconfigv1.VersionTLS13),
+		},
+	})
+	require.NoError(t, err)
+
+	rendered := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal(cfg, &rendered))
+	raw, err := json.Marshal(rendered)
+	require.NoError(t, err)
+
+	warnings, errs := ValidateExtraConfig(apiextensionsv1.JSON{Raw: raw}, field.NewPath("tempo"))
+	require.Empty(t, errs)
+	for _, warning := range warnings {
+		require.NotContains(t, warning, "unknown Tempo configuration key")
+	}
+}
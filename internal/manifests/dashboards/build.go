@@ -0,0 +1,53 @@
+package dashboards
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"text/template"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+)
+
+// Names of the dashboards shipped with the operator.
+var names = []string{"reads", "writes", "resources", "tenants", "rollout-progress"}
+
+var (
+	//go:embed dashboards/*.json
+	dashboardsFS embed.FS
+
+	dashboardsTmpl = template.Must(template.New("").Delims("[[", "]]").ParseFS(dashboardsFS, "dashboards/*.json"))
+)
+
+// Dashboard is a rendered Grafana dashboard.
+type Dashboard struct {
+	// Name is the name of the dashboard, e.g. reads.
+	Name string
+	// JSON is the JSON model of the dashboard.
+	JSON string
+}
+
+// build renders the dashboards for a Tempo instance.
+func build(opts Options) ([]Dashboard, error) {
+	dashboards := make([]Dashboard, 0, len(names))
+	for _, name := range names {
+		file := name + ".json"
+
+		w := bytes.NewBuffer(nil)
+		err := dashboardsTmpl.ExecuteTemplate(w, file, opts)
+		if err != nil {
+			return nil, kverrors.Wrap(err, "failed to execute template",
+				"template", file,
+			)
+		}
+
+		if !json.Valid(w.Bytes()) {
+			return nil, kverrors.New("rendered dashboard is not valid JSON",
+				"template", file,
+			)
+		}
+
+		dashboards = append(dashboards, Dashboard{Name: name, JSON: w.String()})
+	}
+	return dashboards, nil
+}
@@ -0,0 +1,55 @@
+package dashboards
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild(t *testing.T) {
+	opts := Options{
+		Cluster:   "simplest",
+		Namespace: "observability",
+		Jobs: Jobs{
+			Distributor:   "observability/distributor",
+			Ingester:      "observability/ingester",
+			Querier:       "observability/querier",
+			QueryFrontend: "observability/query-frontend",
+			Compactor:     "observability/compactor",
+		},
+		Workloads: "tempo-simplest-(distributor|ingester)",
+		Pods:      "tempo-simplest-(distributor|ingester)-.+",
+	}
+
+	dashboards, err := build(opts)
+	require.NoError(t, err)
+	require.Len(t, dashboards, len(names))
+
+	for i, dashboard := range dashboards {
+		t.Run(dashboard.Name, func(t *testing.T) {
+			assert.Equal(t, names[i], dashboard.Name)
+			assert.NotContains(t, dashboard.JSON, "[[")
+
+			var model struct {
+				Title  string `json:"title"`
+				Panels []struct {
+					Type    string `json:"type"`
+					Targets []struct {
+						Expr string `json:"expr"`
+					} `json:"targets"`
+				} `json:"panels"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(dashboard.JSON), &model))
+			assert.Contains(t, model.Title, "Tempo / observability / simplest / ")
+
+			// every query is restricted to the namespace of the instance
+			for _, panel := range model.Panels {
+				for _, target := range panel.Targets {
+					assert.Contains(t, target.Expr, `namespace="observability"`)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package dashboards
+
+import (
+	"fmt"
+	"strings"
+
+	grafanav1 "github.com/grafana/grafana-operator/v5/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+	"github.com/grafana/tempo-operator/internal/manifests/naming"
+)
+
+const (
+	// sidecarLabel is the default label of the ConfigMaps discovered by the Grafana dashboard sidecar.
+	sidecarLabel = "grafana_dashboard"
+	// folderAnnotation is the annotation of the ConfigMaps which defines the folder of the dashboard.
+	folderAnnotation = "grafana_folder"
+)
+
+// BuildDashboards creates the Grafana dashboards of a TempoStack.
+func BuildDashboards(params manifestutils.Params) ([]client.Object, error) {
+	tempo := params.Tempo
+	spec := *tempo.Spec.Observability.Grafana.Dashboards
+	if spec.InstanceSelector == nil {
+		spec.InstanceSelector = &tempo.Spec.Observability.Grafana.InstanceSelector
+	}
+
+	components := []string{
+		manifestutils.DistributorComponentName,
+		manifestutils.IngesterComponentName,
+		manifestutils.QuerierComponentName,
+		manifestutils.QueryFrontendComponentName,
+		manifestutils.CompactorComponentName,
+		manifestutils.MetricsGeneratorComponentName,
+		manifestutils.GatewayComponentName,
+	}
+	workloads := fmt.Sprintf("%s-(%s)", naming.Name("", tempo.Name), strings.Join(components, "|"))
+	job := func(component string) string {
+		return fmt.Sprintf("%s/%s", tempo.Namespace, component)
+	}
+
+	opts := Options{
+		Cluster:   tempo.Name,
+		Namespace: tempo.Namespace,
+		Jobs: Jobs{
+			Distributor:   job(manifestutils.DistributorComponentName),
+			Ingester:      job(manifestutils.IngesterComponentName),
+			Querier:       job(manifestutils.QuerierComponentName),
+			QueryFrontend: job(manifestutils.QueryFrontendComponentName),
+			Compactor:     job(manifestutils.CompactorComponentName),
+		},
+		Workloads: workloads,
+		Pods:      workloads + "-.+",
+	}
+	return NewDashboards(opts, manifestutils.CommonLabels(tempo.Name), spec, params.CtrlConfig.Gates.GrafanaOperator)
+}
+
+// NewDashboards creates the Grafana dashboards of a Tempo instance.
+// The dashboards are GrafanaDashboard objects if the Grafana Operator is available,
+// otherwise ConfigMaps which are discovered by the Grafana dashboard sidecar.
+func NewDashboards(opts Options, labels k8slabels.Set, spec v1alpha1.GrafanaDashboardsSpec, grafanaOperator bool) ([]client.Object, error) {
+	dashboards, err := build(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]client.Object, 0, len(dashboards))
+	for _, dashboard := range dashboards {
+		name := naming.DashboardName(dashboard.Name, opts.Cluster)
+		if grafanaOperator {
+			objects = append(objects, newGrafanaDashboard(opts.Namespace, name, k8slabels.Merge(spec.ExtraLabels, labels), spec, dashboard))
+		} else {
+			configMapLabels := k8slabels.Merge(k8slabels.Merge(map[string]string{sidecarLabel: "1"}, spec.ExtraLabels), labels)
+			objects = append(objects, newConfigMap(opts.Namespace, name, configMapLabels, spec, dashboard))
+		}
+	}
+	return objects, nil
+}
+
+func newGrafanaDashboard(namespace, name string, labels k8slabels.Set, spec v1alpha1.GrafanaDashboardsSpec, dashboard Dashboard) *grafanav1.GrafanaDashboard {
+	return &grafanav1.GrafanaDashboard{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: grafanav1.SchemeGroupVersion.String(),
+			Kind:       "GrafanaDashboard",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels:    labels,
+		},
+		Spec: grafanav1.GrafanaDashboardSpec{
+			GrafanaCommonSpec: grafanav1.GrafanaCommonSpec{
+				// InstanceSelector is a required field in the spec
+				InstanceSelector: ptr.To(ptr.Deref(spec.InstanceSelector, metav1.LabelSelector{})),
+
+				// Allow using this dashboard from Grafana instances in other namespaces
+				AllowCrossNamespaceImport: true,
+			},
+			GrafanaContentSpec: grafanav1.GrafanaContentSpec{
+				JSON: dashboard.JSON,
+			},
+			FolderTitle: spec.Folder,
+		},
+	}
+}
+
+func newConfigMap(namespace, name string, labels k8slabels.Set, spec v1alpha1.GrafanaDashboardsSpec, dashboard Dashboard) *corev1.ConfigMap {
+	var annotations map[string]string
+	if spec.Folder != "" {
+		annotations = map[string]string{folderAnnotation: spec.Folder}
+	}
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Data: map[string]string{
+			// The sidecar stores the dashboards of all namespaces in one directory,
+			// therefore the file name must be unique across namespaces.
+			fmt.Sprintf("%s-%s.json", namespace, name): dashboard.JSON,
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package dashboards
+
+import (
+	"testing"
+
+	grafanav1 "github.com/grafana/grafana-operator/v5/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+func TestBuildDashboards(t *testing.T) {
+	tempo := v1alpha1.TempoStack{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "simplest",
+			Namespace: "observability",
+		},
+		Spec: v1alpha1.TempoStackSpec{
+			Observability: v1alpha1.ObservabilitySpec{
+				Grafana: v1alpha1.GrafanaConfigSpec{
+					InstanceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"dashboards": "grafana"}},
+					Dashboards: &v1alpha1.GrafanaDashboardsSpec{
+						Enabled:     true,
+						Folder:      "Tempo",
+						ExtraLabels: map[string]string{"team": "tracing"},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("GrafanaDashboard objects", func(t *testing.T) {
+		objects, err := BuildDashboards(manifestutils.Params{
+			Tempo: tempo,
+			CtrlConfig: configv1alpha1.ProjectConfig{
+				Gates: configv1alpha1.FeatureGates{GrafanaOperator: true},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, objects, len(names))
+
+		dashboard, ok := objects[0].(*grafanav1.GrafanaDashboard)
+		require.True(t, ok)
+		assert.Equal(t, "tempo-simplest-dashboard-reads", dashboard.Name)
+		assert.Equal(t, "observability", dashboard.Namespace)
+		assert.Equal(t, "tracing", dashboard.Labels["team"])
+		assert.Equal(t, "tempo-operator", dashboard.Labels["app.kubernetes.io/managed-by"])
+		// falls back to the instance selector of the data source
+		assert.Equal(t, &metav1.LabelSelector{MatchLabels: map[string]string{"dashboards": "grafana"}}, dashboard.Spec.InstanceSelector)
+		assert.True(t, dashboard.Spec.AllowCrossNamespaceImport)
+		assert.Equal(t, "Tempo", dashboard.Spec.FolderTitle)
+		assert.Contains(t, dashboard.Spec.JSON, `job=\"observability/query-frontend\"`)
+	})
+
+	t.Run("ConfigMaps", func(t *testing.T) {
+		objects, err := BuildDashboards(manifestutils.Params{Tempo: tempo})
+		require.NoError(t, err)
+		require.Len(t, objects, len(names))
+
+		configMap, ok := objects[1].(*corev1.ConfigMap)
+		require.True(t, ok)
+		assert.Equal(t, "tempo-simplest-dashboard-writes", configMap.Name)
+		assert.Equal(t, "observability", configMap.Namespace)
+		assert.Equal(t, "1", configMap.Labels["grafana_dashboard"])
+		assert.Equal(t, "tracing", configMap.Labels["team"])
+		assert.Equal(t, map[string]string{"grafana_folder": "Tempo"}, configMap.Annotations)
+		require.Contains(t, configMap.Data, "observability-tempo-simplest-dashboard-writes.json")
+		assert.Contains(t, configMap.Data["observability-tempo-simplest-dashboard-writes.json"], `job=\"observability/distributor\"`)
+	})
+}
+
+func TestNewDashboardsSidecarLabel(t *testing.T) {
+	objects, err := NewDashboards(Options{Cluster: "simplest", Namespace: "observability"}, map[string]string{"app.kubernetes.io/instance": "simplest"},
+		v1alpha1.GrafanaDashboardsSpec{Enabled: true, ExtraLabels: map[string]string{"grafana_dashboard": "tempo"}}, false)
+	require.NoError(t, err)
+
+	for _, obj := range objects {
+		assert.Equal(t, map[string]string{
+			"app.kubernetes.io/instance": "simplest",
+			"grafana_dashboard":          "tempo",
+		}, obj.GetLabels())
+		assert.Empty(t, obj.GetAnnotations())
+	}
+}
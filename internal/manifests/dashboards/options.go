@@ -0,0 +1,24 @@
+package dashboards
+
+// Options is used to configure the Grafana dashboards.
+type Options struct {
+	// Cluster is the value of the cluster label of the Tempo metrics, i.e. the name of the instance.
+	Cluster string
+	// Namespace is the namespace of the instance.
+	Namespace string
+	// Jobs are the values of the job label of the Tempo metrics per component.
+	Jobs Jobs
+	// Workloads is a regular expression matching the names of the Deployments and StatefulSets of the instance.
+	Workloads string
+	// Pods is a regular expression matching the names of the pods of the instance.
+	Pods string
+}
+
+// Jobs defines the values of the job label of the Tempo metrics per component.
+type Jobs struct {
+	Distributor   string
+	Ingester      string
+	Querier       string
+	QueryFrontend string
+	Compactor     string
+}
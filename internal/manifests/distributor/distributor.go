@@ -23,7 +23,7 @@ const openshiftServiceTLSAnnotation = "service.beta.openshift.io/serving-cert-se
 func BuildDistributor(params manifestutils.Params) ([]client.Object, error) {
 	dep := deployment(params)
 	var err error
-	dep.Spec.Template, err = manifestutils.PatchTracingEnvConfiguration(params.Tempo, dep.Spec.Template)
+	dep.Spec.Template, err = manifestutils.PatchTracingEnvConfiguration(params, dep.Spec.Template)
 
 	if err := memberlist.ConfigureHashRingEnv(&dep.Spec.Template.Spec, params.Tempo); err != nil {
 		return nil, err
@@ -50,8 +50,16 @@ func BuildDistributor(params manifestutils.Params) ([]client.Object, error) {
 		}
 	}
 
+	// The distributor reads the user-configurable overrides from the object storage.
+	if tempo.Spec.UserConfigurableOverridesEnabled() {
+		if err := manifestutils.ConfigureStorage(params.StorageParams, tempo, &dep.Spec.Template.Spec, "tempo"); err != nil {
+			return nil, err
+		}
+	}
+
 	manifestutils.SetGoMemLimit("tempo", &dep.Spec.Template.Spec)
 	manifestutils.PatchEnvVars(&dep.Spec.Template.Spec, "tempo", tempo.Spec.Env, tempo.Spec.EnvFrom)
+	manifestutils.PatchExtraArgs(&dep.Spec.Template.Spec, "tempo", tempo.Spec.Template.Distributor.ExtraArgs)
 
 	distributorService := service(tempo)
 	objects := []client.Object{dep, distributorService}
@@ -166,7 +174,7 @@ func configureReceiversTLS(dep *v1.Deployment, caSecretName, certSecretName stri
 func deployment(params manifestutils.Params) *v1.Deployment {
 	tempo := params.Tempo
 	labels := manifestutils.ComponentLabels(manifestutils.DistributorComponentName, tempo.Name)
-	annotations := manifestutils.CommonAnnotations(params)
+	annotations := manifestutils.ComponentAnnotations(params, manifestutils.DistributorComponentName)
 	cfg := tempo.Spec.Template.Distributor
 	image := tempo.Spec.Images.Tempo
 	if image == "" {
@@ -258,7 +266,7 @@ func deployment(params manifestutils.Params) *v1.Deployment {
 							Env:   proxy.ReadProxyVarsFromEnv(),
 							Args: []string{
 								"-target=distributor",
-								"-config.file=/conf/tempo.yaml",
+								"-config.file=" + manifestutils.TempoConfigFile(params, manifestutils.DistributorComponentName),
 								"-log.level=info",
 								"-config.expand-env=true",
 							},
@@ -270,6 +278,7 @@ func deployment(params manifestutils.Params) *v1.Deployment {
 									MountPath: "/conf",
 									ReadOnly:  true,
 								},
+								manifestutils.TenantOverridesVolumeMount(),
 								{
 									Name:      manifestutils.TmpStorageVolumeName,
 									MountPath: manifestutils.TmpTempoStoragePath,
@@ -290,6 +299,7 @@ func deployment(params manifestutils.Params) *v1.Deployment {
 								},
 							},
 						},
+						manifestutils.TenantOverridesVolume(tempo.Name),
 						{
 							Name: manifestutils.TmpStorageVolumeName,
 							VolumeSource: corev1.VolumeSource{
@@ -158,6 +158,16 @@ func TestBuildDistributor(t *testing.T) {
 						},
 					},
 				},
+				{
+					Name: manifestutils.TenantOverridesVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "tempo-test-overrides",
+							},
+						},
+					},
+				},
 				{
 					Name: manifestutils.TmpStorageVolumeName,
 					VolumeSource: corev1.VolumeSource{
@@ -171,6 +181,11 @@ func TestBuildDistributor(t *testing.T) {
 					MountPath: "/conf",
 					ReadOnly:  true,
 				},
+				{
+					Name:      manifestutils.TenantOverridesVolumeName,
+					MountPath: manifestutils.TenantOverridesDir,
+					ReadOnly:  true,
+				},
 				{
 					Name:      manifestutils.TmpStorageVolumeName,
 					MountPath: manifestutils.TmpTempoStoragePath,
@@ -310,6 +325,16 @@ func TestBuildDistributor(t *testing.T) {
 						},
 					},
 				},
+				{
+					Name: manifestutils.TenantOverridesVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "tempo-test-overrides",
+							},
+						},
+					},
+				},
 				{
 					Name: manifestutils.TmpStorageVolumeName,
 					VolumeSource: corev1.VolumeSource{
@@ -341,6 +366,11 @@ func TestBuildDistributor(t *testing.T) {
 					MountPath: "/conf",
 					ReadOnly:  true,
 				},
+				{
+					Name:      manifestutils.TenantOverridesVolumeName,
+					MountPath: manifestutils.TenantOverridesDir,
+					ReadOnly:  true,
+				},
 				{
 					Name:      manifestutils.TmpStorageVolumeName,
 					MountPath: manifestutils.TmpTempoStoragePath,
@@ -491,6 +521,16 @@ func TestBuildDistributor(t *testing.T) {
 						},
 					},
 				},
+				{
+					Name: manifestutils.TenantOverridesVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "tempo-test-overrides",
+							},
+						},
+					},
+				},
 				{
 					Name: manifestutils.TmpStorageVolumeName,
 					VolumeSource: corev1.VolumeSource{
@@ -522,6 +562,11 @@ func TestBuildDistributor(t *testing.T) {
 					MountPath: "/conf",
 					ReadOnly:  true,
 				},
+				{
+					Name:      manifestutils.TenantOverridesVolumeName,
+					MountPath: manifestutils.TenantOverridesDir,
+					ReadOnly:  true,
+				},
 				{
 					Name:      manifestutils.TmpStorageVolumeName,
 					MountPath: manifestutils.TmpTempoStoragePath,
@@ -674,6 +719,16 @@ func TestBuildDistributor(t *testing.T) {
 						},
 					},
 				},
+				{
+					Name: manifestutils.TenantOverridesVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "tempo-test-overrides",
+							},
+						},
+					},
+				},
 				{
 					Name: manifestutils.TmpStorageVolumeName,
 					VolumeSource: corev1.VolumeSource{
@@ -705,6 +760,11 @@ func TestBuildDistributor(t *testing.T) {
 					MountPath: "/conf",
 					ReadOnly:  true,
 				},
+				{
+					Name:      manifestutils.TenantOverridesVolumeName,
+					MountPath: manifestutils.TenantOverridesDir,
+					ReadOnly:  true,
+				},
 				{
 					Name:      manifestutils.TmpStorageVolumeName,
 					MountPath: manifestutils.TmpTempoStoragePath,
@@ -794,6 +854,16 @@ func TestBuildDistributor(t *testing.T) {
 						},
 					},
 				},
+				{
+					Name: manifestutils.TenantOverridesVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "tempo-test-overrides",
+							},
+						},
+					},
+				},
 				{
 					Name: manifestutils.TmpStorageVolumeName,
 					VolumeSource: corev1.VolumeSource{
@@ -807,6 +877,11 @@ func TestBuildDistributor(t *testing.T) {
 					MountPath: "/conf",
 					ReadOnly:  true,
 				},
+				{
+					Name:      manifestutils.TenantOverridesVolumeName,
+					MountPath: manifestutils.TenantOverridesDir,
+					ReadOnly:  true,
+				},
 				{
 					Name:      manifestutils.TmpStorageVolumeName,
 					MountPath: manifestutils.TmpTempoStoragePath,
@@ -941,6 +1016,16 @@ func TestBuildDistributor(t *testing.T) {
 						},
 					},
 				},
+				{
+					Name: manifestutils.TenantOverridesVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "tempo-test-overrides",
+							},
+						},
+					},
+				},
 				{
 					Name: manifestutils.TmpStorageVolumeName,
 					VolumeSource: corev1.VolumeSource{
@@ -954,6 +1039,11 @@ func TestBuildDistributor(t *testing.T) {
 					MountPath: "/conf",
 					ReadOnly:  true,
 				},
+				{
+					Name:      manifestutils.TenantOverridesVolumeName,
+					MountPath: manifestutils.TenantOverridesDir,
+					ReadOnly:  true,
+				},
 				{
 					Name:      manifestutils.TmpStorageVolumeName,
 					MountPath: manifestutils.TmpTempoStoragePath,
@@ -1182,3 +1272,60 @@ func TestOverrideResources(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, dep.Spec.Template.Spec.Containers[0].Resources, overrideResources)
 }
+
+func TestUserConfigurableOverridesStorage(t *testing.T) {
+	tests := []struct {
+		name     string
+		uco      *v1alpha1.UserConfigurableOverridesSpec
+		expected []string
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name: "enabled",
+			uco:  &v1alpha1.UserConfigurableOverridesSpec{Enabled: true},
+			expected: []string{
+				"--storage.trace.s3.secret_key=$(S3_SECRET_KEY)",
+				"--storage.trace.s3.access_key=$(S3_ACCESS_KEY)",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			objects, err := BuildDistributor(manifestutils.Params{
+				StorageParams: manifestutils.StorageParams{
+					CredentialMode: v1alpha1.CredentialModeStatic,
+					S3:             &manifestutils.S3{},
+				},
+				Tempo: v1alpha1.TempoStack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test",
+						Namespace: "project1",
+					},
+					Spec: v1alpha1.TempoStackSpec{
+						Storage: v1alpha1.ObjectStorageSpec{
+							Secret: v1alpha1.ObjectStorageSecretSpec{
+								Name: "storage-secret",
+								Type: v1alpha1.ObjectStorageSecretS3,
+							},
+						},
+						UserConfigurableOverrides: tc.uco,
+					},
+				},
+			})
+			require.NoError(t, err)
+			dep, ok := objects[0].(*v1.Deployment)
+			require.True(t, ok)
+
+			args := dep.Spec.Template.Spec.Containers[0].Args
+			for _, arg := range tc.expected {
+				assert.Contains(t, args, arg)
+			}
+			if tc.expected == nil {
+				assert.NotContains(t, args, "--storage.trace.s3.access_key=$(S3_ACCESS_KEY)")
+			}
+		})
+	}
+}
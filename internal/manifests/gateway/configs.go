@@ -76,6 +76,12 @@ func NewConfigOptions(
 			RedirectURL:           fmt.Sprintf("https://%s/openshift/%s/callback", routeHost, tenantAuth.TenantName),
 		}
 
+		if tenantAuth.MTLS != nil {
+			auth.MTLS = &mtls{
+				CAPath: mtlsCAPath(tenantAuth.TenantName, *tenantAuth.MTLS),
+			}
+		}
+
 		oidcTenantSecret := getOIDCSecret(tenantAuth.TenantName, oidcSecrets)
 		if oidcTenantSecret != nil {
 			auth.OIDCSecret = oidcSecret{
@@ -88,15 +94,21 @@ func NewConfigOptions(
 		auths = append(auths, auth)
 	}
 
+	opaURL := opaPolicyURL(opaPackage)
+	if UsesOPAPolicies(&tenantsSpec) {
+		opaURL = opaPolicyURL(tenantsSpec.Authorization.OPA.Package)
+	}
+
 	return options{
 		Namespace:      namespace,
 		Name:           name,
 		ServiceAccount: saName,
-		OPAUrl:         fmt.Sprintf("http://localhost:%d/v1/data/%s/allow", gatewayOPAHTTPPort, opaPackage),
+		OPAUrl:         opaURL,
+		OPAPolicies:    UsesOPAPolicies(&tenantsSpec),
 		Tenants: &tenants{
 			Mode:           tenantsSpec.Mode,
 			Authentication: auths,
-			Authorization:  tenantsSpec.Authorization,
+			Authorization:  mtlsAuthorization(tenantsSpec),
 		},
 	}
 }
@@ -127,6 +139,10 @@ type options struct {
 
 	ServiceAccount string
 	OPAUrl         string
+	// OPAPolicies is true if the requests of all tenants are authorized by custom OPA policies.
+	OPAPolicies bool
+	// Kubernetes is the authentication of the tenants in kubernetes mode.
+	Kubernetes *kubernetesAuthentication
 }
 
 type tenants struct {
@@ -145,6 +161,13 @@ type authentication struct {
 	OpenShiftCookieSecret string
 	OIDC                  *v1alpha1.OIDCSpec
 	OIDCSecret            oidcSecret
+	MTLS                  *mtls
+	RateLimits            []rateLimit
+}
+
+// mtls is the client certificate authentication of a tenant.
+type mtls struct {
+	CAPath string
 }
 
 // secret for clientID, clientSecret and issuerCAPath for tenant's authentication.
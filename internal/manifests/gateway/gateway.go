@@ -7,6 +7,7 @@ import (
 	"maps"
 	"net/url"
 	"path"
+	"strings"
 
 	"github.com/imdario/mergo"
 	"github.com/operator-framework/operator-lib/proxy"
@@ -33,16 +34,21 @@ func BuildGateway(params manifestutils.Params) ([]client.Object, error) {
 	tempo := params.Tempo
 	labels := manifestutils.ComponentLabels(manifestutils.GatewayComponentName, tempo.Name)
 	gatewayObjectName := naming.Name(manifestutils.GatewayComponentName, tempo.Name)
+	routeHost := naming.RouteFqdn(tempo.Namespace, tempo.Name, manifestutils.GatewayComponentName, params.CtrlConfig.Gates.OpenShift.BaseDomain)
 	cfgOpts := NewConfigOptions(
 		tempo.Namespace,
 		tempo.Name,
 		gatewayObjectName,
-		naming.RouteFqdn(tempo.Namespace, tempo.Name, manifestutils.GatewayComponentName, params.CtrlConfig.Gates.OpenShift.BaseDomain),
+		routeHost,
 		"tempostack",
 		*tempo.Spec.Tenants,
 		params.GatewayTenantSecret,
 		params.GatewayTenantsData,
 	)
+	cfgOpts = withRateLimits(cfgOpts, tempo.Spec.Template.Gateway.RateLimits)
+	if tempo.Spec.Tenants.Mode == v1alpha1.ModeKubernetes {
+		cfgOpts.Kubernetes = newKubernetesAuthentication(tempo.Spec.Tenants.Kubernetes)
+	}
 
 	rbacConfigMap, rbacCfgHash, err := NewRBACConfigMap(cfgOpts, tempo.Namespace, gatewayObjectName, labels)
 	if err != nil {
@@ -59,6 +65,7 @@ func BuildGateway(params manifestutils.Params) ([]client.Object, error) {
 		tenantsSecret,
 		service(params.Tempo, params.CtrlConfig.Gates.OpenShift.ServingCertsService),
 	}
+	objs = append(objs, BuildRateLimitService(params)...)
 
 	dep := deployment(params, rbacCfgHash, tenantsCfgHash)
 
@@ -114,6 +121,60 @@ func BuildGateway(params manifestutils.Params) ([]client.Object, error) {
 		}
 	}
 
+	if params.Tempo.Spec.Tenants.Mode == v1alpha1.ModeKubernetes {
+		dep = patchOCPServiceAccount(params.Tempo, dep)
+		dep, err = patchOCPOPAContainer(params, dep)
+		if err != nil {
+			return nil, err
+		}
+
+		// The SelfSubjectAccessReviews are performed with the token of the user,
+		// therefore the ServiceAccount of the gateway does not need any additional permissions.
+		objs = append(objs, serviceAccount(params.Tempo))
+		for _, clusterRole := range NewKubernetesTracesClusterRoles(
+			// ClusterRole is a cluster scoped resource, therefore we need to add the namespace to the name
+			fmt.Sprintf("%s-%s", gatewayObjectName, tempo.Namespace),
+			manifestutils.ClusterScopedComponentLabels(tempo.ObjectMeta, manifestutils.GatewayComponentName),
+			*tempo.Spec.Tenants,
+		) {
+			objs = append(objs, clusterRole)
+		}
+	}
+
+	if tlsSpec := params.Tempo.Spec.Template.Gateway.TLS; tlsSpec.Enabled {
+		servingCerts := params.CtrlConfig.Gates.OpenShift.ServingCertsService
+		switch {
+		case servingCerts && params.Tempo.Spec.Tenants.Mode == v1alpha1.ModeOpenShift:
+			// NOTE: the serving certificates are already configured for the OpenShift mode.
+		case servingCerts && tlsSpec.Cert == "":
+			objs = append(objs, manifestutils.NewConfigMapCABundle(
+				tempo.Namespace,
+				naming.Name("gateway-cabundle", tempo.Name),
+				manifestutils.ComponentLabels(manifestutils.GatewayComponentName, tempo.Name),
+			))
+
+			dep, err = patchOCPServingCerts(params.Tempo, dep)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			dep, err = patchGatewayTLS(params.Tempo, dep)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	dep, err = patchMTLS(params.Tempo, dep)
+	if err != nil {
+		return nil, err
+	}
+
+	dep, err = patchOPAPolicies(params, dep)
+	if err != nil {
+		return nil, err
+	}
+
 	if params.Tempo.Spec.Template.Gateway.Ingress.Type == v1alpha1.IngressTypeIngress {
 		objs = append(objs, ingress(params.Tempo))
 	} else if params.Tempo.Spec.Template.Gateway.Ingress.Type == v1alpha1.IngressTypeRoute {
@@ -138,6 +199,8 @@ func BuildGateway(params manifestutils.Params) ([]client.Object, error) {
 		return nil, err
 	}
 
+	manifestutils.PatchExtraArgs(&dep.Spec.Template.Spec, containerNameTempoGateway, tempo.Spec.Template.Gateway.ExtraArgs)
+
 	objs = append(objs, dep)
 	objs = append(objs, manifestutils.NewPodDisruptionBudget(params.Tempo, manifestutils.GatewayComponentName))
 	return objs, nil
@@ -226,11 +289,22 @@ func deployment(params manifestutils.Params, rbacCfgHash string, tenantsCfgHash
 			fmt.Sprintf("--traces.tls.ca-file=%s", path.Join(manifestutils.TempoInternalTLSCADir, manifestutils.TLSCAFilename)),
 			"--traces.tls.watch-certs=true",
 		}
-		if params.TLSProfile.Ciphers != nil {
-			tlsArgs = append(tlsArgs, fmt.Sprintf("--tls.cipher-suites=%s", params.TLSProfile.CipherSuites()))
+	}
+	if params.CtrlConfig.Gates.HTTPEncryption || cfg.TLS.Enabled {
+		cipherSuites := params.TLSProfile.CipherSuites()
+		if len(cfg.TLS.CipherSuites) > 0 {
+			cipherSuites = strings.Join(cfg.TLS.CipherSuites, ",")
+		}
+		minVersion := params.TLSProfile.MinTLSVersion
+		if cfg.TLS.MinVersion != "" {
+			minVersion = cfg.TLS.MinVersion
+		}
+
+		if cipherSuites != "" {
+			tlsArgs = append(tlsArgs, fmt.Sprintf("--tls.cipher-suites=%s", cipherSuites))
 		}
-		if params.TLSProfile.MinTLSVersion != "" {
-			tlsArgs = append(tlsArgs, fmt.Sprintf("--tls.min-version=%s", params.TLSProfile.MinTLSVersion))
+		if minVersion != "" {
+			tlsArgs = append(tlsArgs, fmt.Sprintf("--tls.min-version=%s", minVersion))
 		}
 	}
 
@@ -278,7 +352,7 @@ func deployment(params manifestutils.Params, rbacCfgHash string, tenantsCfgHash
 								fmt.Sprintf("--rbac.config=%s", path.Join(tempoGatewayMountDir, "cm", manifestutils.GatewayRBACFileName)),
 								fmt.Sprintf("--tenants.config=%s", path.Join(tempoGatewayMountDir, "secret", manifestutils.GatewayTenantFileName)),
 								"--log.level=info",
-							}, tlsArgs...),
+							}, append(tlsArgs, rateLimitArgs(tempo)...)...),
 							Ports: []corev1.ContainerPort{
 								{
 									Name:          manifestutils.GatewayGrpcPortName,
@@ -527,12 +601,23 @@ func ingress(tempo v1alpha1.TempoStack) *networkingv1.Ingress {
 	ingressName := naming.Name(manifestutils.GatewayComponentName, tempo.Name)
 	labels := manifestutils.ComponentLabels(manifestutils.GatewayComponentName, tempo.Name)
 
+	annotations := tempo.Spec.Template.Gateway.Ingress.Annotations
+	if UsesMTLS(tempo.Spec.Tenants) {
+		annotations = maps.Clone(annotations)
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		if _, ok := annotations[ingressSSLPassthroughAnnotation]; !ok {
+			annotations[ingressSSLPassthroughAnnotation] = "true"
+		}
+	}
+
 	ingress := &networkingv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        ingressName,
 			Namespace:   tempo.Namespace,
 			Labels:      labels,
-			Annotations: tempo.Spec.Template.Gateway.Ingress.Annotations,
+			Annotations: annotations,
 		},
 		Spec: networkingv1.IngressSpec{
 			IngressClassName: tempo.Spec.Template.Gateway.Ingress.IngressClassName,
@@ -0,0 +1,91 @@
+package gateway
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+const (
+	// defaultKubernetesIssuerURL is the default service account issuer of the cluster.
+	defaultKubernetesIssuerURL = "https://kubernetes.default.svc.cluster.local"
+	// kubernetesServiceHost is the host name prefix of the kubernetes service of the Kubernetes API server.
+	kubernetesServiceHost = "kubernetes.default.svc"
+	// serviceAccountCAPath is the CA of the Kubernetes API server in the service account volume.
+	serviceAccountCAPath = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+
+	// kubernetesTracesAPIGroup is the API group of the SelfSubjectAccessReviews of the kubernetes mode.
+	kubernetesTracesAPIGroup = "tempo.grafana.com"
+	// kubernetesTracesResourceName is the resource name of the SelfSubjectAccessReviews of the kubernetes mode.
+	kubernetesTracesResourceName = "traces"
+)
+
+// kubernetesAuthentication is the authentication of the tenants in kubernetes mode.
+type kubernetesAuthentication struct {
+	IssuerURL string
+	Audience  string
+	// IssuerCAPath is the CA of the issuer, if the issuer is the Kubernetes API server of the cluster.
+	IssuerCAPath string
+}
+
+// newKubernetesAuthentication returns the authentication of the tenants in kubernetes mode.
+// The OIDC authenticator of the gateway verifies the service account tokens with the service account issuer of the cluster,
+// and the opa-openshift sidecar authorizes the requests with a SelfSubjectAccessReview using the token of the user.
+func newKubernetesAuthentication(spec *v1alpha1.KubernetesTenantsSpec) *kubernetesAuthentication {
+	auth := &kubernetesAuthentication{
+		IssuerURL: defaultKubernetesIssuerURL,
+	}
+	if spec != nil && spec.IssuerURL != "" {
+		auth.IssuerURL = spec.IssuerURL
+	}
+	auth.Audience = auth.IssuerURL
+	if spec != nil && spec.Audience != "" {
+		auth.Audience = spec.Audience
+	}
+
+	// The Kubernetes API server serves the OIDC discovery of the service account issuer
+	// with the certificate of the cluster CA.
+	if u, err := url.Parse(auth.IssuerURL); err == nil && strings.HasPrefix(u.Hostname(), kubernetesServiceHost) {
+		auth.IssuerCAPath = serviceAccountCAPath
+	}
+	return auth
+}
+
+// NewKubernetesTracesClusterRoles creates the ClusterRoles which grant read and write access to the traces
+// of the tenants in kubernetes mode. The OPA sidecar performs a SelfSubjectAccessReview for the verb get (read)
+// or create (write) on the resource <tenant name> with the resource name traces in the tempo.grafana.com API group.
+// The ClusterRoles are not bound by the operator, the cluster administrator binds them to users, groups and ServiceAccounts.
+func NewKubernetesTracesClusterRoles(name string, labels labels.Set, tenants v1alpha1.TenantsSpec) []*rbacv1.ClusterRole {
+	var tenantNames []string
+	for _, auth := range tenants.Authentication {
+		tenantNames = append(tenantNames, auth.TenantName)
+	}
+
+	clusterRole := func(suffix string, verb string) *rbacv1.ClusterRole {
+		return &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   fmt.Sprintf("%s-%s", name, suffix),
+				Labels: labels,
+			},
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups:     []string{kubernetesTracesAPIGroup},
+					Resources:     tenantNames,
+					ResourceNames: []string{kubernetesTracesResourceName},
+					Verbs:         []string{verb},
+				},
+			},
+		}
+	}
+
+	return []*rbacv1.ClusterRole{
+		clusterRole("traces-reader", "get"),
+		clusterRole("traces-writer", "create"),
+	}
+}
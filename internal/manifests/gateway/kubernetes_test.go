@@ -0,0 +1,155 @@
+package gateway
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+func TestBuildGateway_kubernetes(t *testing.T) {
+	tempo := v1alpha1.TempoStack{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "simplest",
+			Namespace: "observability",
+		},
+		Spec: v1alpha1.TempoStackSpec{
+			Template: v1alpha1.TempoTemplateSpec{
+				Gateway: v1alpha1.TempoGatewaySpec{
+					Enabled: true,
+				},
+			},
+			Tenants: &v1alpha1.TenantsSpec{
+				Mode: v1alpha1.ModeKubernetes,
+				Authentication: []v1alpha1.AuthenticationSpec{
+					{
+						TenantName: "dev",
+						TenantID:   "abcd1",
+					},
+					{
+						TenantName: "prod",
+						TenantID:   "abcd2",
+					},
+				},
+			},
+		},
+	}
+	objects, err := BuildGateway(manifestutils.Params{
+		Tempo: tempo,
+	})
+	require.NoError(t, err)
+
+	obj := getObjectByTypeAndName(objects, "tempo-simplest-gateway", reflect.TypeOf(&appsv1.Deployment{}))
+	require.NotNil(t, obj)
+	dep := obj.(*appsv1.Deployment)
+	assert.Equal(t, "tempo-simplest-gateway", dep.Spec.Template.Spec.ServiceAccountName)
+	// The OPA sidecar authorizes the requests with SelfSubjectAccessReviews.
+	require.Equal(t, 2, len(dep.Spec.Template.Spec.Containers))
+	assert.Equal(t, containerNameTempoGateway, dep.Spec.Template.Spec.Containers[0].Name)
+	assert.Equal(t, "tempo-gateway-opa", dep.Spec.Template.Spec.Containers[1].Name)
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[1].Args, "--opa.ssar")
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[1].Args, "--openshift.mappings=dev=tempo.grafana.com")
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[1].Args, "--openshift.mappings=prod=tempo.grafana.com")
+
+	obj = getObjectByTypeAndName(objects, "tempo-simplest-gateway", reflect.TypeOf(&corev1.ServiceAccount{}))
+	require.NotNil(t, obj)
+	assert.Empty(t, obj.GetAnnotations())
+
+	// The ServiceAccount of the gateway does not need any permissions.
+	assert.Nil(t, getObjectByTypeAndName(objects, "tempo-simplest-gateway-observability", reflect.TypeOf(&rbacv1.ClusterRole{})))
+	assert.Nil(t, getObjectByTypeAndName(objects, "tempo-simplest-gateway-observability", reflect.TypeOf(&rbacv1.ClusterRoleBinding{})))
+
+	obj = getObjectByTypeAndName(objects, "tempo-simplest-gateway-observability-traces-reader", reflect.TypeOf(&rbacv1.ClusterRole{}))
+	require.NotNil(t, obj)
+	assert.Equal(t, []rbacv1.PolicyRule{
+		{
+			APIGroups:     []string{"tempo.grafana.com"},
+			Resources:     []string{"dev", "prod"},
+			ResourceNames: []string{"traces"},
+			Verbs:         []string{"get"},
+		},
+	}, obj.(*rbacv1.ClusterRole).Rules)
+
+	obj = getObjectByTypeAndName(objects, "tempo-simplest-gateway-observability-traces-writer", reflect.TypeOf(&rbacv1.ClusterRole{}))
+	require.NotNil(t, obj)
+	assert.Equal(t, []rbacv1.PolicyRule{
+		{
+			APIGroups:     []string{"tempo.grafana.com"},
+			Resources:     []string{"dev", "prod"},
+			ResourceNames: []string{"traces"},
+			Verbs:         []string{"create"},
+		},
+	}, obj.(*rbacv1.ClusterRole).Rules)
+
+	obj = getObjectByTypeAndName(objects, "tempo-simplest-gateway", reflect.TypeOf(&corev1.Secret{}))
+	require.NotNil(t, obj)
+	assert.Equal(t, `tenants:
+- name: dev
+  id: abcd1
+  oidc:
+    clientID: https://kubernetes.default.svc.cluster.local
+    issuerURL: https://kubernetes.default.svc.cluster.local
+    issuerCAPath: /var/run/secrets/kubernetes.io/serviceaccount/ca.crt
+    usernameClaim: sub
+  opa:
+    url: http://localhost:8082/v1/data/tempostack/allow
+    withAccessToken: true
+- name: prod
+  id: abcd2
+  oidc:
+    clientID: https://kubernetes.default.svc.cluster.local
+    issuerURL: https://kubernetes.default.svc.cluster.local
+    issuerCAPath: /var/run/secrets/kubernetes.io/serviceaccount/ca.crt
+    usernameClaim: sub
+  opa:
+    url: http://localhost:8082/v1/data/tempostack/allow
+    withAccessToken: true`, string(obj.(*corev1.Secret).Data[manifestutils.GatewayTenantFileName]))
+}
+
+func TestNewKubernetesAuthentication(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     *v1alpha1.KubernetesTenantsSpec
+		expected kubernetesAuthentication
+	}{
+		{
+			name: "defaults",
+			expected: kubernetesAuthentication{
+				IssuerURL:    "https://kubernetes.default.svc.cluster.local",
+				Audience:     "https://kubernetes.default.svc.cluster.local",
+				IssuerCAPath: "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt",
+			},
+		},
+		{
+			name: "in-cluster issuer with audience",
+			spec: &v1alpha1.KubernetesTenantsSpec{IssuerURL: "https://kubernetes.default.svc", Audience: "tempo"},
+			expected: kubernetesAuthentication{
+				IssuerURL:    "https://kubernetes.default.svc",
+				Audience:     "tempo",
+				IssuerCAPath: "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt",
+			},
+		},
+		{
+			name: "external issuer",
+			spec: &v1alpha1.KubernetesTenantsSpec{IssuerURL: "https://oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE"},
+			expected: kubernetesAuthentication{
+				IssuerURL: "https://oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE",
+				Audience:  "https://oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, *newKubernetesAuthentication(tc.spec))
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package gateway
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/imdario/mergo"
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+	"github.com/grafana/tempo-operator/internal/manifests/naming"
+)
+
+const (
+	// ingressSSLPassthroughAnnotation enables TLS passthrough in the NGINX ingress controller.
+	// The gateway must terminate TLS itself to verify the client certificates of tenants using mTLS.
+	ingressSSLPassthroughAnnotation = "nginx.ingress.kubernetes.io/ssl-passthrough"
+)
+
+// UsesMTLS returns true if at least one tenant uses mTLS authentication.
+func UsesMTLS(tenants *v1alpha1.TenantsSpec) bool {
+	if tenants == nil {
+		return false
+	}
+	for _, auth := range tenants.Authentication {
+		if auth.MTLS != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// mtlsCAPath returns the path of the CA certificate which verifies the client certificates of a tenant.
+func mtlsCAPath(tenantName string, spec v1alpha1.MTLSSpec) string {
+	caKey := spec.CAKey
+	if caKey == "" {
+		caKey = manifestutils.TLSCAFilename
+	}
+	return path.Join(tempoGatewayMountDir, "tenants-ca", tenantName, caKey)
+}
+
+// clientAuthType returns the client authentication type of the public gateway endpoints.
+// The client certificates are verified by the gateway using the CA of the tenant, therefore they are only requested by the TLS server.
+func clientAuthType(tenants *v1alpha1.TenantsSpec) string {
+	if UsesMTLS(tenants) {
+		return "RequestClientCert"
+	}
+	return "NoClientCert"
+}
+
+// mtlsAuthorization returns the authorization of the tenants, including a role and role binding
+// which allow the subjects of the client certificates of a tenant to write traces to the tenant.
+func mtlsAuthorization(tenants v1alpha1.TenantsSpec) *v1alpha1.AuthorizationSpec {
+	var roles []v1alpha1.RoleSpec
+	var roleBindings []v1alpha1.RoleBindingsSpec
+	for _, auth := range tenants.Authentication {
+		if auth.MTLS == nil || len(auth.MTLS.Subjects) == 0 {
+			continue
+		}
+
+		roleName := fmt.Sprintf("%s-mtls-write", auth.TenantName)
+		roles = append(roles, v1alpha1.RoleSpec{
+			Name:        roleName,
+			Resources:   []string{"traces"},
+			Tenants:     []string{auth.TenantName},
+			Permissions: []v1alpha1.PermissionType{v1alpha1.Write},
+		})
+
+		subjects := make([]v1alpha1.Subject, 0, len(auth.MTLS.Subjects))
+		for _, subject := range auth.MTLS.Subjects {
+			subjects = append(subjects, v1alpha1.Subject{Name: subject, Kind: v1alpha1.User})
+		}
+		roleBindings = append(roleBindings, v1alpha1.RoleBindingsSpec{
+			Name:     fmt.Sprintf("%s-mtls", auth.TenantName),
+			Subjects: subjects,
+			Roles:    []string{roleName},
+		})
+	}
+
+	if len(roles) == 0 {
+		return tenants.Authorization
+	}
+
+	authorization := &v1alpha1.AuthorizationSpec{}
+	if tenants.Authorization != nil {
+		authorization = tenants.Authorization.DeepCopy()
+	}
+	authorization.Roles = append(authorization.Roles, roles...)
+	authorization.RoleBindings = append(authorization.RoleBindings, roleBindings...)
+	return authorization
+}
+
+// patchMTLS mounts the CA certificates of the tenants using mTLS.
+func patchMTLS(tempo v1alpha1.TempoStack, dep *v1.Deployment) (*v1.Deployment, error) {
+	if !UsesMTLS(tempo.Spec.Tenants) {
+		return dep, nil
+	}
+
+	container := corev1.Container{}
+	pod := corev1.PodSpec{}
+	for i, auth := range tempo.Spec.Tenants.Authentication {
+		if auth.MTLS == nil {
+			continue
+		}
+
+		volumeName := fmt.Sprintf("tenants-ca-%d", i)
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      volumeName,
+			ReadOnly:  true,
+			MountPath: path.Dir(mtlsCAPath(auth.TenantName, *auth.MTLS)),
+		})
+		pod.Volumes = append(pod.Volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: auth.MTLS.CA,
+					},
+				},
+			},
+		})
+	}
+
+	for i := range dep.Spec.Template.Spec.Containers {
+		if dep.Spec.Template.Spec.Containers[i].Name != containerNameTempoGateway {
+			continue
+		}
+		if err := mergo.Merge(&dep.Spec.Template.Spec.Containers[i], container, mergo.WithAppendSlice); err != nil {
+			return nil, err
+		}
+	}
+	if err := mergo.Merge(&dep.Spec.Template.Spec, pod, mergo.WithAppendSlice); err != nil {
+		return nil, err
+	}
+	return dep, nil
+}
+
+// patchGatewayTLS configures TLS on the public gateway endpoints using the certificate of spec.template.gateway.tls.
+func patchGatewayTLS(tempo v1alpha1.TempoStack, dep *v1.Deployment) (*v1.Deployment, error) {
+	tlsSpec := tempo.Spec.Template.Gateway.TLS
+	container := corev1.Container{
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "gateway-tls",
+				ReadOnly:  true,
+				MountPath: path.Join(tempoGatewayMountDir, "gateway-tls"),
+			},
+			{
+				Name:      "gateway-tls-ca",
+				ReadOnly:  true,
+				MountPath: path.Join(tempoGatewayMountDir, "gateway-tls-ca"),
+			},
+		},
+		Args: []string{
+			fmt.Sprintf("--tls.server.cert-file=%s", path.Join(tempoGatewayMountDir, "gateway-tls", manifestutils.TLSCertFilename)),
+			fmt.Sprintf("--tls.server.key-file=%s", path.Join(tempoGatewayMountDir, "gateway-tls", manifestutils.TLSKeyFilename)),
+			fmt.Sprintf("--tls.healthchecks.server-ca-file=%s", path.Join(tempoGatewayMountDir, "gateway-tls-ca", manifestutils.TLSCAFilename)),
+			fmt.Sprintf("--tls.healthchecks.server-name=%s", naming.ServiceFqdn(tempo.Namespace, tempo.Name, manifestutils.GatewayComponentName)),
+			fmt.Sprintf("--web.healthchecks.url=https://localhost:%d", manifestutils.GatewayPortHTTPServer),
+			fmt.Sprintf("--tls.client-auth-type=%s", clientAuthType(tempo.Spec.Tenants)),
+		},
+	}
+	for i := range dep.Spec.Template.Spec.Containers {
+		if dep.Spec.Template.Spec.Containers[i].Name != containerNameTempoGateway {
+			continue
+		}
+		if err := mergo.Merge(&dep.Spec.Template.Spec.Containers[i], container, mergo.WithAppendSlice); err != nil {
+			return nil, err
+		}
+	}
+
+	pod := corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{
+				Name: "gateway-tls",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: tlsSpec.Cert,
+					},
+				},
+			},
+			{
+				Name: "gateway-tls-ca",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: tlsSpec.CA,
+						},
+					},
+				},
+			},
+		},
+	}
+	if err := mergo.Merge(&dep.Spec.Template.Spec, pod, mergo.WithAppendSlice); err != nil {
+		return nil, err
+	}
+	return dep, nil
+}
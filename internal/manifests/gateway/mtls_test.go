@@ -0,0 +1,175 @@
+package gateway
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+func TestBuildGateway_mTLS(t *testing.T) {
+	tempo := v1alpha1.TempoStack{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "simplest",
+			Namespace: "observability",
+		},
+		Spec: v1alpha1.TempoStackSpec{
+			Template: v1alpha1.TempoTemplateSpec{
+				Gateway: v1alpha1.TempoGatewaySpec{
+					Enabled: true,
+					Ingress: v1alpha1.IngressSpec{
+						Type: v1alpha1.IngressTypeIngress,
+						Host: "tempo.example.com",
+					},
+					TLS: v1alpha1.TLSSpec{
+						Enabled:    true,
+						Cert:       "gateway-cert",
+						CA:         "gateway-ca",
+						MinVersion: "VersionTLS13",
+					},
+				},
+			},
+			Tenants: &v1alpha1.TenantsSpec{
+				Mode: v1alpha1.ModeStatic,
+				Authentication: []v1alpha1.AuthenticationSpec{
+					{
+						TenantName: "dev",
+						TenantID:   "abcd1",
+						OIDC: &v1alpha1.OIDCSpec{
+							IssuerURL: "https://dex.example.com",
+						},
+					},
+					{
+						TenantName: "pipelines",
+						TenantID:   "abcd2",
+						MTLS: &v1alpha1.MTLSSpec{
+							CA:       "pipelines-ca",
+							CAKey:    "ca.crt",
+							Subjects: []string{"collector.example.com"},
+						},
+					},
+				},
+				Authorization: &v1alpha1.AuthorizationSpec{},
+			},
+		},
+	}
+
+	objects, err := BuildGateway(manifestutils.Params{
+		Tempo: tempo,
+		GatewayTenantSecret: []*manifestutils.GatewayTenantOIDCSecret{
+			{TenantName: "dev", ClientID: "test"},
+		},
+	})
+	require.NoError(t, err)
+
+	obj := getObjectByTypeAndName(objects, "tempo-simplest-gateway", reflect.TypeOf(&appsv1.Deployment{}))
+	require.NotNil(t, obj)
+	dep := obj.(*appsv1.Deployment)
+	container := dep.Spec.Template.Spec.Containers[0]
+	assert.Contains(t, container.Args, "--tls.server.cert-file=/etc/tempo-gateway/gateway-tls/tls.crt")
+	assert.Contains(t, container.Args, "--tls.server.key-file=/etc/tempo-gateway/gateway-tls/tls.key")
+	assert.Contains(t, container.Args, "--tls.healthchecks.server-ca-file=/etc/tempo-gateway/gateway-tls-ca/service-ca.crt")
+	assert.Contains(t, container.Args, "--tls.healthchecks.server-name=tempo-simplest-gateway.observability.svc.cluster.local")
+	assert.Contains(t, container.Args, "--web.healthchecks.url=https://localhost:8080")
+	assert.Contains(t, container.Args, "--tls.client-auth-type=RequestClientCert")
+	assert.Contains(t, container.Args, "--tls.min-version=VersionTLS13")
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{
+		Name:      "tenants-ca-1",
+		ReadOnly:  true,
+		MountPath: "/etc/tempo-gateway/tenants-ca/pipelines",
+	})
+	assert.Contains(t, dep.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: "tenants-ca-1",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "pipelines-ca"},
+			},
+		},
+	})
+	assert.Contains(t, dep.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: "gateway-tls",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: "gateway-cert"},
+		},
+	})
+
+	obj = getObjectByTypeAndName(objects, "tempo-simplest-gateway", reflect.TypeOf(&corev1.Secret{}))
+	require.NotNil(t, obj)
+	assert.Equal(t, `tenants:
+- name: dev
+  id: abcd1
+  oidc:
+    clientID: test
+    issuerURL: https://dex.example.com
+- name: pipelines
+  id: abcd2
+  mTLS:
+    caPath: /etc/tempo-gateway/tenants-ca/pipelines/ca.crt`, string(obj.(*corev1.Secret).Data[manifestutils.GatewayTenantFileName]))
+
+	obj = getObjectByTypeAndName(objects, "tempo-simplest-gateway", reflect.TypeOf(&corev1.ConfigMap{}))
+	require.NotNil(t, obj)
+	assert.Equal(t, `roleBindings:
+- name: pipelines-mtls
+  roles:
+  - pipelines-mtls-write
+
+  subjects:
+  - kind: user
+    name: collector.example.com
+
+roles:
+- name: pipelines-mtls-write
+  permissions:
+  - write
+
+  resources:
+  - traces
+
+  tenants:
+  - pipelines`, obj.(*corev1.ConfigMap).Data[manifestutils.GatewayRBACFileName])
+
+	obj = getObjectByTypeAndName(objects, "tempo-simplest-gateway", reflect.TypeOf(&networkingv1.Ingress{}))
+	require.NotNil(t, obj)
+	assert.Equal(t, map[string]string{"nginx.ingress.kubernetes.io/ssl-passthrough": "true"}, obj.GetAnnotations())
+
+	// the authorization of the TempoStack is not modified
+	assert.Empty(t, tempo.Spec.Tenants.Authorization.Roles)
+}
+
+func TestMTLSAuthorization(t *testing.T) {
+	tenants := v1alpha1.TenantsSpec{
+		Mode: v1alpha1.ModeStatic,
+		Authentication: []v1alpha1.AuthenticationSpec{
+			{TenantName: "dev", TenantID: "dev", MTLS: &v1alpha1.MTLSSpec{CA: "ca"}},
+		},
+		Authorization: &v1alpha1.AuthorizationSpec{
+			Roles: []v1alpha1.RoleSpec{{Name: "read"}},
+		},
+	}
+
+	// without subjects, the authorization is not changed
+	assert.Equal(t, tenants.Authorization, mtlsAuthorization(tenants))
+
+	tenants.Authentication[0].MTLS.Subjects = []string{"a", "b"}
+	assert.Equal(t, &v1alpha1.AuthorizationSpec{
+		Roles: []v1alpha1.RoleSpec{
+			{Name: "read"},
+			{Name: "dev-mtls-write", Resources: []string{"traces"}, Tenants: []string{"dev"}, Permissions: []v1alpha1.PermissionType{v1alpha1.Write}},
+		},
+		RoleBindings: []v1alpha1.RoleBindingsSpec{
+			{
+				Name:     "dev-mtls",
+				Subjects: []v1alpha1.Subject{{Name: "a", Kind: v1alpha1.User}, {Name: "b", Kind: v1alpha1.User}},
+				Roles:    []string{"dev-mtls-write"},
+			},
+		},
+	}, mtlsAuthorization(tenants))
+}
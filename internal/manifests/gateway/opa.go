@@ -0,0 +1,141 @@
+package gateway
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/imdario/mergo"
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+const (
+	opaPoliciesVolumeName = "opa-policies"
+	// opaPoliciesIgnore skips the hidden ..data and timestamped directories of the ConfigMap volume,
+	// otherwise every policy would be loaded multiple times.
+	opaPoliciesIgnore = "..*"
+)
+
+var opaPoliciesMountDir = path.Join(tempoGatewayMountDir, opaPoliciesVolumeName)
+
+// UsesOPAPolicies returns true if the requests of the tenants are authorized by custom OPA policies.
+func UsesOPAPolicies(tenants *v1alpha1.TenantsSpec) bool {
+	return tenants != nil &&
+		tenants.Mode == v1alpha1.ModeStatic &&
+		tenants.Authorization != nil &&
+		tenants.Authorization.OPA != nil
+}
+
+// opaPolicyURL returns the URL of the allow rule of a Rego package served by the OPA sidecar.
+func opaPolicyURL(opaPackage string) string {
+	return fmt.Sprintf("http://localhost:%d/v1/data/%s/allow", gatewayOPAHTTPPort, strings.ReplaceAll(opaPackage, ".", "/"))
+}
+
+// patchOPAPolicies adds an OPA sidecar which evaluates the custom Rego policies of the ConfigMap.
+// The init container verifies that the policies compile, therefore a rollout with invalid policies
+// does not replace the running gateway pods.
+func patchOPAPolicies(params manifestutils.Params, dep *v1.Deployment) (*v1.Deployment, error) {
+	tenants := params.Tempo.Spec.Tenants
+	if !UsesOPAPolicies(tenants) {
+		return dep, nil
+	}
+
+	image := params.CtrlConfig.DefaultImages.OPA
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      opaPoliciesVolumeName,
+			ReadOnly:  true,
+			MountPath: opaPoliciesMountDir,
+		},
+	}
+	opaResources := manifestutils.Resources(params.Tempo, manifestutils.GatewayOpaComponentName, params.Tempo.Spec.Template.Gateway.Replicas)
+
+	pod := corev1.PodSpec{
+		InitContainers: []corev1.Container{
+			{
+				Name:  fmt.Sprintf("%s-opa-check", containerNameTempoGateway),
+				Image: image,
+				Args: []string{
+					"check",
+					fmt.Sprintf("--ignore=%s", opaPoliciesIgnore),
+					opaPoliciesMountDir,
+				},
+				VolumeMounts:    volumeMounts,
+				Resources:       opaResources,
+				SecurityContext: manifestutils.TempoContainerSecurityContext(),
+			},
+		},
+		Containers: []corev1.Container{
+			{
+				Name:  fmt.Sprintf("%s-opa", containerNameTempoGateway),
+				Image: image,
+				Args: []string{
+					"run",
+					"--server",
+					"--log-level=error",
+					fmt.Sprintf("--addr=localhost:%d", gatewayOPAHTTPPort),
+					fmt.Sprintf("--diagnostic-addr=:%d", gatewayOPAInternalPort),
+					fmt.Sprintf("--ignore=%s", opaPoliciesIgnore),
+					"--watch",
+					opaPoliciesMountDir,
+				},
+				Ports: []corev1.ContainerPort{
+					{
+						Name:          "opa-metrics",
+						ContainerPort: gatewayOPAInternalPort,
+						Protocol:      corev1.ProtocolTCP,
+					},
+				},
+				LivenessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{
+						HTTPGet: &corev1.HTTPGetAction{
+							Path:   "/health",
+							Port:   intstr.FromInt(gatewayOPAInternalPort),
+							Scheme: corev1.URISchemeHTTP,
+						},
+					},
+					TimeoutSeconds:   2,
+					PeriodSeconds:    30,
+					FailureThreshold: 10,
+				},
+				ReadinessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{
+						HTTPGet: &corev1.HTTPGetAction{
+							Path:   "/health",
+							Port:   intstr.FromInt(gatewayOPAInternalPort),
+							Scheme: corev1.URISchemeHTTP,
+						},
+					},
+					TimeoutSeconds:   1,
+					PeriodSeconds:    5,
+					FailureThreshold: 12,
+				},
+				VolumeMounts:    volumeMounts,
+				Resources:       opaResources,
+				SecurityContext: manifestutils.TempoContainerSecurityContext(),
+			},
+		},
+		Volumes: []corev1.Volume{
+			{
+				Name: opaPoliciesVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: tenants.Authorization.OPA.ConfigMap,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := mergo.Merge(&dep.Spec.Template.Spec, pod, mergo.WithAppendSlice); err != nil {
+		return nil, err
+	}
+	return dep, nil
+}
@@ -0,0 +1,150 @@
+package gateway
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+func TestBuildGateway_OPAPolicies(t *testing.T) {
+	tempo := v1alpha1.TempoStack{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "simplest",
+			Namespace: "observability",
+		},
+		Spec: v1alpha1.TempoStackSpec{
+			Template: v1alpha1.TempoTemplateSpec{
+				Gateway: v1alpha1.TempoGatewaySpec{
+					Enabled: true,
+				},
+			},
+			Tenants: &v1alpha1.TenantsSpec{
+				Mode: v1alpha1.ModeStatic,
+				Authentication: []v1alpha1.AuthenticationSpec{
+					{
+						TenantName: "dev",
+						TenantID:   "abcd1",
+						OIDC: &v1alpha1.OIDCSpec{
+							IssuerURL: "https://dex.example.com",
+						},
+					},
+				},
+				Authorization: &v1alpha1.AuthorizationSpec{
+					OPA: &v1alpha1.OPAPolicySpec{
+						ConfigMap: "tempo-policies",
+						Package:   "tempo.authz",
+					},
+				},
+			},
+		},
+	}
+
+	objects, err := BuildGateway(manifestutils.Params{
+		Tempo: tempo,
+		CtrlConfig: configv1alpha1.ProjectConfig{
+			DefaultImages: configv1alpha1.ImagesSpec{
+				OPA: "docker.io/openpolicyagent/opa:latest",
+			},
+		},
+		GatewayTenantSecret: []*manifestutils.GatewayTenantOIDCSecret{
+			{TenantName: "dev", ClientID: "test"},
+		},
+	})
+	require.NoError(t, err)
+
+	obj := getObjectByTypeAndName(objects, "tempo-simplest-gateway", reflect.TypeOf(&appsv1.Deployment{}))
+	require.NotNil(t, obj)
+	pod := obj.(*appsv1.Deployment).Spec.Template.Spec
+
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      "opa-policies",
+			ReadOnly:  true,
+			MountPath: "/etc/tempo-gateway/opa-policies",
+		},
+	}
+	require.Len(t, pod.InitContainers, 1)
+	assert.Equal(t, "tempo-gateway-opa-check", pod.InitContainers[0].Name)
+	assert.Equal(t, "docker.io/openpolicyagent/opa:latest", pod.InitContainers[0].Image)
+	assert.Equal(t, []string{"check", "--ignore=..*", "/etc/tempo-gateway/opa-policies"}, pod.InitContainers[0].Args)
+	assert.Equal(t, volumeMounts, pod.InitContainers[0].VolumeMounts)
+
+	require.Len(t, pod.Containers, 2)
+	assert.Equal(t, "tempo-gateway-opa", pod.Containers[1].Name)
+	assert.Equal(t, "docker.io/openpolicyagent/opa:latest", pod.Containers[1].Image)
+	assert.Equal(t, []string{
+		"run",
+		"--server",
+		"--log-level=error",
+		"--addr=localhost:8082",
+		"--diagnostic-addr=:8083",
+		"--ignore=..*",
+		"--watch",
+		"/etc/tempo-gateway/opa-policies",
+	}, pod.Containers[1].Args)
+	assert.Equal(t, volumeMounts, pod.Containers[1].VolumeMounts)
+	assert.Contains(t, pod.Volumes, corev1.Volume{
+		Name: "opa-policies",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "tempo-policies"},
+			},
+		},
+	})
+
+	obj = getObjectByTypeAndName(objects, "tempo-simplest-gateway", reflect.TypeOf(&corev1.Secret{}))
+	require.NotNil(t, obj)
+	assert.Equal(t, `tenants:
+- name: dev
+  id: abcd1
+  oidc:
+    clientID: test
+    issuerURL: https://dex.example.com
+  opa:
+    url: http://localhost:8082/v1/data/tempo/authz/allow`, string(obj.(*corev1.Secret).Data[manifestutils.GatewayTenantFileName]))
+}
+
+func TestUsesOPAPolicies(t *testing.T) {
+	opa := &v1alpha1.OPAPolicySpec{ConfigMap: "policies", Package: "tempo"}
+	tests := []struct {
+		name     string
+		tenants  *v1alpha1.TenantsSpec
+		expected bool
+	}{
+		{
+			name: "no tenants",
+		},
+		{
+			name:    "no authorization",
+			tenants: &v1alpha1.TenantsSpec{Mode: v1alpha1.ModeStatic},
+		},
+		{
+			name:    "roles and role bindings",
+			tenants: &v1alpha1.TenantsSpec{Mode: v1alpha1.ModeStatic, Authorization: &v1alpha1.AuthorizationSpec{}},
+		},
+		{
+			name:     "OPA policies",
+			tenants:  &v1alpha1.TenantsSpec{Mode: v1alpha1.ModeStatic, Authorization: &v1alpha1.AuthorizationSpec{OPA: opa}},
+			expected: true,
+		},
+		{
+			name:    "openshift mode",
+			tenants: &v1alpha1.TenantsSpec{Mode: v1alpha1.ModeOpenShift, Authorization: &v1alpha1.AuthorizationSpec{OPA: opa}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, UsesOPAPolicies(tc.tenants))
+		})
+	}
+}
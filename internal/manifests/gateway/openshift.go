@@ -40,7 +40,11 @@ func BuildServiceAccountAnnotations(tenants v1alpha1.TenantsSpec, routeName stri
 func serviceAccount(tempo v1alpha1.TempoStack) *corev1.ServiceAccount {
 	tt := true
 	labels := manifestutils.ComponentLabels(manifestutils.GatewayComponentName, tempo.Name)
-	annotations := BuildServiceAccountAnnotations(*tempo.Spec.Tenants, naming.Name(manifestutils.GatewayComponentName, tempo.Name))
+	annotations := map[string]string{}
+	// The OAuth redirect references are only used by the OpenShift OAuth server.
+	if tempo.Spec.Tenants.Mode == v1alpha1.ModeOpenShift {
+		annotations = BuildServiceAccountAnnotations(*tempo.Spec.Tenants, naming.Name(manifestutils.GatewayComponentName, tempo.Name))
+	}
 	return &corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        naming.Name(manifestutils.GatewayComponentName, tempo.Name),
@@ -158,7 +162,7 @@ func patchOCPServingCerts(tempo v1alpha1.TempoStack, dep *v1.Deployment) (*v1.De
 			fmt.Sprintf("--tls.healthchecks.server-ca-file=%s", path.Join(tempoGatewayMountDir, "cabundle", "service-ca.crt")),
 			fmt.Sprintf("--tls.healthchecks.server-name=%s", naming.ServiceFqdn(tempo.Namespace, tempo.Name, manifestutils.GatewayComponentName)),
 			"--web.healthchecks.url=https://localhost:8080",
-			"--tls.client-auth-type=NoClientCert",
+			fmt.Sprintf("--tls.client-auth-type=%s", clientAuthType(tempo.Spec.Tenants)),
 		},
 	}
 	// WithOverrides overrides the HTTP in probes
@@ -0,0 +1,255 @@
+package gateway
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"time"
+
+	"github.com/prometheus/common/model"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+	"github.com/grafana/tempo-operator/internal/manifests/naming"
+)
+
+const (
+	containerNameRateLimiter = "gubernator"
+	defaultRateLimitWindow   = time.Second
+)
+
+// rateLimit is a request rate limit of an endpoint of a tenant.
+type rateLimit struct {
+	Endpoint string
+	Limit    int
+	Window   string
+	FailOpen bool
+}
+
+// writeEndpoint returns the regular expression matching the OTLP/HTTP endpoint of a tenant.
+func writeEndpoint(tenantName string) string {
+	return fmt.Sprintf("^/api/traces/v1/%s/v1/traces$", regexp.QuoteMeta(tenantName))
+}
+
+// readEndpoint returns the regular expression matching the Tempo and Jaeger query APIs of a tenant.
+func readEndpoint(tenantName string) string {
+	return fmt.Sprintf("^/api/traces/v1/%s/(tempo/)?api/.+", regexp.QuoteMeta(tenantName))
+}
+
+func newRateLimit(endpoint string, spec *v1alpha1.RequestRateLimitSpec) rateLimit {
+	window := spec.Window.Duration
+	if window == 0 {
+		window = defaultRateLimitWindow
+	}
+	return rateLimit{
+		Endpoint: endpoint,
+		Limit:    spec.Requests,
+		Window:   model.Duration(window).String(),
+		FailOpen: spec.FailOpen,
+	}
+}
+
+// tenantRateLimits returns the request rate limits of a tenant.
+// The read and write limits of a tenant take precedence over the global limits.
+func tenantRateLimits(tenantName string, spec *v1alpha1.GatewayRateLimitsSpec) []rateLimit {
+	if spec == nil {
+		return nil
+	}
+
+	limits := spec.Global
+	if tenantLimits, ok := spec.PerTenant[tenantName]; ok {
+		if tenantLimits.Write != nil {
+			limits.Write = tenantLimits.Write
+		}
+		if tenantLimits.Read != nil {
+			limits.Read = tenantLimits.Read
+		}
+	}
+
+	var rateLimits []rateLimit
+	if limits.Write != nil {
+		rateLimits = append(rateLimits, newRateLimit(writeEndpoint(tenantName), limits.Write))
+	}
+	if limits.Read != nil {
+		rateLimits = append(rateLimits, newRateLimit(readEndpoint(tenantName), limits.Read))
+	}
+	return rateLimits
+}
+
+// withRateLimits adds the request rate limits to the tenants of the gateway configuration.
+func withRateLimits(opts options, spec *v1alpha1.GatewayRateLimitsSpec) options {
+	if opts.Tenants == nil {
+		return opts
+	}
+	for i := range opts.Tenants.Authentication {
+		opts.Tenants.Authentication[i].RateLimits = tenantRateLimits(opts.Tenants.Authentication[i].TenantName, spec)
+	}
+	return opts
+}
+
+// rateLimitArgs returns the concurrency limit and rate limit service arguments of the gateway.
+func rateLimitArgs(tempo v1alpha1.TempoStack) []string {
+	spec := tempo.Spec.Template.Gateway.RateLimits
+	if spec == nil {
+		return nil
+	}
+
+	var args []string
+	if spec.Concurrency != nil {
+		args = append(args, fmt.Sprintf("--middleware.concurrent-request-limit=%d", spec.Concurrency.MaxRequests))
+		if spec.Concurrency.Backlog > 0 {
+			args = append(args, fmt.Sprintf("--middleware.backlog-limit-concurrent-requests=%d", spec.Concurrency.Backlog))
+		}
+		if spec.Concurrency.BacklogDuration.Duration > 0 {
+			args = append(args, fmt.Sprintf("--middleware.backlog-duration-concurrent-requests=%s", spec.Concurrency.BacklogDuration.Duration))
+		}
+	}
+	if spec.Service.Enabled {
+		args = append(args, fmt.Sprintf("--middleware.rate-limiter.grpc-address=%s:%d",
+			naming.ServiceFqdn(tempo.Namespace, tempo.Name, manifestutils.GatewayRateLimiterComponentName), manifestutils.PortGRPCServer))
+	}
+	return args
+}
+
+// UsesRateLimitService returns true if the rate limit service of the gateway is enabled.
+func UsesRateLimitService(tempo v1alpha1.TempoStack) bool {
+	gateway := tempo.Spec.Template.Gateway
+	return gateway.Enabled && gateway.RateLimits != nil && gateway.RateLimits.Service.Enabled
+}
+
+// BuildRateLimitService creates the objects of the gubernator (https://github.com/gubernator-io/gubernator)
+// rate limit service, which shares the request rate limits between the gateway replicas.
+// The replicas of the service discover each other using the DNS records of the headless service.
+func BuildRateLimitService(params manifestutils.Params) []client.Object {
+	if !UsesRateLimitService(params.Tempo) {
+		return nil
+	}
+	return []client.Object{
+		rateLimitServiceDeployment(params),
+		rateLimitService(params.Tempo),
+	}
+}
+
+func rateLimitServiceDeployment(params manifestutils.Params) *appsv1.Deployment {
+	tempo := params.Tempo
+	spec := tempo.Spec.Template.Gateway.RateLimits.Service
+	labels := manifestutils.ComponentLabels(manifestutils.GatewayRateLimiterComponentName, tempo.Name)
+	replicas := spec.Replicas
+	if replicas == nil {
+		replicas = ptr.To(int32(1))
+	}
+	resources := manifestutils.Resources(tempo, manifestutils.GatewayRateLimiterComponentName, replicas)
+	if spec.Resources != nil {
+		resources = *spec.Resources
+	}
+
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.Name(manifestutils.GatewayRateLimiterComponentName, tempo.Name),
+			Namespace: tempo.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      maps.Clone(labels),
+					Annotations: manifestutils.CommonAnnotations(params),
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: tempo.Spec.ServiceAccount,
+					NodeSelector:       tempo.Spec.Template.Gateway.NodeSelector,
+					Tolerations:        tempo.Spec.Template.Gateway.Tolerations,
+					Affinity:           manifestutils.DefaultAffinity(labels),
+					Containers: []corev1.Container{
+						{
+							Name:  containerNameRateLimiter,
+							Image: params.CtrlConfig.DefaultImages.Gubernator,
+							Env: []corev1.EnvVar{
+								{
+									Name: "POD_IP",
+									ValueFrom: &corev1.EnvVarSource{
+										FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.podIP"},
+									},
+								},
+								{Name: "GUBER_GRPC_ADDRESS", Value: fmt.Sprintf("0.0.0.0:%d", manifestutils.PortGRPCServer)},
+								{Name: "GUBER_HTTP_ADDRESS", Value: fmt.Sprintf("0.0.0.0:%d", manifestutils.PortHTTPServer)},
+								{Name: "GUBER_ADVERTISE_ADDRESS", Value: fmt.Sprintf("$(POD_IP):%d", manifestutils.PortGRPCServer)},
+								{Name: "GUBER_PEER_DISCOVERY_TYPE", Value: "dns"},
+								{Name: "GUBER_DNS_FQDN", Value: naming.ServiceFqdn(tempo.Namespace, tempo.Name, manifestutils.GatewayRateLimiterComponentName)},
+							},
+							Ports: []corev1.ContainerPort{
+								{
+									Name:          manifestutils.GrpcPortName,
+									ContainerPort: manifestutils.PortGRPCServer,
+									Protocol:      corev1.ProtocolTCP,
+								},
+								{
+									Name:          manifestutils.HttpPortName,
+									ContainerPort: manifestutils.PortHTTPServer,
+									Protocol:      corev1.ProtocolTCP,
+								},
+							},
+							ReadinessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path:   "/v1/HealthCheck",
+										Port:   intstr.FromString(manifestutils.HttpPortName),
+										Scheme: corev1.URISchemeHTTP,
+									},
+								},
+								TimeoutSeconds:   1,
+								PeriodSeconds:    5,
+								FailureThreshold: 12,
+							},
+							Resources:       resources,
+							SecurityContext: manifestutils.TempoContainerSecurityContext(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// rateLimitService creates a headless service, which is used by the gateway and for the peer discovery.
+func rateLimitService(tempo v1alpha1.TempoStack) *corev1.Service {
+	labels := manifestutils.ComponentLabels(manifestutils.GatewayRateLimiterComponentName, tempo.Name)
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.Name(manifestutils.GatewayRateLimiterComponentName, tempo.Name),
+			Namespace: tempo.Namespace,
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       manifestutils.GrpcPortName,
+					Port:       manifestutils.PortGRPCServer,
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromString(manifestutils.GrpcPortName),
+				},
+				{
+					Name:       manifestutils.HttpPortName,
+					Port:       manifestutils.PortHTTPServer,
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromString(manifestutils.HttpPortName),
+				},
+			},
+			Selector: labels,
+		},
+	}
+}
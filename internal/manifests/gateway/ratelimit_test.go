@@ -0,0 +1,183 @@
+package gateway
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+func TestBuildGateway_rateLimits(t *testing.T) {
+	tempo := v1alpha1.TempoStack{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "simplest",
+			Namespace: "observability",
+		},
+		Spec: v1alpha1.TempoStackSpec{
+			Template: v1alpha1.TempoTemplateSpec{
+				Gateway: v1alpha1.TempoGatewaySpec{
+					Enabled: true,
+					RateLimits: &v1alpha1.GatewayRateLimitsSpec{
+						Global: v1alpha1.GatewayRequestRateLimitsSpec{
+							Write: &v1alpha1.RequestRateLimitSpec{Requests: 100},
+						},
+						PerTenant: map[string]v1alpha1.GatewayRequestRateLimitsSpec{
+							"dev": {
+								Read: &v1alpha1.RequestRateLimitSpec{
+									Requests: 10,
+									Window:   metav1.Duration{Duration: time.Minute},
+									FailOpen: true,
+								},
+							},
+						},
+						Concurrency: &v1alpha1.GatewayConcurrencyLimitSpec{
+							MaxRequests:     500,
+							Backlog:         50,
+							BacklogDuration: metav1.Duration{Duration: 100 * time.Millisecond},
+						},
+						Service: v1alpha1.GatewayRateLimitServiceSpec{
+							Enabled:  true,
+							Replicas: ptr.To(int32(3)),
+						},
+					},
+				},
+			},
+			Tenants: &v1alpha1.TenantsSpec{
+				Mode: v1alpha1.ModeStatic,
+				Authentication: []v1alpha1.AuthenticationSpec{
+					{
+						TenantName: "dev",
+						TenantID:   "abcd1",
+						OIDC: &v1alpha1.OIDCSpec{
+							IssuerURL: "https://dex.example.com",
+						},
+					},
+				},
+				Authorization: &v1alpha1.AuthorizationSpec{},
+			},
+		},
+	}
+
+	objects, err := BuildGateway(manifestutils.Params{
+		Tempo: tempo,
+		CtrlConfig: configv1alpha1.ProjectConfig{
+			DefaultImages: configv1alpha1.ImagesSpec{
+				Gubernator: "ghcr.io/gubernator-io/gubernator:latest",
+			},
+		},
+		GatewayTenantSecret: []*manifestutils.GatewayTenantOIDCSecret{
+			{TenantName: "dev", ClientID: "test"},
+		},
+	})
+	require.NoError(t, err)
+
+	obj := getObjectByTypeAndName(objects, "tempo-simplest-gateway", reflect.TypeOf(&appsv1.Deployment{}))
+	require.NotNil(t, obj)
+	args := obj.(*appsv1.Deployment).Spec.Template.Spec.Containers[0].Args
+	assert.Contains(t, args, "--middleware.concurrent-request-limit=500")
+	assert.Contains(t, args, "--middleware.backlog-limit-concurrent-requests=50")
+	assert.Contains(t, args, "--middleware.backlog-duration-concurrent-requests=100ms")
+	assert.Contains(t, args, "--middleware.rate-limiter.grpc-address=tempo-simplest-gateway-ratelimiter.observability.svc.cluster.local:9095")
+
+	obj = getObjectByTypeAndName(objects, "tempo-simplest-gateway", reflect.TypeOf(&corev1.Secret{}))
+	require.NotNil(t, obj)
+	assert.Equal(t, `tenants:
+- name: dev
+  id: abcd1
+  oidc:
+    clientID: test
+    issuerURL: https://dex.example.com
+  rateLimits:
+  - endpoint: "^/api/traces/v1/dev/v1/traces$"
+    limit: 100
+    window: 1s
+    failOpen: false
+  - endpoint: "^/api/traces/v1/dev/(tempo/)?api/.+"
+    limit: 10
+    window: 1m
+    failOpen: true`, string(obj.(*corev1.Secret).Data[manifestutils.GatewayTenantFileName]))
+
+	obj = getObjectByTypeAndName(objects, "tempo-simplest-gateway-ratelimiter", reflect.TypeOf(&appsv1.Deployment{}))
+	require.NotNil(t, obj)
+	dep := obj.(*appsv1.Deployment)
+	assert.Equal(t, ptr.To(int32(3)), dep.Spec.Replicas)
+	container := dep.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "ghcr.io/gubernator-io/gubernator:latest", container.Image)
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "GUBER_PEER_DISCOVERY_TYPE", Value: "dns"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "GUBER_DNS_FQDN", Value: "tempo-simplest-gateway-ratelimiter.observability.svc.cluster.local"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "GUBER_ADVERTISE_ADDRESS", Value: "$(POD_IP):9095"})
+
+	obj = getObjectByTypeAndName(objects, "tempo-simplest-gateway-ratelimiter", reflect.TypeOf(&corev1.Service{}))
+	require.NotNil(t, obj)
+	assert.Equal(t, corev1.ClusterIPNone, obj.(*corev1.Service).Spec.ClusterIP)
+}
+
+func TestTenantRateLimits(t *testing.T) {
+	write := &v1alpha1.RequestRateLimitSpec{Requests: 100}
+	read := &v1alpha1.RequestRateLimitSpec{Requests: 10, Window: metav1.Duration{Duration: 90 * time.Second}}
+
+	tests := []struct {
+		name     string
+		tenant   string
+		spec     *v1alpha1.GatewayRateLimitsSpec
+		expected []rateLimit
+	}{
+		{
+			name:   "no rate limits",
+			tenant: "dev",
+		},
+		{
+			name:   "global rate limits",
+			tenant: "dev",
+			spec: &v1alpha1.GatewayRateLimitsSpec{
+				Global: v1alpha1.GatewayRequestRateLimitsSpec{Write: write, Read: read},
+			},
+			expected: []rateLimit{
+				{Endpoint: "^/api/traces/v1/dev/v1/traces$", Limit: 100, Window: "1s"},
+				{Endpoint: "^/api/traces/v1/dev/(tempo/)?api/.+", Limit: 10, Window: "1m30s"},
+			},
+		},
+		{
+			name:   "per-tenant rate limits take precedence",
+			tenant: "dev",
+			spec: &v1alpha1.GatewayRateLimitsSpec{
+				Global: v1alpha1.GatewayRequestRateLimitsSpec{Write: write, Read: read},
+				PerTenant: map[string]v1alpha1.GatewayRequestRateLimitsSpec{
+					"dev": {Write: &v1alpha1.RequestRateLimitSpec{Requests: 5, FailOpen: true}},
+				},
+			},
+			expected: []rateLimit{
+				{Endpoint: "^/api/traces/v1/dev/v1/traces$", Limit: 5, Window: "1s", FailOpen: true},
+				{Endpoint: "^/api/traces/v1/dev/(tempo/)?api/.+", Limit: 10, Window: "1m30s"},
+			},
+		},
+		{
+			name:   "tenant name is escaped",
+			tenant: "team.a",
+			spec: &v1alpha1.GatewayRateLimitsSpec{
+				PerTenant: map[string]v1alpha1.GatewayRequestRateLimitsSpec{
+					"team.a": {Write: write},
+				},
+			},
+			expected: []rateLimit{
+				{Endpoint: `^/api/traces/v1/team\.a/v1/traces$`, Limit: 100, Window: "1s"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tenantRateLimits(tc.tenant, tc.spec))
+		})
+	}
+}
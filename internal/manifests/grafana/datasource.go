@@ -7,16 +7,18 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/manifests/naming"
 )
 
 // BuildGrafanaDatasource creates a data source for Grafana Tempo.
-func BuildGrafanaDatasource(params manifestutils.Params) *grafanav1.GrafanaDatasource {
+func BuildGrafanaDatasource(params manifestutils.Params) (*grafanav1.GrafanaDatasource, error) {
 	tempo := params.Tempo
 	labels := manifestutils.CommonLabels(tempo.Name)
 	url := fmt.Sprintf("http://%s:%d", naming.ServiceFqdn(tempo.Namespace, tempo.Name, manifestutils.QueryFrontendComponentName), manifestutils.PortHTTPServer)
-	return NewGrafanaDatasource(tempo.Namespace, tempo.Name, labels, url, tempo.Spec.Observability.Grafana.InstanceSelector)
+	return NewGrafanaDatasource(tempo.Namespace, tempo.Name, labels, url, tempo.Spec.Observability.Grafana.InstanceSelector,
+		tempo.Spec.Observability.Grafana.GrafanaDataSourceLinksSpec)
 }
 
 // NewGrafanaDatasource creates a data source for Grafana Tempo.
@@ -26,7 +28,24 @@ func NewGrafanaDatasource(
 	labels labels.Set,
 	url string,
 	instanceSelector metav1.LabelSelector,
-) *grafanav1.GrafanaDatasource {
+	links v1alpha1.GrafanaDataSourceLinksSpec,
+) (*grafanav1.GrafanaDatasource, error) {
+	return newGrafanaDatasource(namespace, name, labels, url, instanceSelector, newJSONData(links))
+}
+
+func newGrafanaDatasource(
+	namespace string,
+	name string,
+	labels labels.Set,
+	url string,
+	instanceSelector metav1.LabelSelector,
+	data jsonData,
+) (*grafanav1.GrafanaDatasource, error) {
+	jsonData, err := marshalJSONData(data)
+	if err != nil {
+		return nil, err
+	}
+
 	return &grafanav1.GrafanaDatasource{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: grafanav1.SchemeGroupVersion.String(),
@@ -46,11 +65,12 @@ func NewGrafanaDatasource(
 				AllowCrossNamespaceImport: true,
 			},
 			Datasource: &grafanav1.GrafanaDatasourceInternal{
-				Name:   name,
-				Type:   "tempo",
-				Access: "proxy",
-				URL:    url,
+				Name:     name,
+				Type:     "tempo",
+				Access:   "proxy",
+				URL:      url,
+				JSONData: jsonData,
 			},
 		},
-	}
+	}, nil
 }
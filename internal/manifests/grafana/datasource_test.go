@@ -12,13 +12,14 @@ import (
 )
 
 func TestBuildGrafanaDatasource(t *testing.T) {
-	datasource := BuildGrafanaDatasource(manifestutils.Params{Tempo: v1alpha1.TempoStack{
+	datasource, err := BuildGrafanaDatasource(manifestutils.Params{Tempo: v1alpha1.TempoStack{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "test",
 			Namespace: "tempo",
 		},
 		Spec: v1alpha1.TempoStackSpec{},
 	}})
+	require.NoError(t, err)
 	labels := manifestutils.CommonLabels("test")
 
 	require.NotNil(t, datasource)
@@ -46,3 +47,85 @@ func TestBuildGrafanaDatasource(t *testing.T) {
 		},
 	}, datasource)
 }
+
+func TestBuildJSONData(t *testing.T) {
+	tests := []struct {
+		name     string
+		links    v1alpha1.GrafanaDataSourceLinksSpec
+		expected string
+	}{
+		{
+			name: "no links",
+		},
+		{
+			name: "traces to logs",
+			links: v1alpha1.GrafanaDataSourceLinksSpec{
+				TracesToLogs: &v1alpha1.GrafanaTracesToLogsSpec{
+					DataSourceUID:      "loki",
+					Tags:               []v1alpha1.GrafanaTagMapping{{Key: "service.name", Value: "service_name"}, {Key: "k8s.namespace.name"}},
+					SpanStartTimeShift: "-1h",
+					SpanEndTimeShift:   "1h",
+					FilterByTraceID:    true,
+				},
+			},
+			expected: `{"tracesToLogsV2": {
+				"datasourceUid": "loki",
+				"tags": [{"key": "service.name", "value": "service_name"}, {"key": "k8s.namespace.name"}],
+				"spanStartTimeShift": "-1h",
+				"spanEndTimeShift": "1h",
+				"filterByTraceID": true,
+				"filterBySpanID": false,
+				"customQuery": false
+			}}`,
+		},
+		{
+			name: "traces to logs with custom query",
+			links: v1alpha1.GrafanaDataSourceLinksSpec{
+				TracesToLogs: &v1alpha1.GrafanaTracesToLogsSpec{
+					DataSourceUID: "loki",
+					Query:         `{${__tags}} | trace_id="${__trace.traceId}"`,
+				},
+			},
+			expected: `{"tracesToLogsV2": {
+				"datasourceUid": "loki",
+				"filterByTraceID": false,
+				"filterBySpanID": false,
+				"customQuery": true,
+				"query": "{${__tags}} | trace_id=\"${__trace.traceId}\""
+			}}`,
+		},
+		{
+			name: "traces to metrics and service map",
+			links: v1alpha1.GrafanaDataSourceLinksSpec{
+				TracesToMetrics: &v1alpha1.GrafanaTracesToMetricsSpec{
+					DataSourceUID: "prometheus",
+					Tags:          []v1alpha1.GrafanaTagMapping{{Key: "service.name", Value: "service"}},
+					Queries: []v1alpha1.GrafanaMetricsQuery{
+						{Name: "Request rate", Query: "sum(rate(traces_spanmetrics_calls_total{$__tags}[5m]))"},
+					},
+				},
+				ServiceMap: &v1alpha1.GrafanaServiceMapSpec{DataSourceUID: "prometheus"},
+			},
+			expected: `{
+				"tracesToMetrics": {
+					"datasourceUid": "prometheus",
+					"tags": [{"key": "service.name", "value": "service"}],
+					"queries": [{"name": "Request rate", "query": "sum(rate(traces_spanmetrics_calls_total{$__tags}[5m]))"}]
+				},
+				"serviceMap": {"datasourceUid": "prometheus"}
+			}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			jsonData, err := buildJSONData(tc.links)
+			require.NoError(t, err)
+			if tc.expected == "" {
+				require.Nil(t, jsonData)
+				return
+			}
+			require.JSONEq(t, tc.expected, string(jsonData))
+		})
+	}
+}
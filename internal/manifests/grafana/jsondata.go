@@ -0,0 +1,110 @@
+package grafana
+
+import (
+	"encoding/json"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+// jsonData is the jsonData of the Tempo data source.
+// See https://grafana.com/docs/grafana/latest/datasources/tempo/configure-tempo-data-source/#provision-the-data-source
+type jsonData struct {
+	TracesToLogsV2  *tracesToLogs    `json:"tracesToLogsV2,omitempty"`
+	TracesToMetrics *tracesToMetrics `json:"tracesToMetrics,omitempty"`
+	ServiceMap      *serviceMap      `json:"serviceMap,omitempty"`
+
+	// Authentication and TLS settings of the per-tenant data sources.
+	OAuthPassThru     bool   `json:"oauthPassThru,omitempty"`
+	HTTPHeaderName1   string `json:"httpHeaderName1,omitempty"`
+	TLSAuthWithCACert bool   `json:"tlsAuthWithCACert,omitempty"`
+}
+
+type tracesToLogs struct {
+	DatasourceUID      string `json:"datasourceUid"`
+	Tags               []tag  `json:"tags,omitempty"`
+	SpanStartTimeShift string `json:"spanStartTimeShift,omitempty"`
+	SpanEndTimeShift   string `json:"spanEndTimeShift,omitempty"`
+	FilterByTraceID    bool   `json:"filterByTraceID"`
+	FilterBySpanID     bool   `json:"filterBySpanID"`
+	CustomQuery        bool   `json:"customQuery"`
+	Query              string `json:"query,omitempty"`
+}
+
+type tracesToMetrics struct {
+	DatasourceUID      string         `json:"datasourceUid"`
+	Tags               []tag          `json:"tags,omitempty"`
+	SpanStartTimeShift string         `json:"spanStartTimeShift,omitempty"`
+	SpanEndTimeShift   string         `json:"spanEndTimeShift,omitempty"`
+	Queries            []metricsQuery `json:"queries,omitempty"`
+}
+
+type tag struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+type metricsQuery struct {
+	Name  string `json:"name"`
+	Query string `json:"query"`
+}
+
+type serviceMap struct {
+	DatasourceUID string `json:"datasourceUid"`
+}
+
+// buildJSONData returns the jsonData of the data source, or nil if no link is configured.
+func buildJSONData(links v1alpha1.GrafanaDataSourceLinksSpec) (json.RawMessage, error) {
+	return marshalJSONData(newJSONData(links))
+}
+
+// newJSONData returns the jsonData with the links to other data sources.
+func newJSONData(links v1alpha1.GrafanaDataSourceLinksSpec) jsonData {
+	data := jsonData{}
+
+	if links.TracesToLogs != nil {
+		data.TracesToLogsV2 = &tracesToLogs{
+			DatasourceUID:      links.TracesToLogs.DataSourceUID,
+			Tags:               tags(links.TracesToLogs.Tags),
+			SpanStartTimeShift: links.TracesToLogs.SpanStartTimeShift,
+			SpanEndTimeShift:   links.TracesToLogs.SpanEndTimeShift,
+			FilterByTraceID:    links.TracesToLogs.FilterByTraceID,
+			FilterBySpanID:     links.TracesToLogs.FilterBySpanID,
+			CustomQuery:        links.TracesToLogs.Query != "",
+			Query:              links.TracesToLogs.Query,
+		}
+	}
+
+	if links.TracesToMetrics != nil {
+		data.TracesToMetrics = &tracesToMetrics{
+			DatasourceUID:      links.TracesToMetrics.DataSourceUID,
+			Tags:               tags(links.TracesToMetrics.Tags),
+			SpanStartTimeShift: links.TracesToMetrics.SpanStartTimeShift,
+			SpanEndTimeShift:   links.TracesToMetrics.SpanEndTimeShift,
+		}
+		for _, query := range links.TracesToMetrics.Queries {
+			data.TracesToMetrics.Queries = append(data.TracesToMetrics.Queries, metricsQuery{Name: query.Name, Query: query.Query})
+		}
+	}
+
+	if links.ServiceMap != nil {
+		data.ServiceMap = &serviceMap{DatasourceUID: links.ServiceMap.DataSourceUID}
+	}
+
+	return data
+}
+
+// marshalJSONData returns the encoded jsonData, or nil if nothing is set.
+func marshalJSONData(data jsonData) (json.RawMessage, error) {
+	if data == (jsonData{}) {
+		return nil, nil
+	}
+	return json.Marshal(data)
+}
+
+func tags(mappings []v1alpha1.GrafanaTagMapping) []tag {
+	var result []tag
+	for _, mapping := range mappings {
+		result = append(result, tag{Key: mapping.Key, Value: mapping.Value})
+	}
+	return result
+}
@@ -0,0 +1,133 @@
+package grafana
+
+import (
+	"encoding/json"
+	"fmt"
+
+	grafanav1 "github.com/grafana/grafana-operator/v5/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+	"github.com/grafana/tempo-operator/internal/manifests/naming"
+)
+
+// tokenSecretKey is the key of the token in a Secret of type kubernetes.io/service-account-token.
+const tokenSecretKey = "token"
+
+// Gateway defines how the per-tenant data sources connect to the gateway.
+type Gateway struct {
+	// URL is the base URL of the gateway, without the tenant path.
+	URL string
+	// CAConfigMap is the name of a ConfigMap containing the CA certificate (service-ca.crt) of the gateway.
+	// The CA is not configured if the name is empty.
+	CAConfigMap string
+	// Authentication defines how the data sources authenticate at the gateway.
+	Authentication *v1alpha1.GrafanaDataSourceAuthenticationSpec
+}
+
+// BuildTenantGrafanaDatasources creates a data source for every tenant of the TempoStack gateway.
+// Without tenants, the single data source of the query-frontend is created instead,
+// so the existing data source is not pruned.
+func BuildTenantGrafanaDatasources(params manifestutils.Params) ([]client.Object, error) {
+	tempo := params.Tempo
+	if tempo.Spec.Tenants == nil {
+		datasource, err := BuildGrafanaDatasource(params)
+		if err != nil {
+			return nil, err
+		}
+		return []client.Object{datasource}, nil
+	}
+
+	tls, caConfigMap := manifestutils.GatewayTLS(params)
+	gateway := Gateway{
+		URL: fmt.Sprintf("%s://%s:%d", scheme(tls),
+			naming.ServiceFqdn(tempo.Namespace, tempo.Name, manifestutils.GatewayComponentName), manifestutils.GatewayPortHTTPServer),
+		CAConfigMap:    caConfigMap,
+		Authentication: tempo.Spec.Observability.Grafana.Authentication,
+	}
+	return NewTenantGrafanaDatasources(tempo.Namespace, tempo.Name, manifestutils.CommonLabels(tempo.Name), gateway,
+		tempo.Spec.Tenants.Authentication, tempo.Spec.Observability.Grafana.InstanceSelector, tempo.Spec.Observability.Grafana.GrafanaDataSourceLinksSpec)
+}
+
+func scheme(tls bool) string {
+	if tls {
+		return "https"
+	}
+	return "http"
+}
+
+// NewTenantGrafanaDatasources creates a data source for every tenant of the gateway.
+// The data sources are named <name>-<tenant name>.
+func NewTenantGrafanaDatasources(
+	namespace string,
+	name string,
+	labels labels.Set,
+	gateway Gateway,
+	tenants []v1alpha1.AuthenticationSpec,
+	instanceSelector metav1.LabelSelector,
+	links v1alpha1.GrafanaDataSourceLinksSpec,
+) ([]client.Object, error) {
+	objs := make([]client.Object, 0, len(tenants))
+	for _, tenant := range tenants {
+		data := newJSONData(links)
+		secureData := map[string]string{}
+		var valuesFrom []grafanav1.ValueFrom
+
+		method := v1alpha1.GrafanaDataSourceAuthenticationForwardOAuthIdentity
+		if gateway.Authentication != nil && gateway.Authentication.Method != "" {
+			method = gateway.Authentication.Method
+		}
+		switch method {
+		case v1alpha1.GrafanaDataSourceAuthenticationServiceAccountToken:
+			data.HTTPHeaderName1 = "Authorization"
+			// The grafana-operator replaces the placeholder with the value of the referenced key.
+			secureData["httpHeaderValue1"] = fmt.Sprintf("Bearer ${%s}", tokenSecretKey)
+			valuesFrom = append(valuesFrom, grafanav1.ValueFrom{
+				TargetPath: "secureJsonData.httpHeaderValue1",
+				ValueFrom: grafanav1.ValueFromSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: gateway.Authentication.TokenSecret},
+						Key:                  tokenSecretKey,
+					},
+				},
+			})
+		default:
+			data.OAuthPassThru = true
+		}
+
+		if gateway.CAConfigMap != "" {
+			data.TLSAuthWithCACert = true
+			secureData["tlsCACert"] = fmt.Sprintf("${%s}", manifestutils.TLSCAFilename)
+			valuesFrom = append(valuesFrom, grafanav1.ValueFrom{
+				TargetPath: "secureJsonData.tlsCACert",
+				ValueFrom: grafanav1.ValueFromSource{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: gateway.CAConfigMap},
+						Key:                  manifestutils.TLSCAFilename,
+					},
+				},
+			})
+		}
+
+		url := fmt.Sprintf("%s/api/traces/v1/%s/tempo", gateway.URL, tenant.TenantName)
+		datasource, err := newGrafanaDatasource(namespace, naming.DNSName(fmt.Sprintf("%s-%s", name, tenant.TenantName)),
+			labels, url, instanceSelector, data)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(secureData) > 0 {
+			datasource.Spec.Datasource.SecureJSONData, err = json.Marshal(secureData)
+			if err != nil {
+				return nil, err
+			}
+		}
+		datasource.Spec.ValuesFrom = valuesFrom
+		objs = append(objs, datasource)
+	}
+	return objs, nil
+}
@@ -0,0 +1,161 @@
+package grafana
+
+import (
+	"testing"
+
+	grafanav1 "github.com/grafana/grafana-operator/v5/api/v1beta1"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+func TestBuildTenantGrafanaDatasources(t *testing.T) {
+	tenants := &v1alpha1.TenantsSpec{
+		Mode: v1alpha1.ModeOpenShift,
+		Authentication: []v1alpha1.AuthenticationSpec{
+			{TenantName: "dev", TenantID: "1"},
+			{TenantName: "prod", TenantID: "2"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		tempo      v1alpha1.TempoStack
+		ctrlConfig configv1alpha1.ProjectConfig
+		url        string
+		jsonData   string
+		secureData string
+		valuesFrom []grafanav1.ValueFrom
+	}{
+		{
+			name: "forward OAuth identity",
+			tempo: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{Tenants: tenants},
+			},
+			url:      "http://tempo-test-gateway.tempo.svc.cluster.local:8080",
+			jsonData: `{"oauthPassThru": true}`,
+		},
+		{
+			name: "service account token and serving certificates",
+			tempo: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: tenants,
+					Observability: v1alpha1.ObservabilitySpec{
+						Grafana: v1alpha1.GrafanaConfigSpec{
+							Authentication: &v1alpha1.GrafanaDataSourceAuthenticationSpec{
+								Method:      v1alpha1.GrafanaDataSourceAuthenticationServiceAccountToken,
+								TokenSecret: "grafana-token",
+							},
+						},
+					},
+				},
+			},
+			ctrlConfig: configv1alpha1.ProjectConfig{
+				Gates: configv1alpha1.FeatureGates{
+					OpenShift: configv1alpha1.OpenShiftFeatureGates{ServingCertsService: true},
+				},
+			},
+			url:        "https://tempo-test-gateway.tempo.svc.cluster.local:8080",
+			jsonData:   `{"httpHeaderName1": "Authorization", "tlsAuthWithCACert": true}`,
+			secureData: `{"httpHeaderValue1": "Bearer ${token}", "tlsCACert": "${service-ca.crt}"}`,
+			valuesFrom: []grafanav1.ValueFrom{
+				{
+					TargetPath: "secureJsonData.httpHeaderValue1",
+					ValueFrom: grafanav1.ValueFromSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "grafana-token"},
+							Key:                  "token",
+						},
+					},
+				},
+				{
+					TargetPath: "secureJsonData.tlsCACert",
+					ValueFrom: grafanav1.ValueFromSource{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "tempo-test-gateway-cabundle"},
+							Key:                  "service-ca.crt",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "gateway TLS with custom CA",
+			tempo: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode:           v1alpha1.ModeStatic,
+						Authentication: tenants.Authentication,
+					},
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{
+							Enabled: true,
+							TLS:     v1alpha1.TLSSpec{Enabled: true, CA: "custom-ca", Cert: "custom-cert"},
+						},
+					},
+				},
+			},
+			url:        "https://tempo-test-gateway.tempo.svc.cluster.local:8080",
+			jsonData:   `{"oauthPassThru": true, "tlsAuthWithCACert": true}`,
+			secureData: `{"tlsCACert": "${service-ca.crt}"}`,
+			valuesFrom: []grafanav1.ValueFrom{
+				{
+					TargetPath: "secureJsonData.tlsCACert",
+					ValueFrom: grafanav1.ValueFromSource{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "custom-ca"},
+							Key:                  "service-ca.crt",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.tempo.ObjectMeta = metav1.ObjectMeta{Name: "test", Namespace: "tempo"}
+			objs, err := BuildTenantGrafanaDatasources(manifestutils.Params{Tempo: tc.tempo, CtrlConfig: tc.ctrlConfig})
+			require.NoError(t, err)
+			require.Len(t, objs, 2)
+
+			for i, tenant := range []string{"dev", "prod"} {
+				datasource := objs[i].(*grafanav1.GrafanaDatasource)
+				require.Equal(t, "test-"+tenant, datasource.Name)
+				require.Equal(t, "tempo", datasource.Namespace)
+				require.Equal(t, "test-"+tenant, datasource.Spec.Datasource.Name)
+				require.Equal(t, tc.url+"/api/traces/v1/"+tenant+"/tempo", datasource.Spec.Datasource.URL)
+				require.JSONEq(t, tc.jsonData, string(datasource.Spec.Datasource.JSONData))
+				if tc.secureData == "" {
+					require.Nil(t, datasource.Spec.Datasource.SecureJSONData)
+				} else {
+					require.JSONEq(t, tc.secureData, string(datasource.Spec.Datasource.SecureJSONData))
+				}
+				require.Equal(t, tc.valuesFrom, datasource.Spec.ValuesFrom)
+			}
+		})
+	}
+}
+
+func TestBuildTenantGrafanaDatasourcesWithoutTenants(t *testing.T) {
+	tempo := v1alpha1.TempoStack{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "tempo"},
+		Spec: v1alpha1.TempoStackSpec{
+			Template: v1alpha1.TempoTemplateSpec{
+				Gateway: v1alpha1.TempoGatewaySpec{Enabled: true},
+			},
+		},
+	}
+
+	objs, err := BuildTenantGrafanaDatasources(manifestutils.Params{Tempo: tempo})
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+
+	datasource := objs[0].(*grafanav1.GrafanaDatasource)
+	require.Equal(t, "test", datasource.Name)
+	require.Equal(t, "http://tempo-test-query-frontend.tempo.svc.cluster.local:3200", datasource.Spec.Datasource.URL)
+}
@@ -51,6 +51,7 @@ func BuildIngester(params manifestutils.Params) ([]client.Object, error) {
 	}
 
 	manifestutils.PatchEnvVars(&ss.Spec.Template.Spec, "tempo", tempo.Spec.Env, tempo.Spec.EnvFrom)
+	manifestutils.PatchExtraArgs(&ss.Spec.Template.Spec, "tempo", tempo.Spec.Template.Ingester.ExtraArgs)
 
 	return []client.Object{
 		ss,
@@ -62,7 +63,7 @@ func BuildIngester(params manifestutils.Params) ([]client.Object, error) {
 func statefulSet(params manifestutils.Params) (*v1.StatefulSet, error) {
 	tempo := params.Tempo
 	labels := manifestutils.ComponentLabels(manifestutils.IngesterComponentName, tempo.Name)
-	annotations := manifestutils.CommonAnnotations(params)
+	annotations := manifestutils.ComponentAnnotations(params, manifestutils.IngesterComponentName)
 	annotations = manifestutils.StorageSecretHash(params.StorageParams, annotations)
 
 	filesystem := corev1.PersistentVolumeFilesystem
@@ -108,7 +109,7 @@ func statefulSet(params manifestutils.Params) (*v1.StatefulSet, error) {
 							Env:   proxy.ReadProxyVarsFromEnv(),
 							Args: []string{
 								"-target=ingester",
-								"-config.file=/conf/tempo.yaml",
+								"-config.file=" + manifestutils.TempoConfigFile(params, manifestutils.IngesterComponentName),
 								"-log.level=info",
 								"-config.expand-env=true",
 							},
@@ -118,6 +119,7 @@ func statefulSet(params manifestutils.Params) (*v1.StatefulSet, error) {
 									MountPath: "/conf",
 									ReadOnly:  true,
 								},
+								manifestutils.TenantOverridesVolumeMount(),
 								{
 									Name:      dataVolumeName,
 									MountPath: "/var/tempo",
@@ -156,6 +158,7 @@ func statefulSet(params manifestutils.Params) (*v1.StatefulSet, error) {
 								},
 							},
 						},
+						manifestutils.TenantOverridesVolume(tempo.Name),
 					},
 					SecurityContext: tempo.Spec.Template.Ingester.PodSecurityContext,
 				},
@@ -185,7 +188,7 @@ func statefulSet(params manifestutils.Params) (*v1.StatefulSet, error) {
 		return nil, err
 	}
 
-	ss.Spec.Template, err = manifestutils.PatchTracingEnvConfiguration(tempo, ss.Spec.Template)
+	ss.Spec.Template, err = manifestutils.PatchTracingEnvConfiguration(params, ss.Spec.Template)
 	if err != nil {
 		return nil, err
 	}
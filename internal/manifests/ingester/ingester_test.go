@@ -199,6 +199,11 @@ func TestBuildIngester(t *testing.T) {
 											MountPath: "/conf",
 											ReadOnly:  true,
 										},
+										{
+											Name:      manifestutils.TenantOverridesVolumeName,
+											MountPath: manifestutils.TenantOverridesDir,
+											ReadOnly:  true,
+										},
 										{
 											Name:      dataVolumeName,
 											MountPath: "/var/tempo",
@@ -256,6 +261,16 @@ func TestBuildIngester(t *testing.T) {
 										},
 									},
 								},
+								{
+									Name: manifestutils.TenantOverridesVolumeName,
+									VolumeSource: corev1.VolumeSource{
+										ConfigMap: &corev1.ConfigMapVolumeSource{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "tempo-test-overrides",
+											},
+										},
+									},
+								},
 							},
 						},
 					},
@@ -0,0 +1,353 @@
+package kuberbacproxy
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/operator-framework/operator-lib/proxy"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+	"github.com/grafana/tempo-operator/internal/manifests/naming"
+)
+
+const (
+	containerName        = "kube-rbac-proxy"
+	configVolumeName     = "kube-rbac-proxy-config"
+	configMountPath      = "/etc/kube-rbac-proxy"
+	configFileName       = "config.yaml"
+	tlsVolumeName        = "kube-rbac-proxy-tls"
+	tlsMountPath         = "/etc/tls/private"
+	proxyEndpointsPort   = 8643
+	healthPath           = "/healthz"
+	configHashAnnotation = "tempo.grafana.com/kubeRBACProxyConfig.hash"
+)
+
+// BackendProtocolAnnotation is the annotation of the NGINX Ingress controller which defines the protocol of the backend.
+const BackendProtocolAnnotation = "nginx.ingress.kubernetes.io/backend-protocol"
+
+// IngressAnnotations returns the annotations of the Ingress of the Jaeger UI.
+// kube-rbac-proxy only serves HTTPS, therefore the Ingress controller must connect to the proxy with HTTPS.
+// The oauth2-proxy of the OIDC login serves HTTP without the service serving certificates.
+// The annotations of the user take precedence.
+func IngressAnnotations(annotations map[string]string, authSpec *v1alpha1.JaegerQueryAuthenticationSpec, servingCerts bool) map[string]string {
+	if !ServesHTTPS(authSpec, servingCerts) {
+		return annotations
+	}
+
+	result := map[string]string{BackendProtocolAnnotation: "HTTPS"}
+	maps.Copy(result, annotations)
+	return result
+}
+
+// SubjectAccessReview is the subset of the SAR of the oauth-proxy which is supported by kube-rbac-proxy.
+type SubjectAccessReview struct {
+	Namespace    string `json:"namespace,omitempty"`
+	Verb         string `json:"verb,omitempty"`
+	APIGroup     string `json:"resourceAPIGroup,omitempty"`
+	APIVersion   string `json:"resourceAPIVersion,omitempty"`
+	Resource     string `json:"resource,omitempty"`
+	ResourceName string `json:"resourceName,omitempty"`
+}
+
+// ParseSAR parses the SAR of the Jaeger UI authentication.
+// kube-rbac-proxy derives the verb of the SubjectAccessReview from the HTTP method of the request,
+// therefore only the get verb (i.e. the verb of GET requests) can be reused.
+func ParseSAR(sar string) (SubjectAccessReview, error) {
+	review := SubjectAccessReview{}
+	decoder := json.NewDecoder(strings.NewReader(sar))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&review); err != nil {
+		return review, fmt.Errorf("invalid SAR: %w", err)
+	}
+
+	if review.Resource == "" {
+		return review, errors.New("invalid SAR: the resource must be set")
+	}
+	if review.Verb != "" && review.Verb != "get" {
+		return review, fmt.Errorf("invalid SAR: kube-rbac-proxy derives the verb from the HTTP method of the request, the verb %q is not supported", review.Verb)
+	}
+	return review, nil
+}
+
+type config struct {
+	Authorization authorization `json:"authorization"`
+}
+
+type authorization struct {
+	ResourceAttributes resourceAttributes `json:"resourceAttributes"`
+}
+
+type resourceAttributes struct {
+	Namespace  string `json:"namespace,omitempty"`
+	APIGroup   string `json:"apiGroup,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+	Resource   string `json:"resource,omitempty"`
+	Name       string `json:"name,omitempty"`
+}
+
+func buildConfig(sar string) ([]byte, error) {
+	review, err := ParseSAR(sar)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(config{
+		Authorization: authorization{
+			ResourceAttributes: resourceAttributes{
+				Namespace:  review.Namespace,
+				APIGroup:   review.APIGroup,
+				APIVersion: review.APIVersion,
+				Resource:   review.Resource,
+				Name:       review.ResourceName,
+			},
+		},
+	})
+}
+
+// Options defines the kube-rbac-proxy sidecar which protects the Jaeger UI of a Tempo instance.
+type Options struct {
+	// Tempo is the metadata of the TempoStack or TempoMonolithic instance.
+	Tempo metav1.ObjectMeta
+	// Component is the name of the component serving the Jaeger UI.
+	Component string
+	// Labels are the labels of the namespaced objects.
+	Labels labels.Set
+	// ClusterScopedLabels are the labels of the cluster-scoped objects.
+	ClusterScopedLabels labels.Set
+	AuthSpec            *v1alpha1.JaegerQueryAuthenticationSpec
+	Image               string
+	// OAuth2ProxyImage is the image of the oauth2-proxy sidecar of the OIDC login.
+	OAuth2ProxyImage string
+	// ServingCerts enables the OpenShift service serving certificates,
+	// otherwise kube-rbac-proxy generates a self-signed certificate.
+	ServingCerts bool
+	Resources    corev1.ResourceRequirements
+}
+
+func configMapName(opts Options) string {
+	return fmt.Sprintf("%s-kube-rbac-proxy", naming.Name(opts.Component, opts.Tempo.Name))
+}
+
+func tlsSecretName(tempoName string) string {
+	return fmt.Sprintf("%s-ui-kube-rbac-proxy-tls", tempoName)
+}
+
+// clusterScopedName returns the name of the ClusterRole and ClusterRoleBinding.
+// These are cluster scoped resources, therefore the name contains the namespace.
+func clusterScopedName(opts Options) string {
+	return fmt.Sprintf("%s-kube-rbac-proxy-%s", naming.Name(opts.Component, opts.Tempo.Name), opts.Tempo.Namespace)
+}
+
+// NewAccessReviewClusterRole creates a ClusterRole for tokenreviews and subjectaccessreviews.
+// kube-rbac-proxy authenticates the bearer tokens with a TokenReview and
+// authorizes the requests with a SubjectAccessReview.
+func NewAccessReviewClusterRole(name string, labels labels.Set) *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{"authentication.k8s.io"},
+				Resources: []string{"tokenreviews"},
+				Verbs:     []string{"create"},
+			},
+			{
+				APIGroups: []string{"authorization.k8s.io"},
+				Resources: []string{"subjectaccessreviews"},
+				Verbs:     []string{"create"},
+			},
+		},
+	}
+}
+
+func accessReviewClusterRoleBinding(name string, labels labels.Set, saNamespace string, saName string) *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Name:      saName,
+				Kind:      "ServiceAccount",
+				Namespace: saNamespace,
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			Kind:     "ClusterRole",
+			Name:     name,
+			APIGroup: "rbac.authorization.k8s.io",
+		},
+	}
+}
+
+// PatchPodTemplate adds the kube-rbac-proxy sidecar to the pod serving the Jaeger UI and
+// returns the ConfigMap and RBAC objects required by the sidecar.
+// With the OIDC login, the oauth2-proxy sidecar serves the proxy port and kube-rbac-proxy listens on localhost.
+func PatchPodTemplate(opts Options, template *corev1.PodTemplateSpec) ([]client.Object, error) {
+	cfg, err := buildConfig(opts.AuthSpec.SAR)
+	if err != nil {
+		return nil, err
+	}
+
+	configMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapName(opts),
+			Namespace: opts.Tempo.Namespace,
+			Labels:    opts.Labels,
+		},
+		Data: map[string]string{
+			configFileName: string(cfg),
+		},
+	}
+
+	oidc := opts.AuthSpec.IsOIDC()
+	listenAddress := fmt.Sprintf("0.0.0.0:%d", manifestutils.OAuthProxyPort)
+	if oidc {
+		listenAddress = fmt.Sprintf("127.0.0.1:%d", oidcUpstreamPort)
+	}
+
+	args := []string{
+		fmt.Sprintf("--secure-listen-address=%s", listenAddress),
+		fmt.Sprintf("--upstream=http://localhost:%d/", manifestutils.PortJaegerUI),
+		fmt.Sprintf("--config-file=%s/%s", configMountPath, configFileName),
+		fmt.Sprintf("--proxy-endpoints-port=%d", proxyEndpointsPort),
+	}
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      configVolumeName,
+			MountPath: configMountPath,
+			ReadOnly:  true,
+		},
+	}
+	template.Spec.Volumes = append(template.Spec.Volumes, corev1.Volume{
+		Name: configVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: configMap.Name,
+				},
+			},
+		},
+	})
+
+	if opts.ServingCerts {
+		args = append(args,
+			fmt.Sprintf("--tls-cert-file=%s/tls.crt", tlsMountPath),
+			fmt.Sprintf("--tls-private-key-file=%s/tls.key", tlsMountPath),
+		)
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      tlsVolumeName,
+			MountPath: tlsMountPath,
+			ReadOnly:  true,
+		})
+		template.Spec.Volumes = append(template.Spec.Volumes, corev1.Volume{
+			Name: tlsVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: tlsSecretName(opts.Tempo.Name),
+				},
+			},
+		})
+	}
+
+	resources := opts.Resources
+	if opts.AuthSpec.Resources != nil {
+		resources = *opts.AuthSpec.Resources
+	}
+
+	ports := []corev1.ContainerPort{
+		{
+			Name:          manifestutils.OAuthProxyPortName,
+			ContainerPort: manifestutils.OAuthProxyPort,
+			Protocol:      corev1.ProtocolTCP,
+		},
+	}
+	env := proxy.ReadProxyVarsFromEnv()
+	if oidc {
+		ports = nil
+		args = append(args, oidcArgs(opts.AuthSpec.OIDC)...)
+		env = append(env, secretEnvVar(clientIDEnvVar, opts.AuthSpec.OIDC.Secret, OIDCClientIDKey))
+	}
+
+	template.Spec.Containers = append(template.Spec.Containers, corev1.Container{
+		Name:         containerName,
+		Image:        opts.Image,
+		Args:         args,
+		Ports:        ports,
+		VolumeMounts: volumeMounts,
+		Resources:    resources,
+		Env:          env,
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Scheme: corev1.URISchemeHTTPS,
+					Path:   healthPath,
+					Port:   intstr.FromInt(proxyEndpointsPort),
+				},
+			},
+			InitialDelaySeconds: 5,
+			TimeoutSeconds:      5,
+		},
+		SecurityContext: manifestutils.TempoContainerSecurityContext(),
+	})
+
+	if oidc {
+		template.Spec.Containers = append(template.Spec.Containers, oauth2ProxyContainer(opts, resources))
+	}
+
+	// kube-rbac-proxy does not reload the configuration file, therefore restart the pods if the SAR changes.
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[configHashAnnotation] = fmt.Sprintf("%x", sha256.Sum256(cfg))
+
+	serviceAccountName := template.Spec.ServiceAccountName
+	if serviceAccountName == "" {
+		serviceAccountName = "default"
+	}
+
+	return []client.Object{
+		configMap,
+		NewAccessReviewClusterRole(clusterScopedName(opts), opts.ClusterScopedLabels),
+		accessReviewClusterRoleBinding(clusterScopedName(opts), opts.ClusterScopedLabels, opts.Tempo.Namespace, serviceAccountName),
+	}, nil
+}
+
+// PatchService adds the kube-rbac-proxy port to the service of the Jaeger UI.
+func PatchService(service *corev1.Service, tempoName string, servingCerts bool) {
+	if service == nil {
+		return
+	}
+
+	if servingCerts {
+		if service.Annotations == nil {
+			service.Annotations = make(map[string]string)
+		}
+		service.Annotations["service.beta.openshift.io/serving-cert-secret-name"] = tlsSecretName(tempoName)
+	}
+
+	service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+		Name:       manifestutils.OAuthProxyPortName,
+		Port:       manifestutils.OAuthProxyPort,
+		TargetPort: intstr.FromString(manifestutils.OAuthProxyPortName),
+	})
+}
@@ -0,0 +1,332 @@
+package kuberbacproxy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+func TestParseSAR(t *testing.T) {
+	tests := []struct {
+		name     string
+		sar      string
+		expected SubjectAccessReview
+		err      error
+	}{
+		{
+			name: "default SAR",
+			sar:  `{"namespace": "observability", "resource": "pods", "verb": "get"}`,
+			expected: SubjectAccessReview{
+				Namespace: "observability",
+				Resource:  "pods",
+				Verb:      "get",
+			},
+		},
+		{
+			name: "custom resource",
+			sar:  `{"namespace": "observability", "resourceAPIGroup": "tempo.grafana.com", "resource": "tempostacks", "resourceName": "simplest"}`,
+			expected: SubjectAccessReview{
+				Namespace:    "observability",
+				APIGroup:     "tempo.grafana.com",
+				Resource:     "tempostacks",
+				ResourceName: "simplest",
+			},
+		},
+		{
+			name: "missing resource",
+			sar:  `{"namespace": "observability"}`,
+			err:  errors.New("invalid SAR: the resource must be set"),
+		},
+		{
+			name: "unsupported verb",
+			sar:  `{"namespace": "observability", "resource": "pods", "verb": "list"}`,
+			err:  errors.New(`invalid SAR: kube-rbac-proxy derives the verb from the HTTP method of the request, the verb "list" is not supported`),
+		},
+		{
+			name: "unsupported field",
+			sar:  `{"resource": "pods", "path": "/"}`,
+			err:  errors.New(`invalid SAR: json: unknown field "path"`),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			review, err := ParseSAR(tc.sar)
+			if tc.err != nil {
+				assert.EqualError(t, err, tc.err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, review)
+		})
+	}
+}
+
+func TestPatchPodTemplate(t *testing.T) {
+	resources := corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU: resource.MustParse("100m"),
+		},
+	}
+	opts := Options{
+		Tempo: metav1.ObjectMeta{
+			Name:      "simplest",
+			Namespace: "observability",
+		},
+		Component:           manifestutils.QueryFrontendComponentName,
+		Labels:              manifestutils.ComponentLabels(manifestutils.QueryFrontendComponentName, "simplest"),
+		ClusterScopedLabels: map[string]string{"app.kubernetes.io/namespace": "observability"},
+		AuthSpec: &v1alpha1.JaegerQueryAuthenticationSpec{
+			Enabled:  true,
+			Provider: v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy,
+			SAR:      `{"namespace": "observability", "resource": "pods", "verb": "get"}`,
+		},
+		Image:     "quay.io/brancz/kube-rbac-proxy:latest",
+		Resources: resources,
+	}
+
+	tests := []struct {
+		name         string
+		servingCerts bool
+		expectedArgs []string
+	}{
+		{
+			name: "self-signed certificate",
+			expectedArgs: []string{
+				"--secure-listen-address=0.0.0.0:8443",
+				"--upstream=http://localhost:16686/",
+				"--config-file=/etc/kube-rbac-proxy/config.yaml",
+				"--proxy-endpoints-port=8643",
+			},
+		},
+		{
+			name:         "serving certificates",
+			servingCerts: true,
+			expectedArgs: []string{
+				"--secure-listen-address=0.0.0.0:8443",
+				"--upstream=http://localhost:16686/",
+				"--config-file=/etc/kube-rbac-proxy/config.yaml",
+				"--proxy-endpoints-port=8643",
+				"--tls-cert-file=/etc/tls/private/tls.crt",
+				"--tls-private-key-file=/etc/tls/private/tls.key",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			opts.ServingCerts = tc.servingCerts
+			template := corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					ServiceAccountName: "tempo-simplest",
+				},
+			}
+
+			objs, err := PatchPodTemplate(opts, &template)
+			require.NoError(t, err)
+			require.Len(t, objs, 3)
+
+			configMap := objs[0].(*corev1.ConfigMap)
+			assert.Equal(t, "tempo-simplest-query-frontend-kube-rbac-proxy", configMap.Name)
+			assert.Equal(t, `authorization:
+  resourceAttributes:
+    namespace: observability
+    resource: pods
+`, configMap.Data["config.yaml"])
+
+			clusterRoleBinding := objs[2].(*rbacv1.ClusterRoleBinding)
+			assert.Equal(t, "tempo-simplest-query-frontend-kube-rbac-proxy-observability", clusterRoleBinding.Name)
+			assert.Equal(t, "tempo-simplest-query-frontend-kube-rbac-proxy-observability", clusterRoleBinding.RoleRef.Name)
+			assert.Equal(t, []rbacv1.Subject{{Kind: "ServiceAccount", Name: "tempo-simplest", Namespace: "observability"}}, clusterRoleBinding.Subjects)
+
+			require.Len(t, template.Spec.Containers, 1)
+			container := template.Spec.Containers[0]
+			assert.Equal(t, "kube-rbac-proxy", container.Name)
+			assert.Equal(t, "quay.io/brancz/kube-rbac-proxy:latest", container.Image)
+			assert.Equal(t, tc.expectedArgs, container.Args)
+			assert.Equal(t, resources, container.Resources)
+			assert.Equal(t, []corev1.ContainerPort{{
+				Name:          manifestutils.OAuthProxyPortName,
+				ContainerPort: manifestutils.OAuthProxyPort,
+				Protocol:      corev1.ProtocolTCP,
+			}}, container.Ports)
+			assert.Contains(t, template.Annotations, "tempo.grafana.com/kubeRBACProxyConfig.hash")
+			if tc.servingCerts {
+				assert.Len(t, template.Spec.Volumes, 2)
+			} else {
+				assert.Len(t, template.Spec.Volumes, 1)
+			}
+		})
+	}
+}
+
+func TestPatchService(t *testing.T) {
+	service := &corev1.Service{}
+	PatchService(service, "simplest", true)
+
+	assert.Equal(t, "simplest-ui-kube-rbac-proxy-tls", service.Annotations["service.beta.openshift.io/serving-cert-secret-name"])
+	assert.Equal(t, []corev1.ServicePort{{
+		Name:       manifestutils.OAuthProxyPortName,
+		Port:       manifestutils.OAuthProxyPort,
+		TargetPort: intstr.FromString(manifestutils.OAuthProxyPortName),
+	}}, service.Spec.Ports)
+
+	service = &corev1.Service{}
+	PatchService(service, "simplest", false)
+	assert.Empty(t, service.Annotations)
+}
+
+func TestPatchPodTemplateOIDC(t *testing.T) {
+	opts := Options{
+		Tempo: metav1.ObjectMeta{
+			Name:      "simplest",
+			Namespace: "observability",
+		},
+		Component: manifestutils.QueryFrontendComponentName,
+		Labels:    manifestutils.ComponentLabels(manifestutils.QueryFrontendComponentName, "simplest"),
+		AuthSpec: &v1alpha1.JaegerQueryAuthenticationSpec{
+			Enabled:  true,
+			Provider: v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy,
+			SAR:      `{"namespace": "observability", "resource": "pods", "verb": "get"}`,
+			OIDC: &v1alpha1.JaegerUIOIDCSpec{
+				IssuerURL:   "https://keycloak.example.com/realms/tempo",
+				Secret:      "oidc",
+				GroupsClaim: "roles",
+			},
+		},
+		Image:            "quay.io/brancz/kube-rbac-proxy:latest",
+		OAuth2ProxyImage: "quay.io/oauth2-proxy/oauth2-proxy:latest",
+	}
+
+	tests := []struct {
+		name              string
+		servingCerts      bool
+		expectedProxyArgs []string
+		expectedScheme    corev1.URIScheme
+		expectedMounts    []corev1.VolumeMount
+	}{
+		{
+			name: "HTTP",
+			expectedProxyArgs: []string{
+				"--provider=oidc",
+				"--oidc-issuer-url=https://keycloak.example.com/realms/tempo",
+				"--upstream=https://localhost:8644/",
+				"--ssl-upstream-insecure-skip-verify",
+				"--pass-authorization-header",
+				"--skip-jwt-bearer-tokens",
+				"--skip-provider-button",
+				"--email-domain=*",
+				"--reverse-proxy",
+				"--http-address=0.0.0.0:8443",
+			},
+			expectedScheme: corev1.URISchemeHTTP,
+		},
+		{
+			name:         "serving certificates",
+			servingCerts: true,
+			expectedProxyArgs: []string{
+				"--provider=oidc",
+				"--oidc-issuer-url=https://keycloak.example.com/realms/tempo",
+				"--upstream=https://localhost:8644/",
+				"--ssl-upstream-insecure-skip-verify",
+				"--pass-authorization-header",
+				"--skip-jwt-bearer-tokens",
+				"--skip-provider-button",
+				"--email-domain=*",
+				"--reverse-proxy",
+				"--https-address=0.0.0.0:8443",
+				"--tls-cert-file=/etc/tls/private/tls.crt",
+				"--tls-key-file=/etc/tls/private/tls.key",
+			},
+			expectedScheme: corev1.URISchemeHTTPS,
+			expectedMounts: []corev1.VolumeMount{{Name: "kube-rbac-proxy-tls", MountPath: "/etc/tls/private", ReadOnly: true}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			opts.ServingCerts = tc.servingCerts
+			template := corev1.PodTemplateSpec{}
+
+			_, err := PatchPodTemplate(opts, &template)
+			require.NoError(t, err)
+			require.Len(t, template.Spec.Containers, 2)
+
+			kubeRBACProxy := template.Spec.Containers[0]
+			assert.Equal(t, "kube-rbac-proxy", kubeRBACProxy.Name)
+			assert.Equal(t, "--secure-listen-address=127.0.0.1:8644", kubeRBACProxy.Args[0])
+			assert.Subset(t, kubeRBACProxy.Args, []string{
+				"--oidc-issuer=https://keycloak.example.com/realms/tempo",
+				"--oidc-clientID=$(OIDC_CLIENT_ID)",
+				"--oidc-username-claim=email",
+				"--oidc-groups-claim=roles",
+			})
+			assert.Empty(t, kubeRBACProxy.Ports)
+			assert.Contains(t, kubeRBACProxy.Env, corev1.EnvVar{
+				Name: "OIDC_CLIENT_ID",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "oidc"},
+						Key:                  "clientID",
+					},
+				},
+			})
+
+			oauth2Proxy := template.Spec.Containers[1]
+			assert.Equal(t, "oauth2-proxy", oauth2Proxy.Name)
+			assert.Equal(t, "quay.io/oauth2-proxy/oauth2-proxy:latest", oauth2Proxy.Image)
+			assert.Equal(t, tc.expectedProxyArgs, oauth2Proxy.Args)
+			assert.Equal(t, []corev1.ContainerPort{{
+				Name:          manifestutils.OAuthProxyPortName,
+				ContainerPort: manifestutils.OAuthProxyPort,
+				Protocol:      corev1.ProtocolTCP,
+			}}, oauth2Proxy.Ports)
+			assert.Equal(t, tc.expectedScheme, oauth2Proxy.ReadinessProbe.HTTPGet.Scheme)
+			assert.Equal(t, tc.expectedMounts, oauth2Proxy.VolumeMounts)
+			var envNames []string
+			for _, env := range oauth2Proxy.Env {
+				envNames = append(envNames, env.Name)
+			}
+			assert.Subset(t, envNames, []string{"OAUTH2_PROXY_CLIENT_ID", "OAUTH2_PROXY_CLIENT_SECRET", "OAUTH2_PROXY_COOKIE_SECRET"})
+		})
+	}
+}
+
+func TestIngressAnnotations(t *testing.T) {
+	authSpec := &v1alpha1.JaegerQueryAuthenticationSpec{
+		Enabled:  true,
+		Provider: v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy,
+	}
+	assert.Equal(t, map[string]string{
+		"nginx.ingress.kubernetes.io/backend-protocol": "HTTPS",
+	}, IngressAnnotations(nil, authSpec, false))
+
+	annotations := map[string]string{
+		"nginx.ingress.kubernetes.io/backend-protocol": "GRPCS",
+		"example.com/annotation":                       "value",
+	}
+	assert.Equal(t, map[string]string{
+		"nginx.ingress.kubernetes.io/backend-protocol": "GRPCS",
+		"example.com/annotation":                       "value",
+	}, IngressAnnotations(annotations, authSpec, false))
+	// the annotations of the spec are not modified
+	assert.Len(t, annotations, 2)
+
+	// oauth2-proxy serves HTTP without the serving certificates
+	oidcAuthSpec := authSpec.DeepCopy()
+	oidcAuthSpec.OIDC = &v1alpha1.JaegerUIOIDCSpec{IssuerURL: "https://keycloak.example.com", Secret: "oidc"}
+	assert.Nil(t, IngressAnnotations(nil, oidcAuthSpec, false))
+	assert.Equal(t, map[string]string{
+		"nginx.ingress.kubernetes.io/backend-protocol": "HTTPS",
+	}, IngressAnnotations(nil, oidcAuthSpec, true))
+}
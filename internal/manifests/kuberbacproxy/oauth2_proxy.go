@@ -0,0 +1,137 @@
+package kuberbacproxy
+
+import (
+	"fmt"
+
+	"github.com/operator-framework/operator-lib/proxy"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+const (
+	oauth2ProxyContainerName = "oauth2-proxy"
+	// oidcUpstreamPort is the port of kube-rbac-proxy if the oauth2-proxy sidecar serves the Jaeger UI.
+	oidcUpstreamPort      = 8644
+	oauth2ProxyHealthPath = "/ping"
+
+	// OIDCClientIDKey is the key of the client ID in the OIDC Secret.
+	OIDCClientIDKey = "clientID"
+	// OIDCClientSecretKey is the key of the client secret in the OIDC Secret.
+	OIDCClientSecretKey = "clientSecret"
+	// OIDCCookieSecretKey is the key of the cookie secret in the OIDC Secret.
+	OIDCCookieSecretKey = "cookieSecret"
+
+	defaultUsernameClaim = "email"
+	defaultGroupsClaim   = "groups"
+	clientIDEnvVar       = "OIDC_CLIENT_ID"
+)
+
+// ServesHTTPS returns true if the proxy port of the Jaeger UI serves HTTPS.
+// kube-rbac-proxy always serves HTTPS, while oauth2-proxy serves HTTPS only with the service serving certificates.
+func ServesHTTPS(authSpec *v1alpha1.JaegerQueryAuthenticationSpec, servingCerts bool) bool {
+	return !authSpec.IsOIDC() || servingCerts
+}
+
+func secretEnvVar(name string, secret string, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: secret,
+				},
+				Key: key,
+			},
+		},
+	}
+}
+
+// oidcArgs returns the arguments of kube-rbac-proxy which authenticate the ID tokens forwarded by oauth2-proxy.
+func oidcArgs(oidc *v1alpha1.JaegerUIOIDCSpec) []string {
+	usernameClaim := oidc.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = defaultUsernameClaim
+	}
+	groupsClaim := oidc.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = defaultGroupsClaim
+	}
+
+	return []string{
+		fmt.Sprintf("--oidc-issuer=%s", oidc.IssuerURL),
+		fmt.Sprintf("--oidc-clientID=$(%s)", clientIDEnvVar),
+		fmt.Sprintf("--oidc-username-claim=%s", usernameClaim),
+		fmt.Sprintf("--oidc-groups-claim=%s", groupsClaim),
+	}
+}
+
+// oauth2ProxyContainer creates the oauth2-proxy sidecar, which logs in the users with the OIDC provider
+// and forwards the requests with the ID token of the user to kube-rbac-proxy.
+// The TLS volume of the service serving certificates is shared with kube-rbac-proxy.
+func oauth2ProxyContainer(opts Options, resources corev1.ResourceRequirements) corev1.Container {
+	oidc := opts.AuthSpec.OIDC
+	args := []string{
+		"--provider=oidc",
+		fmt.Sprintf("--oidc-issuer-url=%s", oidc.IssuerURL),
+		fmt.Sprintf("--upstream=https://localhost:%d/", oidcUpstreamPort),
+		// kube-rbac-proxy serves a certificate for the service name, not for localhost
+		"--ssl-upstream-insecure-skip-verify",
+		"--pass-authorization-header",
+		"--skip-jwt-bearer-tokens",
+		"--skip-provider-button",
+		"--email-domain=*",
+		"--reverse-proxy",
+	}
+	scheme := corev1.URISchemeHTTP
+	var volumeMounts []corev1.VolumeMount
+	if opts.ServingCerts {
+		scheme = corev1.URISchemeHTTPS
+		args = append(args,
+			fmt.Sprintf("--https-address=0.0.0.0:%d", manifestutils.OAuthProxyPort),
+			fmt.Sprintf("--tls-cert-file=%s/tls.crt", tlsMountPath),
+			fmt.Sprintf("--tls-key-file=%s/tls.key", tlsMountPath),
+		)
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      tlsVolumeName,
+			MountPath: tlsMountPath,
+			ReadOnly:  true,
+		})
+	} else {
+		args = append(args, fmt.Sprintf("--http-address=0.0.0.0:%d", manifestutils.OAuthProxyPort))
+	}
+
+	return corev1.Container{
+		Name:  oauth2ProxyContainerName,
+		Image: opts.OAuth2ProxyImage,
+		Args:  args,
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          manifestutils.OAuthProxyPortName,
+				ContainerPort: manifestutils.OAuthProxyPort,
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+		VolumeMounts: volumeMounts,
+		Resources:    resources,
+		Env: append([]corev1.EnvVar{
+			secretEnvVar("OAUTH2_PROXY_CLIENT_ID", oidc.Secret, OIDCClientIDKey),
+			secretEnvVar("OAUTH2_PROXY_CLIENT_SECRET", oidc.Secret, OIDCClientSecretKey),
+			secretEnvVar("OAUTH2_PROXY_COOKIE_SECRET", oidc.Secret, OIDCCookieSecretKey),
+		}, proxy.ReadProxyVarsFromEnv()...),
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Scheme: scheme,
+					Path:   oauth2ProxyHealthPath,
+					Port:   intstr.FromString(manifestutils.OAuthProxyPortName),
+				},
+			},
+			InitialDelaySeconds: 5,
+			TimeoutSeconds:      5,
+		},
+		SecurityContext: manifestutils.TempoContainerSecurityContext(),
+	}
+}
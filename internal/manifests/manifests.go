@@ -1,11 +1,14 @@
 package manifests
 
 import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/manifests/alerts"
 	"github.com/grafana/tempo-operator/internal/manifests/compactor"
 	"github.com/grafana/tempo-operator/internal/manifests/config"
+	"github.com/grafana/tempo-operator/internal/manifests/dashboards"
 	"github.com/grafana/tempo-operator/internal/manifests/distributor"
 	"github.com/grafana/tempo-operator/internal/manifests/gateway"
 	"github.com/grafana/tempo-operator/internal/manifests/grafana"
@@ -15,6 +18,7 @@ import (
 	"github.com/grafana/tempo-operator/internal/manifests/metricsgenerator"
 	"github.com/grafana/tempo-operator/internal/manifests/naming"
 	"github.com/grafana/tempo-operator/internal/manifests/networkpolicies"
+	"github.com/grafana/tempo-operator/internal/manifests/overrides"
 	"github.com/grafana/tempo-operator/internal/manifests/querier"
 	"github.com/grafana/tempo-operator/internal/manifests/queryfrontend"
 	"github.com/grafana/tempo-operator/internal/manifests/serviceaccount"
@@ -23,11 +27,17 @@ import (
 
 // BuildAll creates objects for Tempo deployment.
 func BuildAll(params manifestutils.Params) ([]client.Object, error) {
-	configMaps, configChecksum, err := config.BuildConfigMap(params)
+	configMaps, checksums, err := config.BuildConfigMap(params)
+	if err != nil {
+		return nil, err
+	}
+	params.ConfigChecksum = checksums.Config
+	params.ComponentConfigChecksums = checksums.Components
+
+	overridesConfigMap, err := config.BuildTenantOverridesConfigMap(params)
 	if err != nil {
 		return nil, err
 	}
-	params.ConfigChecksum = configChecksum
 
 	ingesterObjs, err := ingester.BuildIngester(params)
 	if err != nil {
@@ -54,7 +64,7 @@ func BuildAll(params manifestutils.Params) ([]client.Object, error) {
 	}
 
 	var manifests []client.Object
-	manifests = append(manifests, configMaps)
+	manifests = append(manifests, configMaps, overridesConfigMap)
 	if params.Tempo.Spec.ServiceAccount == naming.DefaultServiceAccountName(params.Tempo.Name) {
 		manifests = append(manifests, serviceaccount.BuildDefaultServiceAccount(params))
 	}
@@ -85,6 +95,17 @@ func BuildAll(params manifestutils.Params) ([]client.Object, error) {
 		manifests = append(manifests, servicemonitor.BuildServiceMonitors(params)...)
 	}
 
+	switch params.Tempo.Spec.Observability.Metrics.ScrapeMode {
+	case v1alpha1.MetricsScrapeModeAnnotations:
+		servicemonitor.AnnotateServices(manifests, params)
+	case v1alpha1.MetricsScrapeModeOTelCollector:
+		scrapeConfig, err := servicemonitor.BuildScrapeConfig(params)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, scrapeConfig)
+	}
+
 	if params.Tempo.Spec.Observability.Metrics.CreatePrometheusRules {
 		prometheusRuleObjs, err := alerts.BuildPrometheusRule(params)
 		if err != nil {
@@ -94,12 +115,32 @@ func BuildAll(params manifestutils.Params) ([]client.Object, error) {
 	}
 
 	if params.Tempo.Spec.Observability.Grafana.CreateDatasource {
-		manifests = append(manifests, grafana.BuildGrafanaDatasource(params))
+		if params.Tempo.Spec.Template.Gateway.Enabled {
+			datasources, err := grafana.BuildTenantGrafanaDatasources(params)
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, datasources...)
+		} else {
+			datasource, err := grafana.BuildGrafanaDatasource(params)
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, datasource)
+		}
+	}
+
+	if params.Tempo.Spec.Observability.Grafana.Dashboards != nil && params.Tempo.Spec.Observability.Grafana.Dashboards.Enabled {
+		dashboardObjs, err := dashboards.BuildDashboards(params)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, dashboardObjs...)
 	}
 
 	if params.Tempo.Spec.NetworkPolicy.Enabled == nil || *params.Tempo.Spec.NetworkPolicy.Enabled {
 		manifests = append(manifests, networkpolicies.GenerateOperandPolicies(params)...)
 	}
 
-	return manifests, nil
+	return overrides.Apply(manifests, params.Tempo.Spec.Overrides, field.NewPath("spec", "overrides"))
 }
@@ -83,7 +83,7 @@ func TestBuildAll(t *testing.T) {
 		TLSProfile: tlsprofile.TLSProfileOptions{},
 	})
 	require.NoError(t, err)
-	// 17 base objects + 9 network policies (gossip, metrics, DNS, distributor, ingester, compactor, querier, query-frontend, gateway)
+	// 18 base objects + 10 network policies (gossip, metrics, operator, DNS, distributor, ingester, compactor, querier, query-frontend, gateway)
 	// + 5 pod disruption budgets (distributor, ingester, querier, query-frontend, gateway; no PDB for the compactor)
-	assert.Len(t, objects, 31)
+	assert.Len(t, objects, 33)
 }
@@ -17,6 +17,17 @@ func CommonAnnotations(params Params) map[string]string {
 	return annotations
 }
 
+// ComponentAnnotations returns common annotations for the pods of a Tempo component.
+// If the component uses a component-specific configuration file, the config hash annotation
+// contains the checksum of this file, therefore only this component is restarted when it changes.
+func ComponentAnnotations(params Params, component string) map[string]string {
+	annotations := CommonAnnotations(params)
+	if checksum, ok := params.ComponentConfigChecksums[component]; ok {
+		annotations["tempo.grafana.com/config.hash"] = checksum
+	}
+	return annotations
+}
+
 // S3AWSSTSAnnotations returns service account annotations required by AWS STS.
 func S3AWSSTSAnnotations(secret S3) map[string]string {
 	return map[string]string{
@@ -67,6 +67,33 @@ func TestCommonAnnotations(t *testing.T) {
 	}
 }
 
+func TestComponentAnnotations(t *testing.T) {
+	params := Params{
+		ConfigChecksum: "abc123",
+		ComponentConfigChecksums: map[string]string{
+			QuerierComponentName: "def456",
+		},
+	}
+
+	assert.Equal(t, map[string]string{
+		"tempo.grafana.com/config.hash": "def456",
+	}, ComponentAnnotations(params, QuerierComponentName))
+	assert.Equal(t, map[string]string{
+		"tempo.grafana.com/config.hash": "abc123",
+	}, ComponentAnnotations(params, CompactorComponentName))
+}
+
+func TestTempoConfigFile(t *testing.T) {
+	params := Params{
+		ComponentConfigChecksums: map[string]string{
+			QuerierComponentName: "def456",
+		},
+	}
+
+	assert.Equal(t, "/conf/tempo-querier.yaml", TempoConfigFile(params, QuerierComponentName))
+	assert.Equal(t, "/conf/tempo.yaml", TempoConfigFile(params, CompactorComponentName))
+}
+
 func TestCertificateHashAnnotations(t *testing.T) {
 	tests := []struct {
 		name        string
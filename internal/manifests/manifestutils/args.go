@@ -0,0 +1,21 @@
+package manifestutils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PatchExtraArgs appends user-provided command line arguments to the named container.
+// This should be called after all operator-managed arguments have been set,
+// so user arguments can override operator defaults if needed (the last occurrence of a flag wins).
+func PatchExtraArgs(pod *corev1.PodSpec, containerName string, args []string) {
+	if len(args) == 0 {
+		return
+	}
+
+	index, _ := findContainerIndex(pod, containerName)
+	if index == -1 {
+		return
+	}
+
+	pod.Containers[index].Args = append(pod.Containers[index].Args, args...)
+}
@@ -0,0 +1,49 @@
+package manifestutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestPatchExtraArgs(t *testing.T) {
+	t.Run("appends user args after existing args", func(t *testing.T) {
+		pod := corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "tempo",
+					Args: []string{"-target=querier", "-log.level=info"},
+				},
+			},
+		}
+
+		PatchExtraArgs(&pod, "tempo", []string{"-log.level=debug"})
+
+		require.Equal(t, []string{"-target=querier", "-log.level=info", "-log.level=debug"}, pod.Containers[0].Args)
+	})
+
+	t.Run("no-op when args are empty", func(t *testing.T) {
+		pod := corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "tempo", Args: []string{"-target=querier"}},
+			},
+		}
+
+		PatchExtraArgs(&pod, "tempo", nil)
+
+		require.Equal(t, []string{"-target=querier"}, pod.Containers[0].Args)
+	})
+
+	t.Run("no-op when container not found", func(t *testing.T) {
+		pod := corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "other", Args: []string{"-foo"}},
+			},
+		}
+
+		PatchExtraArgs(&pod, "tempo", []string{"-bar"})
+
+		require.Equal(t, []string{"-foo"}, pod.Containers[0].Args)
+	})
+}
@@ -148,6 +148,8 @@ const (
 	GatewayComponentName = "gateway"
 	// GatewayOpaComponentName declares the internal name of the gateway OPA sidecar component.
 	GatewayOpaComponentName = "tempo-gateway-opa"
+	// GatewayRateLimiterComponentName declares the internal name of the gateway rate limit service component.
+	GatewayRateLimiterComponentName = "gateway-ratelimiter"
 
 	// TempoMonolithComponentName declares the internal name of the Tempo Monolith component.
 	TempoMonolithComponentName = "tempo"
@@ -21,6 +21,14 @@ func CommonLabels(instanceName string) map[string]string {
 	}
 }
 
+// ManagedByLabels returns the label of all objects created by the operator for TempoStack and TempoMonolithic instances.
+// The label is part of the CommonLabels of both TempoStack and TempoMonolithic.
+func ManagedByLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/managed-by": "tempo-operator",
+	}
+}
+
 // ClusterScopedCommonLabels returns common labels for cluster-scoped resouces, for example ClusterRole.
 func ClusterScopedCommonLabels(instance metav1.ObjectMeta) map[string]string {
 	return labels.Merge(CommonLabels(instance.Name), map[string]string{
@@ -0,0 +1,56 @@
+package manifestutils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/grafana/tempo-operator/internal/manifests/naming"
+)
+
+const (
+	// TenantOverridesVolumeName declares the name of the volume containing the per-tenant overrides.
+	TenantOverridesVolumeName = "tempo-overrides"
+	// TenantOverridesDir is the path where the per-tenant overrides ConfigMap is mounted.
+	TenantOverridesDir = "/overrides"
+	// TenantOverridesFileName is the key of the per-tenant overrides in the overrides ConfigMap.
+	TenantOverridesFileName = "overrides.yaml"
+	// TenantOverridesFile is the path of the per-tenant overrides file, which is reloaded by Tempo at runtime.
+	TenantOverridesFile = TenantOverridesDir + "/" + TenantOverridesFileName
+
+	// TempoRuntimeConfigPath is the path of the Tempo endpoint which shows the currently loaded runtime configuration.
+	TempoRuntimeConfigPath = "/status/runtime_config"
+	// TempoUserConfigurableOverridesPath is the path of the user-configurable overrides API of Tempo.
+	TempoUserConfigurableOverridesPath = "/api/overrides"
+
+	// SingleTenantID is the tenant ID used by Tempo if multitenancy is disabled.
+	SingleTenantID = "single-tenant"
+)
+
+// TenantOverridesConfigMapName returns the name of the ConfigMap containing the per-tenant overrides.
+func TenantOverridesConfigMapName(tempoName string) string {
+	return naming.Name("overrides", tempoName)
+}
+
+// TenantOverridesVolume returns the volume containing the per-tenant overrides ConfigMap.
+func TenantOverridesVolume(tempoName string) corev1.Volume {
+	return corev1.Volume{
+		Name: TenantOverridesVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: TenantOverridesConfigMapName(tempoName),
+				},
+			},
+		},
+	}
+}
+
+// TenantOverridesVolumeMount returns the volume mount of the per-tenant overrides.
+// The ConfigMap is mounted as a directory (not with subPath), therefore the kubelet
+// propagates updates to the running pods and Tempo reloads the overrides without a restart.
+func TenantOverridesVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      TenantOverridesVolumeName,
+		MountPath: TenantOverridesDir,
+		ReadOnly:  true,
+	}
+}
@@ -16,15 +16,17 @@ type KubeAPIServerInfo struct {
 
 // Params holds parameters used to create Tempo objects.
 type Params struct {
-	StorageParams       StorageParams
-	ConfigChecksum      string
-	CertHashAnnotations map[string]string
-	Tempo               v1alpha1.TempoStack
-	CtrlConfig          configv1alpha1.ProjectConfig
-	TLSProfile          tlsprofile.TLSProfileOptions
-	GatewayTenantSecret []*GatewayTenantOIDCSecret
-	GatewayTenantsData  []*GatewayTenantsData
-	KubeAPIServer       KubeAPIServerInfo
+	StorageParams  StorageParams
+	ConfigChecksum string
+	// ComponentConfigChecksums holds the checksums of the component-specific configuration files, keyed by component name.
+	ComponentConfigChecksums map[string]string
+	CertHashAnnotations      map[string]string
+	Tempo                    v1alpha1.TempoStack
+	CtrlConfig               configv1alpha1.ProjectConfig
+	TLSProfile               tlsprofile.TLSProfileOptions
+	GatewayTenantSecret      []*GatewayTenantOIDCSecret
+	GatewayTenantsData       []*GatewayTenantsData
+	KubeAPIServer            KubeAPIServerInfo
 }
 
 // StorageParams holds storage configuration from the storage secret, except the credentials.
@@ -1,5 +1,7 @@
 package manifestutils
 
+import "fmt"
+
 const (
 	// TLSDir is the path that is mounted from the secret for TLS.
 	TLSDir = "/var/run/tls"
@@ -28,4 +30,22 @@ const (
 	StorageTLSCADir = TLSDir + "/storage/ca"
 	// StorageTLSCertDir contains the certificate and key file for accessing object storage.
 	StorageTLSCertDir = TLSDir + "/storage/cert"
+
+	// TracingTLSCADir contains the CA file for exporting the traces of Tempo.
+	TracingTLSCADir = TLSDir + "/tracing/ca"
+	// TracingTLSCertDir contains the client certificate and key file for exporting the traces of Tempo.
+	TracingTLSCertDir = TLSDir + "/tracing/cert"
 )
+
+// TempoConfigFile returns the path of the Tempo configuration file of a component.
+func TempoConfigFile(params Params, component string) string {
+	if _, ok := params.ComponentConfigChecksums[component]; ok {
+		return fmt.Sprintf("/conf/%s", ComponentConfigFileName(component))
+	}
+	return "/conf/tempo.yaml"
+}
+
+// ComponentConfigFileName returns the name of the component-specific Tempo configuration file.
+func ComponentConfigFileName(component string) string {
+	return fmt.Sprintf("tempo-%s.yaml", component)
+}
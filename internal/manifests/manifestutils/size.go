@@ -2,6 +2,7 @@ package manifestutils
 
 import (
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -174,6 +175,61 @@ var rateLimitProfiles = map[v1alpha1.TempoStackSize]*RateLimitProfile{
 	},
 }
 
+// TuningProfile defines compactor, ingester and query tuning defaults for a given size.
+// Pointer fields allow distinguishing between "not set" (nil) and "set to zero".
+type TuningProfile struct {
+	CompactionWindow        *time.Duration
+	IngesterMaxBlockBytes   *int
+	SearchConcurrentJobs    *int
+	TraceByIDQueryShards    *int
+	MaxOutstandingPerTenant *int
+	MaxConcurrentQueries    *int
+}
+
+// tuningProfiles maps each size to its tuning profile.
+// nil profile means the operator and Tempo defaults are used.
+var tuningProfiles = map[v1alpha1.TempoStackSize]*TuningProfile{
+	// 1x.demo: Operator and Tempo defaults (development/demo environment)
+	v1alpha1.SizeDemo: nil,
+
+	// 1x.pico: Small production workloads
+	// Smaller head blocks and fewer search jobs to fit the smaller querier memory.
+	v1alpha1.SizePico: {
+		IngesterMaxBlockBytes: ptr.To(100_000_000),
+		SearchConcurrentJobs:  ptr.To(1000),
+		TraceByIDQueryShards:  ptr.To(20),
+		MaxConcurrentQueries:  ptr.To(10),
+	},
+
+	// 1x.extra-small: Medium production workloads (~100GB/day)
+	v1alpha1.SizeExtraSmall: {
+		IngesterMaxBlockBytes: ptr.To(250_000_000),
+		SearchConcurrentJobs:  ptr.To(2000),
+		TraceByIDQueryShards:  ptr.To(50),
+		MaxConcurrentQueries:  ptr.To(20),
+	},
+
+	// 1x.small: Larger production workloads (~500GB/day)
+	v1alpha1.SizeSmall: {
+		IngesterMaxBlockBytes:   ptr.To(500_000_000),
+		SearchConcurrentJobs:    ptr.To(2000),
+		TraceByIDQueryShards:    ptr.To(50),
+		MaxOutstandingPerTenant: ptr.To(4000),
+		MaxConcurrentQueries:    ptr.To(20),
+	},
+
+	// 1x.medium: High-scale production workloads (~2TB/day)
+	// Shorter compaction window to keep the number of blocks per window manageable.
+	v1alpha1.SizeMedium: {
+		CompactionWindow:        ptr.To(30 * time.Minute),
+		IngesterMaxBlockBytes:   ptr.To(1_000_000_000),
+		SearchConcurrentJobs:    ptr.To(4000),
+		TraceByIDQueryShards:    ptr.To(100),
+		MaxOutstandingPerTenant: ptr.To(8000),
+		MaxConcurrentQueries:    ptr.To(40),
+	},
+}
+
 // replicationFactors maps each size to its default replication factor.
 var replicationFactors = map[v1alpha1.TempoStackSize]int{
 	v1alpha1.SizeDemo:       1,
@@ -301,6 +357,15 @@ func GetRateLimitProfile(size v1alpha1.TempoStackSize) *RateLimitProfile {
 	return rateLimitProfiles[size]
 }
 
+// GetTuningProfile returns the tuning profile for the given size.
+// Returns nil if size is empty or is SizeDemo (which uses the defaults).
+func GetTuningProfile(size v1alpha1.TempoStackSize) *TuningProfile {
+	if size == "" {
+		return nil
+	}
+	return tuningProfiles[size]
+}
+
 // ReplicationFactorForSize returns the default replication factor for the given size.
 // Returns 0 if size is empty or unknown.
 func ReplicationFactorForSize(size v1alpha1.TempoStackSize) int {
@@ -119,6 +119,25 @@ func NewConfigMapCABundle(namespace string, name string, labels labels.Set) *cor
 	}
 }
 
+// GatewayTLS returns if the public server of the TempoStack gateway uses TLS,
+// and the name of the ConfigMap containing the CA certificate.
+func GatewayTLS(params Params) (bool, string) {
+	tempo := params.Tempo
+	servingCerts := params.CtrlConfig.Gates.OpenShift.ServingCertsService
+	tlsSpec := tempo.Spec.Template.Gateway.TLS
+
+	switch {
+	case servingCerts && tempo.Spec.Tenants != nil && tempo.Spec.Tenants.Mode == v1alpha1.ModeOpenShift:
+		return true, naming.Name("gateway-cabundle", tempo.Name)
+	case tlsSpec.Enabled && servingCerts && tlsSpec.Cert == "":
+		return true, naming.Name("gateway-cabundle", tempo.Name)
+	case tlsSpec.Enabled:
+		return true, tlsSpec.CA
+	default:
+		return false, ""
+	}
+}
+
 func findContainerIndex(pod *corev1.PodSpec, containerName string) (int, error) {
 	for i, container := range pod.Containers {
 		if container.Name == containerName {
@@ -3,16 +3,234 @@ package manifestutils
 import (
 	"fmt"
 	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/imdario/mergo"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/naming"
 )
 
+// TracingHeadersSecretKey is the key of the OTLP export headers in the Secret referenced by headersSecret.
+const TracingHeadersSecretKey = "headers"
+
+// OTLPTracingTarget defines the receiver of the traces of Tempo.
+type OTLPTracingTarget struct {
+	// Endpoint is the URL of the OTLP receiver.
+	Endpoint string
+	// CAConfigMap is the name of a ConfigMap containing the CA certificate (service-ca.crt) of the receiver.
+	// The CA is not configured if the name is empty.
+	CAConfigMap string
+	// Tenant is sent in the tenant header if the traces are exported to the gateway.
+	Tenant string
+}
+
+// NewOTLPTracingTarget returns the receiver of the traces.
+// If the traces are sent to the gateway, the endpoint points to the public server of the gateway
+// and the CA defaults to the CA of the gateway.
+func NewOTLPTracingTarget(spec v1alpha1.OTLPTracingSpec, gatewayHost string, gatewayTLS bool, gatewayCA string) OTLPTracingTarget {
+	target := OTLPTracingTarget{Endpoint: spec.Endpoint}
+	if spec.TLS != nil {
+		target.CAConfigMap = spec.TLS.CA
+	}
+	if spec.Gateway == nil {
+		return target
+	}
+
+	scheme := "http"
+	if gatewayTLS {
+		scheme = "https"
+	}
+	if spec.Protocol == v1alpha1.OTLPTracingProtocolHTTP {
+		target.Endpoint = fmt.Sprintf("%s://%s:%d/api/traces/v1/%s", scheme, gatewayHost, GatewayPortHTTPServer, spec.Gateway.Tenant)
+	} else {
+		target.Endpoint = fmt.Sprintf("%s://%s:%d", scheme, gatewayHost, GatewayPortGRPCServer)
+	}
+	target.Tenant = spec.Gateway.Tenant
+	if target.CAConfigMap == "" {
+		target.CAConfigMap = gatewayCA
+	}
+	return target
+}
+
+// StackOTLPTracingTarget returns the receiver of the traces of the TempoStack components.
+func StackOTLPTracingTarget(params Params) OTLPTracingTarget {
+	tempo := params.Tempo
+	tls, caConfigMap := GatewayTLS(params)
+	return NewOTLPTracingTarget(*tempo.Spec.Observability.Tracing.OTLP,
+		naming.ServiceFqdn(tempo.Namespace, tempo.Name, GatewayComponentName), tls, caConfigMap)
+}
+
+// Port returns the port of the OTLP receiver.
+func (t OTLPTracingTarget) Port() (int, error) {
+	endpoint, err := url.Parse(t.Endpoint)
+	if err != nil {
+		return 0, err
+	}
+	if endpoint.Port() != "" {
+		return strconv.Atoi(endpoint.Port())
+	}
+	if endpoint.Scheme == "https" {
+		return 443, nil
+	}
+	return 80, nil
+}
+
+// ConfigureOTLPTracing configures the OpenTelemetry SDK of the given containers to export traces via OTLP.
+func ConfigureOTLPTracing(pod *corev1.PodSpec, spec v1alpha1.OTLPTracingSpec, target OTLPTracingTarget, containers ...string) error {
+	protocol := spec.Protocol
+	if protocol == "" {
+		protocol = v1alpha1.OTLPTracingProtocolGRPC
+	}
+	sampler := spec.Sampler
+	if sampler == "" {
+		sampler = v1alpha1.TracingSamplerParentBasedTraceIDRatio
+	}
+
+	env := []corev1.EnvVar{
+		{
+			Name:  "OTEL_TRACES_EXPORTER",
+			Value: "otlp",
+		},
+		{
+			Name:  "OTEL_EXPORTER_OTLP_PROTOCOL",
+			Value: string(protocol),
+		},
+		{
+			Name:  "OTEL_EXPORTER_OTLP_ENDPOINT",
+			Value: target.Endpoint,
+		},
+	}
+	if target.CAConfigMap != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  "OTEL_EXPORTER_OTLP_CERTIFICATE",
+			Value: path.Join(TracingTLSCADir, TLSCAFilename),
+		})
+	}
+	if spec.TLS != nil && spec.TLS.Cert != "" {
+		env = append(env,
+			corev1.EnvVar{
+				Name:  "OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE",
+				Value: path.Join(TracingTLSCertDir, TLSCertFilename),
+			},
+			corev1.EnvVar{
+				Name:  "OTEL_EXPORTER_OTLP_CLIENT_KEY",
+				Value: path.Join(TracingTLSCertDir, TLSKeyFilename),
+			},
+		)
+	}
+	env = append(env, tracingHeaders(spec.HeadersSecret, target.Tenant)...)
+	env = append(env, corev1.EnvVar{
+		Name:  "OTEL_TRACES_SAMPLER",
+		Value: string(sampler),
+	})
+	if spec.SamplerArgument != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  "OTEL_TRACES_SAMPLER_ARG",
+			Value: spec.SamplerArgument,
+		})
+	}
+	if len(spec.ResourceAttributes) > 0 {
+		env = append(env, corev1.EnvVar{
+			Name:  "OTEL_RESOURCE_ATTRIBUTES",
+			Value: resourceAttributes(spec.ResourceAttributes),
+		})
+	}
+
+	for _, containerName := range containers {
+		containerIdx, err := findContainerIndex(pod, containerName)
+		if err != nil {
+			return err
+		}
+		pod.Containers[containerIdx].Env = append(pod.Containers[containerIdx].Env, env...)
+
+		if target.CAConfigMap != "" {
+			err := MountCAConfigMap(pod, containerName, target.CAConfigMap, TracingTLSCADir)
+			if err != nil {
+				return err
+			}
+		}
+		if spec.TLS != nil && spec.TLS.Cert != "" {
+			err := MountCertSecret(pod, containerName, spec.TLS.Cert, TracingTLSCertDir)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// tracingHeaders returns the environment variables of the OTLP export headers.
+// The tenant header is prepended to the headers of the Secret, if both are set.
+func tracingHeaders(headersSecret string, tenant string) []corev1.EnvVar {
+	secretRef := &corev1.EnvVarSource{
+		SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: headersSecret},
+			Key:                  TracingHeadersSecretKey,
+		},
+	}
+	tenantHeader := fmt.Sprintf("%s=%s", TenantHeader, tenant)
+
+	switch {
+	case headersSecret != "" && tenant != "":
+		return []corev1.EnvVar{
+			{
+				Name:      "TEMPO_OTLP_HEADERS",
+				ValueFrom: secretRef,
+			},
+			{
+				Name:  "OTEL_EXPORTER_OTLP_HEADERS",
+				Value: tenantHeader + ",$(TEMPO_OTLP_HEADERS)",
+			},
+		}
+	case headersSecret != "":
+		return []corev1.EnvVar{{
+			Name:      "OTEL_EXPORTER_OTLP_HEADERS",
+			ValueFrom: secretRef,
+		}}
+	case tenant != "":
+		return []corev1.EnvVar{{
+			Name:  "OTEL_EXPORTER_OTLP_HEADERS",
+			Value: tenantHeader,
+		}}
+	default:
+		return nil
+	}
+}
+
+// resourceAttributes formats the resource attributes as comma-separated key=value pairs, sorted by key.
+func resourceAttributes(attributes map[string]string) string {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, url.PathEscape(attributes[key])))
+	}
+	return strings.Join(pairs, ",")
+}
+
 // PatchTracingEnvConfiguration configures OTEL SDK via environment variables if
 // operand observability settings exist.
-func PatchTracingEnvConfiguration(tempo v1alpha1.TempoStack, pod corev1.PodTemplateSpec) (corev1.PodTemplateSpec, error) {
+func PatchTracingEnvConfiguration(params Params, pod corev1.PodTemplateSpec) (corev1.PodTemplateSpec, error) {
+	tempo := params.Tempo
+	if tempo.Spec.Observability.Tracing.OTLP != nil {
+		containers := make([]string, 0, len(pod.Spec.Containers))
+		for _, container := range pod.Spec.Containers {
+			containers = append(containers, container.Name)
+		}
+		err := ConfigureOTLPTracing(&pod.Spec, *tempo.Spec.Observability.Tracing.OTLP, StackOTLPTracingTarget(params), containers...)
+		return pod, err
+	}
+
 	if tempo.Spec.Observability.Tracing.SamplingFraction == "" {
 		return pod, nil
 	}
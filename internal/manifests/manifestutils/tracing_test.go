@@ -161,7 +161,7 @@ func Test_PatchTracing(t *testing.T) {
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			pod, err := PatchTracingEnvConfiguration(tc.inputTempo, tc.inputPod)
+			pod, err := PatchTracingEnvConfiguration(Params{Tempo: tc.inputTempo}, tc.inputPod)
 			if err != nil {
 				require.EqualError(t, err, tc.expectErr)
 			}
@@ -169,3 +169,164 @@ func Test_PatchTracing(t *testing.T) {
 		})
 	}
 }
+
+func TestNewOTLPTracingTarget(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     v1alpha1.OTLPTracingSpec
+		expected OTLPTracingTarget
+	}{
+		{
+			name: "endpoint",
+			spec: v1alpha1.OTLPTracingSpec{
+				Endpoint: "https://collector:4317",
+				TLS:      &v1alpha1.OTLPTracingTLSSpec{CA: "collector-ca"},
+			},
+			expected: OTLPTracingTarget{
+				Endpoint:    "https://collector:4317",
+				CAConfigMap: "collector-ca",
+			},
+		},
+		{
+			name: "gateway via gRPC",
+			spec: v1alpha1.OTLPTracingSpec{
+				Gateway: &v1alpha1.OTLPTracingGatewaySpec{Tenant: "dev"},
+			},
+			expected: OTLPTracingTarget{
+				Endpoint:    "https://tempo-simplest-gateway.ns.svc.cluster.local:8090",
+				CAConfigMap: "tempo-simplest-gateway-cabundle",
+				Tenant:      "dev",
+			},
+		},
+		{
+			name: "gateway via HTTP with custom CA",
+			spec: v1alpha1.OTLPTracingSpec{
+				Protocol: v1alpha1.OTLPTracingProtocolHTTP,
+				Gateway:  &v1alpha1.OTLPTracingGatewaySpec{Tenant: "dev"},
+				TLS:      &v1alpha1.OTLPTracingTLSSpec{CA: "custom-ca"},
+			},
+			expected: OTLPTracingTarget{
+				Endpoint:    "https://tempo-simplest-gateway.ns.svc.cluster.local:8080/api/traces/v1/dev",
+				CAConfigMap: "custom-ca",
+				Tenant:      "dev",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			target := NewOTLPTracingTarget(tc.spec, "tempo-simplest-gateway.ns.svc.cluster.local", true, "tempo-simplest-gateway-cabundle")
+			assert.Equal(t, tc.expected, target)
+		})
+	}
+}
+
+func TestOTLPTracingTargetPort(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		expected int
+	}{
+		{endpoint: "http://collector:4318", expected: 4318},
+		{endpoint: "https://collector.example.com", expected: 443},
+		{endpoint: "http://collector.example.com", expected: 80},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.endpoint, func(t *testing.T) {
+			port, err := OTLPTracingTarget{Endpoint: tc.endpoint}.Port()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, port)
+		})
+	}
+}
+
+func TestConfigureOTLPTracing(t *testing.T) {
+	pod := corev1.PodSpec{
+		Containers: []corev1.Container{
+			{Name: "tempo"},
+			{Name: "sidecar"},
+		},
+	}
+	spec := v1alpha1.OTLPTracingSpec{
+		Protocol:        v1alpha1.OTLPTracingProtocolHTTP,
+		Gateway:         &v1alpha1.OTLPTracingGatewaySpec{Tenant: "dev"},
+		TLS:             &v1alpha1.OTLPTracingTLSSpec{Cert: "client-cert"},
+		HeadersSecret:   "tracing-headers",
+		SamplerArgument: "0.5",
+		ResourceAttributes: map[string]string{
+			"k8s.cluster.name":       "prod",
+			"deployment.environment": "a,b",
+		},
+	}
+	target := OTLPTracingTarget{
+		Endpoint:    "https://tempo-simplest-gateway.ns.svc.cluster.local:8080/api/traces/v1/dev",
+		CAConfigMap: "tempo-simplest-gateway-cabundle",
+		Tenant:      "dev",
+	}
+
+	err := ConfigureOTLPTracing(&pod, spec, target, "tempo")
+	require.NoError(t, err)
+
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "OTEL_TRACES_EXPORTER", Value: "otlp"},
+		{Name: "OTEL_EXPORTER_OTLP_PROTOCOL", Value: "http/protobuf"},
+		{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: "https://tempo-simplest-gateway.ns.svc.cluster.local:8080/api/traces/v1/dev"},
+		{Name: "OTEL_EXPORTER_OTLP_CERTIFICATE", Value: "/var/run/tls/tracing/ca/service-ca.crt"},
+		{Name: "OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE", Value: "/var/run/tls/tracing/cert/tls.crt"},
+		{Name: "OTEL_EXPORTER_OTLP_CLIENT_KEY", Value: "/var/run/tls/tracing/cert/tls.key"},
+		{
+			Name: "TEMPO_OTLP_HEADERS",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "tracing-headers"},
+					Key:                  "headers",
+				},
+			},
+		},
+		{Name: "OTEL_EXPORTER_OTLP_HEADERS", Value: "x-scope-orgid=dev,$(TEMPO_OTLP_HEADERS)"},
+		{Name: "OTEL_TRACES_SAMPLER", Value: "parentbased_traceidratio"},
+		{Name: "OTEL_TRACES_SAMPLER_ARG", Value: "0.5"},
+		{Name: "OTEL_RESOURCE_ATTRIBUTES", Value: "deployment.environment=a%2Cb,k8s.cluster.name=prod"},
+	}, pod.Containers[0].Env)
+	assert.Equal(t, []corev1.VolumeMount{
+		{Name: "tempo-simplest-gateway-cabundle", MountPath: "/var/run/tls/tracing/ca", ReadOnly: true},
+		{Name: "client-cert", MountPath: "/var/run/tls/tracing/cert", ReadOnly: true},
+	}, pod.Containers[0].VolumeMounts)
+	assert.Empty(t, pod.Containers[1].Env)
+	assert.Len(t, pod.Volumes, 2)
+}
+
+func TestPatchTracingEnvConfigurationOTLP(t *testing.T) {
+	params := Params{Tempo: v1alpha1.TempoStack{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "simplest",
+			Namespace: "ns",
+		},
+		Spec: v1alpha1.TempoStackSpec{
+			Observability: v1alpha1.ObservabilitySpec{
+				Tracing: v1alpha1.TracingConfigSpec{
+					SamplingFraction: "1",
+					OTLPHttpEndpoint: "http://localhost:4320",
+					OTLP: &v1alpha1.OTLPTracingSpec{
+						Endpoint: "http://collector:4317",
+					},
+				},
+			},
+		},
+	}}
+	pod := corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "tempo"}},
+		},
+	}
+
+	pod, err := PatchTracingEnvConfiguration(params, pod)
+	require.NoError(t, err)
+	assert.Empty(t, pod.Annotations)
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "OTEL_TRACES_EXPORTER", Value: "otlp"},
+		{Name: "OTEL_EXPORTER_OTLP_PROTOCOL", Value: "grpc"},
+		{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: "http://collector:4317"},
+		{Name: "OTEL_TRACES_SAMPLER", Value: "parentbased_traceidratio"},
+	}, pod.Spec.Containers[0].Env)
+}
@@ -24,7 +24,7 @@ func BuildMetricsGenerator(params manifestutils.Params) ([]client.Object, error)
 		return nil, err
 	}
 
-	d.Spec.Template, err = manifestutils.PatchTracingEnvConfiguration(params.Tempo, d.Spec.Template)
+	d.Spec.Template, err = manifestutils.PatchTracingEnvConfiguration(params, d.Spec.Template)
 	if err != nil {
 		return nil, err
 	}
@@ -50,6 +50,8 @@ func BuildMetricsGenerator(params manifestutils.Params) ([]client.Object, error)
 		}
 	}
 
+	manifestutils.PatchExtraArgs(&d.Spec.Template.Spec, "tempo", tempo.Spec.Template.MetricsGenerator.ExtraArgs)
+
 	return []client.Object{
 		d,
 		service(tempo),
@@ -67,7 +69,7 @@ func resources(tempo v1alpha1.TempoStack) corev1.ResourceRequirements {
 func deployment(params manifestutils.Params) *v1.Deployment {
 	tempo := params.Tempo
 	labels := manifestutils.ComponentLabels(manifestutils.MetricsGeneratorComponentName, tempo.Name)
-	annotations := manifestutils.CommonAnnotations(params)
+	annotations := manifestutils.ComponentAnnotations(params, manifestutils.MetricsGeneratorComponentName)
 	cfg := tempo.Spec.Template.MetricsGenerator
 	image := tempo.Spec.Images.Tempo
 	if image == "" {
@@ -105,7 +107,7 @@ func deployment(params manifestutils.Params) *v1.Deployment {
 							Env:   proxy.ReadProxyVarsFromEnv(),
 							Args: []string{
 								"-target=metrics-generator",
-								"-config.file=/conf/tempo.yaml",
+								"-config.file=" + manifestutils.TempoConfigFile(params, manifestutils.MetricsGeneratorComponentName),
 								"-log.level=info",
 								"-config.expand-env=true",
 							},
@@ -133,6 +135,7 @@ func deployment(params manifestutils.Params) *v1.Deployment {
 									MountPath: "/conf",
 									ReadOnly:  true,
 								},
+								manifestutils.TenantOverridesVolumeMount(),
 								{
 									Name:      manifestutils.TmpStorageVolumeName,
 									MountPath: manifestutils.TmpTempoStoragePath,
@@ -153,6 +156,7 @@ func deployment(params manifestutils.Params) *v1.Deployment {
 								},
 							},
 						},
+						manifestutils.TenantOverridesVolume(tempo.Name),
 						{
 							Name: manifestutils.TmpStorageVolumeName,
 							VolumeSource: corev1.VolumeSource{
@@ -153,6 +153,11 @@ func TestBuildMetricsGenerator(t *testing.T) {
 									MountPath: "/conf",
 									ReadOnly:  true,
 								},
+								{
+									Name:      manifestutils.TenantOverridesVolumeName,
+									MountPath: manifestutils.TenantOverridesDir,
+									ReadOnly:  true,
+								},
 								{
 									Name:      manifestutils.TmpStorageVolumeName,
 									MountPath: manifestutils.TmpTempoStoragePath,
@@ -173,6 +178,16 @@ func TestBuildMetricsGenerator(t *testing.T) {
 								},
 							},
 						},
+						{
+							Name: manifestutils.TenantOverridesVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "tempo-test-overrides",
+									},
+								},
+							},
+						},
 						{
 							Name: manifestutils.TmpStorageVolumeName,
 							VolumeSource: corev1.VolumeSource{
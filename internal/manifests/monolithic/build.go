@@ -4,12 +4,14 @@ import (
 	"maps"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/manifests/naming"
 	"github.com/grafana/tempo-operator/internal/manifests/oauthproxy"
+	"github.com/grafana/tempo-operator/internal/manifests/overrides"
 )
 
 func getJaegerUIService(services []client.Object, tempo v1alpha1.TempoMonolithic) *corev1.Service {
@@ -82,6 +84,14 @@ func BuildAll(opts Options) ([]client.Object, error) {
 	manifests = append(manifests, services...)
 
 	if tempo.Spec.JaegerUI != nil && tempo.Spec.JaegerUI.Enabled {
+		if usesKubeRBACProxy(tempo) {
+			objs, err := patchKubeRBACProxy(opts, statefulSet, getJaegerUIService(services, tempo))
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, objs...)
+		}
+
 		if tempo.Spec.JaegerUI.Ingress != nil && tempo.Spec.JaegerUI.Ingress.Enabled {
 			manifests = append(manifests, BuildJaegerUIIngress(opts))
 		}
@@ -92,7 +102,9 @@ func BuildAll(opts Options) ([]client.Object, error) {
 				return nil, err
 			}
 			manifests = append(manifests, route)
-			if tempo.Spec.JaegerUI.Authentication.Enabled && !tempo.Spec.Multitenancy.IsGatewayEnabled() {
+			if usesKubeRBACProxy(tempo) {
+				oauthproxy.PatchRouteForOauthProxy(route)
+			} else if tempo.Spec.JaegerUI.Authentication.Enabled && !tempo.Spec.Multitenancy.IsGatewayEnabled() {
 
 				oauthproxy.PatchStatefulSetForOauthProxy(
 					tempo.ObjectMeta,
@@ -128,9 +140,30 @@ func BuildAll(opts Options) ([]client.Object, error) {
 
 		if tempo.Spec.Observability.Grafana != nil &&
 			tempo.Spec.Observability.Grafana.DataSource != nil && tempo.Spec.Observability.Grafana.DataSource.Enabled {
-			manifests = append(manifests, BuildGrafanaDatasource(opts))
+			if tempo.Spec.Multitenancy.IsGatewayEnabled() {
+				datasources, err := BuildTenantGrafanaDatasources(opts)
+				if err != nil {
+					return nil, err
+				}
+				manifests = append(manifests, datasources...)
+			} else {
+				datasource, err := BuildGrafanaDatasource(opts)
+				if err != nil {
+					return nil, err
+				}
+				manifests = append(manifests, datasource)
+			}
+		}
+
+		if tempo.Spec.Observability.Grafana != nil &&
+			tempo.Spec.Observability.Grafana.Dashboards != nil && tempo.Spec.Observability.Grafana.Dashboards.Enabled {
+			dashboards, err := BuildDashboards(opts)
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, dashboards...)
 		}
 	}
 
-	return manifests, nil
+	return overrides.Apply(manifests, tempo.Spec.Overrides, field.NewPath("spec", "overrides"))
 }
@@ -7,11 +7,14 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 )
 
 func TestBuildAll(t *testing.T) {
@@ -36,6 +39,79 @@ func TestBuildAll(t *testing.T) {
 	require.Len(t, objects, 4)
 }
 
+func TestBuildAll_KubeRBACProxy(t *testing.T) {
+	opts := Options{
+		CtrlConfig: configv1alpha1.ProjectConfig{
+			DefaultImages: configv1alpha1.ImagesSpec{
+				KubeRBACProxy: "quay.io/brancz/kube-rbac-proxy:latest",
+			},
+		},
+		Tempo: v1alpha1.TempoMonolithic{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "sample",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.TempoMonolithicSpec{
+				Storage: &v1alpha1.MonolithicStorageSpec{
+					Traces: v1alpha1.MonolithicTracesStorageSpec{
+						Backend: "memory",
+					},
+				},
+				JaegerUI: &v1alpha1.MonolithicJaegerUISpec{
+					Enabled: true,
+					Ingress: &v1alpha1.MonolithicJaegerUIIngressSpec{
+						Enabled: true,
+					},
+					Authentication: &v1alpha1.JaegerQueryAuthenticationSpec{
+						Enabled:  true,
+						Provider: v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy,
+					},
+				},
+			},
+		},
+	}
+	opts.Tempo.Default(opts.CtrlConfig)
+
+	objects, err := BuildAll(opts)
+	require.NoError(t, err)
+
+	var (
+		statefulSet        *appsv1.StatefulSet
+		ingress            *networkingv1.Ingress
+		configMap          *corev1.ConfigMap
+		clusterRoleBinding *rbacv1.ClusterRoleBinding
+	)
+	for _, obj := range objects {
+		switch typed := obj.(type) {
+		case *appsv1.StatefulSet:
+			statefulSet = typed
+		case *networkingv1.Ingress:
+			ingress = typed
+		case *corev1.ConfigMap:
+			if typed.Name == "tempo-sample-jaegerui-kube-rbac-proxy" {
+				configMap = typed
+			}
+		case *rbacv1.ClusterRoleBinding:
+			clusterRoleBinding = typed
+		}
+	}
+
+	require.NotNil(t, statefulSet)
+	containers := statefulSet.Spec.Template.Spec.Containers
+	assert.Equal(t, "kube-rbac-proxy", containers[len(containers)-1].Name)
+	require.NotNil(t, ingress)
+	assert.Equal(t, manifestutils.OAuthProxyPortName, ingress.Spec.DefaultBackend.Service.Port.Name)
+	require.NotNil(t, configMap)
+	assert.Equal(t, `authorization:
+  resourceAttributes:
+    namespace: default
+    resource: pods
+`, configMap.Data["config.yaml"])
+	require.NotNil(t, clusterRoleBinding)
+	assert.Equal(t, []rbacv1.Subject{{Kind: "ServiceAccount", Name: "tempo-sample", Namespace: "default"}}, clusterRoleBinding.Subjects)
+	assert.Equal(t, ClusterScopedComponentLabels(opts.Tempo.ObjectMeta, manifestutils.JaegerUIComponentName), map[string]string(clusterRoleBinding.Labels))
+}
+
 func TestIngestionServingCertName(t *testing.T) {
 	tests := []struct {
 		name             string
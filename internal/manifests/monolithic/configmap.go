@@ -101,7 +101,35 @@ type tempoConfig struct {
 		} `yaml:"receivers,omitempty"`
 	} `yaml:"distributor,omitempty"`
 
+	Ingester struct {
+		MaxBlockDuration time.Duration `yaml:"max_block_duration,omitempty"`
+		MaxBlockBytes    int           `yaml:"max_block_bytes,omitempty"`
+		TraceIdlePeriod  time.Duration `yaml:"trace_idle_period,omitempty"`
+	} `yaml:"ingester,omitempty"`
+
+	Compactor struct {
+		Compaction struct {
+			CompactionWindow     time.Duration `yaml:"compaction_window,omitempty"`
+			MaxCompactionObjects int           `yaml:"max_compaction_objects,omitempty"`
+			MaxBlockBytes        int           `yaml:"max_block_bytes,omitempty"`
+		} `yaml:"compaction,omitempty"`
+	} `yaml:"compactor,omitempty"`
+
+	Querier struct {
+		MaxConcurrentQueries int `yaml:"max_concurrent_queries,omitempty"`
+	} `yaml:"querier,omitempty"`
+
 	QueryFrontend struct {
+		MaxOutstandingPerTenant int `yaml:"max_outstanding_per_tenant,omitempty"`
+		Search                  struct {
+			ConcurrentJobs int `yaml:"concurrent_jobs,omitempty"`
+		} `yaml:"search,omitempty"`
+		TraceByID struct {
+			QueryShards int `yaml:"query_shards,omitempty"`
+		} `yaml:"trace_by_id,omitempty"`
+		Metrics struct {
+			ConcurrentJobs int `yaml:"concurrent_jobs,omitempty"`
+		} `yaml:"metrics,omitempty"`
 		MCPServer struct {
 			Enabled bool `yaml:"enabled,omitempty"`
 		} `yaml:"mcp_server,omitempty"`
@@ -371,6 +399,10 @@ func buildTempoConfig(opts Options) ([]byte, error) {
 		config.QueryFrontend.MCPServer.Enabled = true
 	}
 
+	if tempo.Spec.Tuning != nil {
+		configureTuning(&config, *tempo.Spec.Tuning)
+	}
+
 	generatedYaml, err := yaml.Marshal(config)
 	if err != nil {
 		return nil, err
@@ -383,6 +415,34 @@ func buildTempoConfig(opts Options) ([]byte, error) {
 	}
 }
 
+func configureTuning(config *tempoConfig, tuning v1alpha1.TuningSpec) {
+	if tuning.Compaction != nil {
+		if tuning.Compaction.CompactionWindow != nil {
+			config.Compactor.Compaction.CompactionWindow = tuning.Compaction.CompactionWindow.Duration
+		}
+		config.Compactor.Compaction.MaxCompactionObjects = ptr.Deref(tuning.Compaction.MaxCompactionObjects, 0)
+		config.Compactor.Compaction.MaxBlockBytes = ptr.Deref(tuning.Compaction.MaxBlockBytes, 0)
+	}
+
+	if tuning.Ingestion != nil {
+		if tuning.Ingestion.MaxBlockDuration != nil {
+			config.Ingester.MaxBlockDuration = tuning.Ingestion.MaxBlockDuration.Duration
+		}
+		config.Ingester.MaxBlockBytes = ptr.Deref(tuning.Ingestion.MaxBlockBytes, 0)
+		if tuning.Ingestion.TraceIdlePeriod != nil {
+			config.Ingester.TraceIdlePeriod = tuning.Ingestion.TraceIdlePeriod.Duration
+		}
+	}
+
+	if tuning.Query != nil {
+		config.Querier.MaxConcurrentQueries = ptr.Deref(tuning.Query.MaxConcurrentQueries, 0)
+		config.QueryFrontend.MaxOutstandingPerTenant = ptr.Deref(tuning.Query.MaxOutstandingPerTenant, 0)
+		config.QueryFrontend.Search.ConcurrentJobs = ptr.Deref(tuning.Query.SearchConcurrentJobs, 0)
+		config.QueryFrontend.TraceByID.QueryShards = ptr.Deref(tuning.Query.TraceByIDQueryShards, 0)
+		config.QueryFrontend.Metrics.ConcurrentJobs = ptr.Deref(tuning.Query.MetricsConcurrentJobs, 0)
+	}
+}
+
 func buildTempoQueryConfig(jaegerUISpec *v1alpha1.MonolithicJaegerUISpec, enableTLS bool, profile tlsprofile.TLSProfileOptions) ([]byte, error) {
 	config := tempoQueryConfig{}
 	config.Address = fmt.Sprintf("0.0.0.0:%d", manifestutils.PortTempoGRPCQuery)
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
@@ -539,6 +540,73 @@ query_frontend:
     enabled: true
 usage_report:
   reporting_enabled: false
+`,
+		},
+		{
+			name: "tuning",
+			spec: v1alpha1.TempoMonolithicSpec{
+				Tuning: &v1alpha1.TuningSpec{
+					Compaction: &v1alpha1.CompactionTuningSpec{
+						CompactionWindow:     &metav1.Duration{Duration: 30 * time.Minute},
+						MaxCompactionObjects: ptr.To(500000),
+					},
+					Ingestion: &v1alpha1.IngestionTuningSpec{
+						MaxBlockDuration: &metav1.Duration{Duration: 5 * time.Minute},
+						MaxBlockBytes:    ptr.To(104857600),
+						TraceIdlePeriod:  &metav1.Duration{Duration: 20 * time.Second},
+					},
+					Query: &v1alpha1.QueryTuningSpec{
+						TraceByIDQueryShards:    ptr.To(100),
+						SearchConcurrentJobs:    ptr.To(1500),
+						MetricsConcurrentJobs:   ptr.To(500),
+						MaxOutstandingPerTenant: ptr.To(3000),
+						MaxConcurrentQueries:    ptr.To(15),
+					},
+				},
+			},
+			expected: `
+server:
+  http_listen_port: 3200
+  http_server_read_timeout: 30s
+  http_server_write_timeout: 30s
+internal_server:
+  enable: true
+  http_listen_address: 0.0.0.0
+storage:
+  trace:
+    backend: local
+    wal:
+      path: /var/tempo/wal
+    local:
+      path: /var/tempo/blocks
+distributor:
+  receivers:
+    otlp:
+      protocols:
+        grpc:
+          endpoint: 0.0.0.0:4317
+        http:
+          endpoint: 0.0.0.0:4318
+ingester:
+  max_block_duration: 5m0s
+  max_block_bytes: 104857600
+  trace_idle_period: 20s
+compactor:
+  compaction:
+    compaction_window: 30m0s
+    max_compaction_objects: 500000
+querier:
+  max_concurrent_queries: 15
+query_frontend:
+  max_outstanding_per_tenant: 3000
+  search:
+    concurrent_jobs: 1500
+  trace_by_id:
+    query_shards: 100
+  metrics:
+    concurrent_jobs: 500
+usage_report:
+  reporting_enabled: false
 `,
 		},
 		{
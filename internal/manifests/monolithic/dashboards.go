@@ -0,0 +1,35 @@
+package monolithic
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/grafana/tempo-operator/internal/manifests/dashboards"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+	"github.com/grafana/tempo-operator/internal/manifests/naming"
+)
+
+// BuildDashboards creates the Grafana dashboards.
+func BuildDashboards(opts Options) ([]client.Object, error) {
+	tempo := opts.Tempo
+	// all Tempo components run in the same pod and share the same job label
+	job := fmt.Sprintf("%s/%s", tempo.Namespace, manifestutils.TempoMonolithComponentName)
+	workload := naming.Name(manifestutils.TempoMonolithComponentName, tempo.Name)
+
+	dashboardOpts := dashboards.Options{
+		Cluster:   tempo.Name,
+		Namespace: tempo.Namespace,
+		Jobs: dashboards.Jobs{
+			Distributor:   job,
+			Ingester:      job,
+			Querier:       job,
+			QueryFrontend: job,
+			Compactor:     job,
+		},
+		Workloads: workload,
+		Pods:      workload + "-[0-9]+",
+	}
+	return dashboards.NewDashboards(dashboardOpts, CommonLabels(tempo.Name), *tempo.Spec.Observability.Grafana.Dashboards,
+		opts.CtrlConfig.Gates.GrafanaOperator)
+}
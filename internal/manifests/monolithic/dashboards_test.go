@@ -0,0 +1,65 @@
+package monolithic
+
+import (
+	"testing"
+
+	grafanav1 "github.com/grafana/grafana-operator/v5/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+func TestBuildDashboards(t *testing.T) {
+	opts := Options{
+		CtrlConfig: configv1alpha1.ProjectConfig{
+			Gates: configv1alpha1.FeatureGates{GrafanaOperator: true},
+		},
+		Tempo: v1alpha1.TempoMonolithic{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "sample",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.TempoMonolithicSpec{
+				Observability: &v1alpha1.MonolithicObservabilitySpec{
+					Grafana: &v1alpha1.MonolithicObservabilityGrafanaSpec{
+						Dashboards: &v1alpha1.GrafanaDashboardsSpec{
+							Enabled: true,
+							InstanceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"key": "value"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	objects, err := BuildDashboards(opts)
+	require.NoError(t, err)
+	require.Len(t, objects, 5)
+
+	names := []string{}
+	for _, obj := range objects {
+		dashboard, ok := obj.(*grafanav1.GrafanaDashboard)
+		require.True(t, ok)
+		names = append(names, dashboard.Name)
+
+		assert.Equal(t, CommonLabels("sample"), map[string]string(dashboard.Labels))
+		assert.Equal(t, &metav1.LabelSelector{MatchLabels: map[string]string{"key": "value"}}, dashboard.Spec.InstanceSelector)
+		assert.NotContains(t, dashboard.Spec.JSON, `job=\"default/distributor\"`)
+	}
+	assert.Equal(t, []string{
+		"tempo-sample-dashboard-reads",
+		"tempo-sample-dashboard-writes",
+		"tempo-sample-dashboard-resources",
+		"tempo-sample-dashboard-tenants",
+		"tempo-sample-dashboard-rollout-progress",
+	}, names)
+
+	reads := objects[0].(*grafanav1.GrafanaDashboard)
+	assert.Contains(t, reads.Spec.JSON, `cluster=\"sample\", namespace=\"default\", job=\"default/tempo\"`)
+	rollout := objects[4].(*grafanav1.GrafanaDashboard)
+	assert.Contains(t, rollout.Spec.JSON, `statefulset=~\"tempo-sample\"`)
+}
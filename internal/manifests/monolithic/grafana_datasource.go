@@ -6,6 +6,7 @@ import (
 	grafanav1 "github.com/grafana/grafana-operator/v5/api/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/grafana/tempo-operator/internal/manifests/grafana"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
@@ -13,10 +14,35 @@ import (
 )
 
 // BuildGrafanaDatasource create a Grafana data source.
-func BuildGrafanaDatasource(opts Options) *grafanav1.GrafanaDatasource {
+func BuildGrafanaDatasource(opts Options) (*grafanav1.GrafanaDatasource, error) {
 	tempo := opts.Tempo
 	labels := ComponentLabels(manifestutils.TempoMonolithComponentName, tempo.Name)
 	url := fmt.Sprintf("http://%s:%d", naming.ServiceFqdn(tempo.Namespace, tempo.Name, manifestutils.TempoMonolithComponentName), manifestutils.PortHTTPServer)
-	instanceSelector := ptr.Deref(tempo.Spec.Observability.Grafana.DataSource.InstanceSelector, metav1.LabelSelector{})
-	return grafana.NewGrafanaDatasource(tempo.Namespace, tempo.Name, labels, url, instanceSelector)
+	dataSource := tempo.Spec.Observability.Grafana.DataSource
+	instanceSelector := ptr.Deref(dataSource.InstanceSelector, metav1.LabelSelector{})
+	return grafana.NewGrafanaDatasource(tempo.Namespace, tempo.Name, labels, url, instanceSelector, dataSource.GrafanaDataSourceLinksSpec)
+}
+
+// BuildTenantGrafanaDatasources creates a Grafana data source for every tenant of the gateway.
+func BuildTenantGrafanaDatasources(opts Options) ([]client.Object, error) {
+	tempo := opts.Tempo
+	labels := ComponentLabels(manifestutils.TempoMonolithComponentName, tempo.Name)
+	dataSource := tempo.Spec.Observability.Grafana.DataSource
+	instanceSelector := ptr.Deref(dataSource.InstanceSelector, metav1.LabelSelector{})
+
+	// The public server of the gateway uses the serving certificates of OpenShift, if available.
+	scheme := "http"
+	caConfigMap := ""
+	if opts.CtrlConfig.Gates.OpenShift.ServingCertsService {
+		scheme = "https"
+		caConfigMap = naming.ServingCABundleName(tempo.Name)
+	}
+
+	gateway := grafana.Gateway{
+		URL:            fmt.Sprintf("%s://%s:%d", scheme, naming.ServiceFqdn(tempo.Namespace, tempo.Name, manifestutils.GatewayComponentName), manifestutils.GatewayPortHTTPServer),
+		CAConfigMap:    caConfigMap,
+		Authentication: dataSource.Authentication,
+	}
+	return grafana.NewTenantGrafanaDatasources(tempo.Namespace, tempo.Name, labels, gateway,
+		tempo.Spec.Multitenancy.Authentication, instanceSelector, dataSource.GrafanaDataSourceLinksSpec)
 }
@@ -5,8 +5,10 @@ import (
 
 	grafanav1 "github.com/grafana/grafana-operator/v5/api/v1beta1"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 )
 
@@ -31,7 +33,8 @@ func TestBuildGrafanaDatasource(t *testing.T) {
 			},
 		},
 	}
-	datasource := BuildGrafanaDatasource(opts)
+	datasource, err := BuildGrafanaDatasource(opts)
+	require.NoError(t, err)
 
 	labels := ComponentLabels("tempo", "sample")
 	require.Equal(t, &grafanav1.GrafanaDatasource{
@@ -60,3 +63,55 @@ func TestBuildGrafanaDatasource(t *testing.T) {
 		},
 	}, datasource)
 }
+
+func TestBuildTenantGrafanaDatasources(t *testing.T) {
+	opts := Options{
+		CtrlConfig: configv1alpha1.ProjectConfig{
+			Gates: configv1alpha1.FeatureGates{
+				OpenShift: configv1alpha1.OpenShiftFeatureGates{ServingCertsService: true},
+			},
+		},
+		Tempo: v1alpha1.TempoMonolithic{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "sample",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.TempoMonolithicSpec{
+				Multitenancy: &v1alpha1.MonolithicMultitenancySpec{
+					Enabled: true,
+					TenantsSpec: v1alpha1.TenantsSpec{
+						Mode: v1alpha1.ModeOpenShift,
+						Authentication: []v1alpha1.AuthenticationSpec{
+							{TenantName: "dev", TenantID: "1"},
+						},
+					},
+				},
+				Observability: &v1alpha1.MonolithicObservabilitySpec{
+					Grafana: &v1alpha1.MonolithicObservabilityGrafanaSpec{
+						DataSource: &v1alpha1.MonolithicObservabilityGrafanaDataSourceSpec{
+							Enabled: true,
+						},
+					},
+				},
+			},
+		},
+	}
+	objs, err := BuildTenantGrafanaDatasources(opts)
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+
+	datasource := objs[0].(*grafanav1.GrafanaDatasource)
+	require.Equal(t, "sample-dev", datasource.Name)
+	require.Equal(t, map[string]string(ComponentLabels("tempo", "sample")), datasource.Labels)
+	require.Equal(t, "https://tempo-sample-gateway.default.svc.cluster.local:8080/api/traces/v1/dev/tempo", datasource.Spec.Datasource.URL)
+	require.JSONEq(t, `{"oauthPassThru": true, "tlsAuthWithCACert": true}`, string(datasource.Spec.Datasource.JSONData))
+	require.Equal(t, []grafanav1.ValueFrom{{
+		TargetPath: "secureJsonData.tlsCACert",
+		ValueFrom: grafanav1.ValueFromSource{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "tempo-sample-serving-cabundle"},
+				Key:                  "service-ca.crt",
+			},
+		},
+	}}, datasource.Spec.ValuesFrom)
+}
@@ -10,6 +10,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/kuberbacproxy"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/manifests/naming"
 )
@@ -36,6 +37,11 @@ func BuildJaegerUIIngress(opts Options) *networkingv1.Ingress {
 		},
 	}
 
+	if usesKubeRBACProxy(tempo) {
+		ingress.Annotations = kuberbacproxy.IngressAnnotations(ingress.Annotations,
+			tempo.Spec.JaegerUI.Authentication, opts.CtrlConfig.Gates.OpenShift.ServingCertsService)
+	}
+
 	backend := networkingv1.IngressBackend{
 		Service: &networkingv1.IngressServiceBackend{
 			Name: targetService,
@@ -127,6 +133,8 @@ func BuildJaegerUIRoute(opts Options) (*routev1.Route, error) {
 func jaegerUIServiceAndPort(tempo v1alpha1.TempoMonolithic) (string, string) {
 	if tempo.Spec.Multitenancy.IsGatewayEnabled() {
 		return naming.Name(manifestutils.GatewayComponentName, tempo.Name), manifestutils.GatewayHttpPortName
+	} else if usesKubeRBACProxy(tempo) {
+		return naming.Name(manifestutils.JaegerUIComponentName, tempo.Name), manifestutils.OAuthProxyPortName
 	} else {
 		return naming.Name(manifestutils.JaegerUIComponentName, tempo.Name), manifestutils.JaegerUIPortName
 	}
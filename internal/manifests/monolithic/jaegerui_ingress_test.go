@@ -113,6 +113,49 @@ func TestBuildJaegerUIIngress(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ingress with kube-rbac-proxy",
+			input: v1alpha1.TempoMonolithicSpec{
+				JaegerUI: &v1alpha1.MonolithicJaegerUISpec{
+					Enabled: true,
+					Ingress: &v1alpha1.MonolithicJaegerUIIngressSpec{
+						Enabled: true,
+						Annotations: map[string]string{
+							"nginx.ingress.kubernetes.io/proxy-read-timeout": "60",
+						},
+					},
+					Authentication: &v1alpha1.JaegerQueryAuthenticationSpec{
+						Enabled:  true,
+						Provider: v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy,
+					},
+				},
+			},
+			expected: &networkingv1.Ingress{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "networking.k8s.io/v1",
+					Kind:       "Ingress",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tempo-sample-jaegerui",
+					Namespace: "default",
+					Labels:    labels,
+					Annotations: map[string]string{
+						"nginx.ingress.kubernetes.io/backend-protocol":   "HTTPS",
+						"nginx.ingress.kubernetes.io/proxy-read-timeout": "60",
+					},
+				},
+				Spec: networkingv1.IngressSpec{
+					DefaultBackend: &networkingv1.IngressBackend{
+						Service: &networkingv1.IngressServiceBackend{
+							Name: "tempo-sample-jaegerui",
+							Port: networkingv1.ServiceBackendPort{
+								Name: "oauth-proxy",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,43 @@
+package monolithic
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/kuberbacproxy"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+// usesKubeRBACProxy returns true if the Jaeger UI is protected by kube-rbac-proxy.
+func usesKubeRBACProxy(tempo v1alpha1.TempoMonolithic) bool {
+	return tempo.Spec.JaegerUI != nil &&
+		tempo.Spec.JaegerUI.Enabled &&
+		!tempo.Spec.Multitenancy.IsGatewayEnabled() &&
+		tempo.Spec.JaegerUI.Authentication.IsKubeRBACProxy()
+}
+
+// patchKubeRBACProxy adds the kube-rbac-proxy sidecar, which authenticates and authorizes
+// the requests to the Jaeger UI with the Kubernetes API.
+func patchKubeRBACProxy(opts Options, statefulSet *appsv1.StatefulSet, service *corev1.Service) ([]client.Object, error) {
+	tempo := opts.Tempo
+	servingCerts := opts.CtrlConfig.Gates.OpenShift.ServingCertsService
+
+	objs, err := kuberbacproxy.PatchPodTemplate(kuberbacproxy.Options{
+		Tempo:               tempo.ObjectMeta,
+		Component:           manifestutils.JaegerUIComponentName,
+		Labels:              ComponentLabels(manifestutils.JaegerUIComponentName, tempo.Name),
+		ClusterScopedLabels: ClusterScopedComponentLabels(tempo.ObjectMeta, manifestutils.JaegerUIComponentName),
+		AuthSpec:            tempo.Spec.JaegerUI.Authentication,
+		Image:               opts.CtrlConfig.DefaultImages.KubeRBACProxy,
+		OAuth2ProxyImage:    opts.CtrlConfig.DefaultImages.OAuth2Proxy,
+		ServingCerts:        servingCerts,
+	}, &statefulSet.Spec.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	kuberbacproxy.PatchService(service, tempo.Name, servingCerts)
+	return objs, nil
+}
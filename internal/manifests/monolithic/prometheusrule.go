@@ -4,6 +4,7 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	k8slabels "k8s.io/apimachinery/pkg/labels"
 
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/manifests/alerts"
 )
 
@@ -11,10 +12,12 @@ import (
 func BuildPrometheusRules(opts Options) (*monitoringv1.PrometheusRule, error) {
 	tempo := opts.Tempo
 	labels := CommonLabels(opts.Tempo.Name)
+	var alertsSpec *v1alpha1.AlertsSpec
 	if opts.Tempo.Spec.Observability != nil &&
 		opts.Tempo.Spec.Observability.Metrics != nil &&
 		opts.Tempo.Spec.Observability.Metrics.PrometheusRules != nil {
 		labels = k8slabels.Merge(opts.Tempo.Spec.Observability.Metrics.PrometheusRules.ExtraLabels, labels)
+		alertsSpec = opts.Tempo.Spec.Observability.Metrics.PrometheusRules.Alerts
 	}
-	return alerts.NewPrometheusRule(tempo.Name, tempo.Namespace, labels)
+	return alerts.NewPrometheusRule(tempo.Name, tempo.Namespace, labels, alertsSpec)
 }
@@ -4,6 +4,7 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/manifests/servicemonitor"
 )
@@ -25,10 +26,11 @@ func BuildServiceMonitor(opts Options) *monitoringv1.ServiceMonitor {
 			[]string{
 				manifestutils.GatewayInternalHttpPortName,
 				manifestutils.HttpPortName,
-			})
+			}, v1alpha1.MetricsScrapeSpec{})
 	} else {
 		labels := ComponentLabels(manifestutils.TempoMonolithComponentName, tempo.Name)
 		return servicemonitor.NewServiceMonitor(
-			tempo.Namespace, tempo.Name, labels, extraLabels, false, manifestutils.TempoMonolithComponentName, []string{manifestutils.HttpPortName})
+			tempo.Namespace, tempo.Name, labels, extraLabels, false, manifestutils.TempoMonolithComponentName, []string{manifestutils.HttpPortName},
+			v1alpha1.MetricsScrapeSpec{})
 	}
 }
@@ -189,9 +189,32 @@ func BuildTempoStatefulset(opts Options, extraAnnotations map[string]string) (*a
 		}
 	}
 
+	if tempo.Spec.Observability != nil && tempo.Spec.Observability.Tracing != nil {
+		err := configureTracing(opts, sts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return sts, nil
 }
 
+func configureTracing(opts Options, sts *appsv1.StatefulSet) error {
+	tempo := opts.Tempo
+
+	// The public server of the gateway uses the serving certificates of OpenShift, if available.
+	gatewayTLS := false
+	gatewayCA := ""
+	if opts.CtrlConfig.Gates.OpenShift.ServingCertsService {
+		gatewayTLS = true
+		gatewayCA = naming.ServingCABundleName(tempo.Name)
+	}
+
+	target := manifestutils.NewOTLPTracingTarget(*tempo.Spec.Observability.Tracing,
+		naming.ServiceFqdn(tempo.Namespace, tempo.Name, manifestutils.GatewayComponentName), gatewayTLS, gatewayCA)
+	return manifestutils.ConfigureOTLPTracing(&sts.Spec.Template.Spec, *tempo.Spec.Observability.Tracing, target, "tempo")
+}
+
 func serviceAccountName(tempo v1alpha1.TempoMonolithic) string {
 	if tempo.Spec.ServiceAccount != "" {
 		return tempo.Spec.ServiceAccount
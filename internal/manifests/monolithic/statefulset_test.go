@@ -1431,3 +1431,55 @@ func TestStatefulsetPodSecurityContext(t *testing.T) {
 		RunAsGroup: ptr.To(int64(10001)),
 	}, sts.Spec.Template.Spec.SecurityContext)
 }
+
+func TestStatefulsetTracing(t *testing.T) {
+	opts := Options{
+		CtrlConfig: configv1alpha1.ProjectConfig{
+			DefaultImages: configv1alpha1.ImagesSpec{
+				Tempo: "docker.io/grafana/tempo:x.y.z",
+			},
+			Gates: configv1alpha1.FeatureGates{
+				OpenShift: configv1alpha1.OpenShiftFeatureGates{
+					ServingCertsService: true,
+				},
+			},
+		},
+		Tempo: v1alpha1.TempoMonolithic{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "sample",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.TempoMonolithicSpec{
+				Storage: &v1alpha1.MonolithicStorageSpec{
+					Traces: v1alpha1.MonolithicTracesStorageSpec{
+						Backend: "memory",
+					},
+				},
+				Observability: &v1alpha1.MonolithicObservabilitySpec{
+					Tracing: &v1alpha1.OTLPTracingSpec{
+						Gateway: &v1alpha1.OTLPTracingGatewaySpec{Tenant: "dev"},
+						Sampler: v1alpha1.TracingSamplerAlwaysOn,
+					},
+				},
+			},
+		},
+	}
+	sts, err := BuildTempoStatefulset(opts, map[string]string{})
+	require.NoError(t, err)
+
+	tempo := sts.Spec.Template.Spec.Containers[0]
+	require.Equal(t, "tempo", tempo.Name)
+	require.Subset(t, tempo.Env, []corev1.EnvVar{
+		{Name: "OTEL_TRACES_EXPORTER", Value: "otlp"},
+		{Name: "OTEL_EXPORTER_OTLP_PROTOCOL", Value: "grpc"},
+		{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: "https://tempo-sample-gateway.default.svc.cluster.local:8090"},
+		{Name: "OTEL_EXPORTER_OTLP_CERTIFICATE", Value: "/var/run/tls/tracing/ca/service-ca.crt"},
+		{Name: "OTEL_EXPORTER_OTLP_HEADERS", Value: "x-scope-orgid=dev"},
+		{Name: "OTEL_TRACES_SAMPLER", Value: "always_on"},
+	})
+	require.Contains(t, tempo.VolumeMounts, corev1.VolumeMount{
+		Name:      "tempo-sample-serving-cabundle",
+		MountPath: "/var/run/tls/tracing/ca",
+		ReadOnly:  true,
+	})
+}
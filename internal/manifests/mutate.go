@@ -311,14 +311,31 @@ func statefulSetVolumeClaimTemplatesChanged(existing, desired *appsv1.StatefulSe
 	return false
 }
 
-func mutateStatefulSet(existing, desired *appsv1.StatefulSet) error {
+// CheckImmutableFields returns an ImmutableErr if the desired object changes an immutable field of the existing object.
+func CheckImmutableFields(existing, desired client.Object) error {
+	switch existing := existing.(type) {
+	case *appsv1.StatefulSet:
+		return checkStatefulSetImmutableFields(existing, desired.(*appsv1.StatefulSet))
+	default:
+		return nil
+	}
+}
+
+func checkStatefulSetImmutableFields(existing, desired *appsv1.StatefulSet) error {
 	// list of mutable fields: https://github.com/kubernetes/kubernetes/blob/b1cf91b300a82bd05fdd7b115559e5b83680d768/pkg/apis/apps/validation/validation.go#L184
+	if !apiequality.Semantic.DeepEqual(desired.Spec.Selector, existing.Spec.Selector) {
+		return &ImmutableErr{".spec.selector", existing.Spec.Selector, desired.Spec.Selector}
+	}
+	if statefulSetVolumeClaimTemplatesChanged(existing, desired) {
+		return &ImmutableErr{".spec.volumeClaimTemplates", existing.Spec.VolumeClaimTemplates, desired.Spec.VolumeClaimTemplates}
+	}
+	return nil
+}
+
+func mutateStatefulSet(existing, desired *appsv1.StatefulSet) error {
 	if !existing.CreationTimestamp.IsZero() {
-		if !apiequality.Semantic.DeepEqual(desired.Spec.Selector, existing.Spec.Selector) {
-			return &ImmutableErr{".spec.selector", existing.Spec.Selector, desired.Spec.Selector}
-		}
-		if statefulSetVolumeClaimTemplatesChanged(existing, desired) {
-			return &ImmutableErr{".spec.volumeClaimTemplates", existing.Spec.VolumeClaimTemplates, desired.Spec.VolumeClaimTemplates}
+		if err := checkStatefulSetImmutableFields(existing, desired); err != nil {
+			return err
 		}
 	}
 
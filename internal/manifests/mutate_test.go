@@ -1279,3 +1279,25 @@ func TestMutateServiceAccount(t *testing.T) {
 		},
 	}, existing)
 }
+
+func TestCheckImmutableFields(t *testing.T) {
+	existing := &appsv1.StatefulSet{
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/component": "ingester"}},
+		},
+	}
+
+	desired := existing.DeepCopy()
+	require.NoError(t, manifests.CheckImmutableFields(existing, desired))
+
+	desired.Spec.Selector.MatchLabels["app.kubernetes.io/instance"] = "simplest"
+	var immutableErr *manifests.ImmutableErr
+	require.ErrorAs(t, manifests.CheckImmutableFields(existing, desired), &immutableErr)
+
+	desired = existing.DeepCopy()
+	desired.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}}
+	require.ErrorAs(t, manifests.CheckImmutableFields(existing, desired), &immutableErr)
+
+	// Other kinds don't have immutable fields which are changed by the operator
+	require.NoError(t, manifests.CheckImmutableFields(&appsv1.Deployment{}, &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))}}))
+}
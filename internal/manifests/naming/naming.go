@@ -43,6 +43,12 @@ func PrometheusRuleName(stackName string) string {
 	return fmt.Sprintf("%s-prometheus-rule", stackName)
 }
 
+// DashboardName returns the name of the object holding a Grafana dashboard.
+// Example: tempo-simplest-dashboard-reads.
+func DashboardName(dashboard string, tempoStackName string) string {
+	return Name(fmt.Sprintf("dashboard-%s", dashboard), tempoStackName)
+}
+
 // ServingCertName returns the Secret name of the serving certs generated by service-ca-operator.
 func ServingCertName(component string, tempoStackName string) string {
 	return Name(component, tempoStackName) + "-serving-cert"
@@ -1,6 +1,7 @@
 package networkpolicies
 
 import (
+	"net/url"
 	"sort"
 	"strconv"
 	"strings"
@@ -154,7 +155,7 @@ func policyPeersFor(name string, params manifestutils.Params) []networkingv1.Net
 			},
 		}
 	case netPolicyOAuthServer:
-		// Allow egress to OpenShift OAuth server for token exchange
+		// Allow egress to OpenShift OAuth server for token exchange, or to the OIDC provider of the Jaeger UI login
 		// The OAuth server can be accessed via route (external) or service (internal)
 		// so we allow both ipBlock and namespaceSelector
 		return []networkingv1.NetworkPolicyPeer{
@@ -279,6 +280,35 @@ func componentRelations(params manifestutils.Params) networkRelations {
 		}
 	}
 
+	if tempo.Spec.Observability.Tracing.OTLP != nil {
+		target := manifestutils.StackOTLPTracingTarget(params)
+		if port, err := target.Port(); err == nil {
+			// The components send their traces either to the gateway or to an external receiver
+			to := netPolicyOtelTargets
+			if tempo.Spec.Observability.Tracing.OTLP.Gateway != nil {
+				to = manifestutils.GatewayComponentName
+			}
+			tracingConn := networkingv1.NetworkPolicyPort{
+				Protocol: ptr.To(corev1.ProtocolTCP),
+				Port:     ptr.To(intstr.FromInt(port)),
+			}
+
+			components := []string{
+				manifestutils.DistributorComponentName,
+				manifestutils.IngesterComponentName,
+				manifestutils.QuerierComponentName,
+				manifestutils.QueryFrontendComponentName,
+				manifestutils.CompactorComponentName,
+			}
+			if tempo.Spec.Template.MetricsGenerator.Enabled {
+				components = append(components, manifestutils.MetricsGeneratorComponentName)
+			}
+			for _, component := range components {
+				fromTo[component][to] = append(fromTo[component][to], tracingConn)
+			}
+		}
+	}
+
 	fromTo[netPolicyClusterComponents] = map[string][]networkingv1.NetworkPolicyPort{}
 	if tempo.Spec.Template.Gateway.Enabled {
 		// Allow external access to Gateway HTTP and gRPC ports
@@ -312,6 +342,21 @@ func componentRelations(params manifestutils.Params) networkRelations {
 		// Gateway queries via Query Frontend
 		fromTo[manifestutils.GatewayComponentName][manifestutils.QueryFrontendComponentName] = grpcConn
 
+		if tempo.Spec.Template.Gateway.RateLimits != nil && tempo.Spec.Template.Gateway.RateLimits.Service.Enabled {
+			rateLimiterConn := []networkingv1.NetworkPolicyPort{
+				{
+					Protocol: ptr.To(corev1.ProtocolTCP),
+					Port:     ptr.To(intstr.FromInt(manifestutils.PortGRPCServer)),
+				},
+			}
+			// Gateway checks the rate limits of the requests with the rate limit service
+			fromTo[manifestutils.GatewayComponentName][manifestutils.GatewayRateLimiterComponentName] = rateLimiterConn
+			// The replicas of the rate limit service forward the rate limit checks to their peers
+			fromTo[manifestutils.GatewayRateLimiterComponentName] = map[string][]networkingv1.NetworkPolicyPort{
+				manifestutils.GatewayRateLimiterComponentName: rateLimiterConn,
+			}
+		}
+
 		// Gateway needs to access Kubernetes API server for TokenReview/SubjectAccessReview
 		// when using OpenShift RBAC mode for multi-tenancy
 		fromTo[manifestutils.GatewayComponentName][netPolicyKubeAPIServer] = kubeAPIServer
@@ -403,6 +448,16 @@ func componentRelations(params manifestutils.Params) networkRelations {
 				},
 			)
 		}
+
+		// kube-rbac-proxy needs to access Kubernetes API server for TokenReview/SubjectAccessReview
+		if !tempo.Spec.Template.Gateway.Enabled && tempo.Spec.Template.QueryFrontend.JaegerQuery.Authentication.IsKubeRBACProxy() {
+			fromTo[manifestutils.QueryFrontendComponentName][netPolicyKubeAPIServer] = kubeAPIServer
+
+			// oauth2-proxy and kube-rbac-proxy need to access the OIDC provider for the login and the validation of the ID tokens
+			if oidc := tempo.Spec.Template.QueryFrontend.JaegerQuery.Authentication.OIDC; oidc != nil {
+				fromTo[manifestutils.QueryFrontendComponentName][netPolicyOAuthServer] = oidcProviderPorts(oidc.IssuerURL)
+			}
+		}
 	}
 
 	if tempo.Spec.Template.MetricsGenerator.Enabled {
@@ -427,6 +482,23 @@ func componentRelations(params manifestutils.Params) networkRelations {
 	return fromTo
 }
 
+// oidcProviderPorts returns the port of the issuer URL of an OIDC provider, the default port is 443.
+func oidcProviderPorts(issuerURL string) []networkingv1.NetworkPolicyPort {
+	port := 443
+	if u, err := url.Parse(issuerURL); err == nil && u.Port() != "" {
+		if p, err := strconv.Atoi(u.Port()); err == nil {
+			port = p
+		}
+	}
+
+	return []networkingv1.NetworkPolicyPort{
+		{
+			Protocol: ptr.To(corev1.ProtocolTCP),
+			Port:     ptr.To(intstr.FromInt(port)),
+		},
+	}
+}
+
 func reverseRelations(rels map[string]map[string][]networkingv1.NetworkPolicyPort) map[string]map[string][]networkingv1.NetworkPolicyPort {
 	reverse := map[string]map[string][]networkingv1.NetworkPolicyPort{}
 
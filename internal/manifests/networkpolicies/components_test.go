@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -213,6 +214,66 @@ func TestOAuthServerEgress(t *testing.T) {
 	assert.Contains(t, np.Spec.PolicyTypes, networkingv1.PolicyTypeEgress)
 }
 
+func TestOTLPTracingEgress(t *testing.T) {
+	tests := []struct {
+		name         string
+		otlp         v1alpha1.OTLPTracingSpec
+		expectedPort int
+		expectedPeer networkingv1.NetworkPolicyPeer
+	}{
+		{
+			name:         "external receiver",
+			otlp:         v1alpha1.OTLPTracingSpec{Endpoint: "https://collector.observability.svc:14317"},
+			expectedPort: 14317,
+			expectedPeer: networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"}},
+		},
+		{
+			name:         "gateway",
+			otlp:         v1alpha1.OTLPTracingSpec{Gateway: &v1alpha1.OTLPTracingGatewaySpec{Tenant: "dev"}},
+			expectedPort: manifestutils.GatewayPortGRPCServer,
+			expectedPeer: networkingv1.NetworkPolicyPeer{
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: manifestutils.ComponentLabels(manifestutils.GatewayComponentName, "myinstance"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := manifestutils.Params{
+				Tempo: v1alpha1.TempoStack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myinstance",
+						Namespace: "something",
+					},
+					Spec: v1alpha1.TempoStackSpec{
+						Template: v1alpha1.TempoTemplateSpec{
+							Gateway: v1alpha1.TempoGatewaySpec{
+								Enabled: true,
+							},
+						},
+						Observability: v1alpha1.ObservabilitySpec{
+							Tracing: v1alpha1.TracingConfigSpec{
+								OTLP: &tc.otlp,
+							},
+						},
+					},
+				},
+			}
+
+			np := generatePolicyFor(params, manifestutils.CompactorComponentName)
+			assert.Contains(t, np.Spec.Egress, networkingv1.NetworkPolicyEgressRule{
+				Ports: []networkingv1.NetworkPolicyPort{{
+					Protocol: ptr.To(corev1.ProtocolTCP),
+					Port:     ptr.To(intstr.FromInt(tc.expectedPort)),
+				}},
+				To: []networkingv1.NetworkPolicyPeer{tc.expectedPeer},
+			})
+		})
+	}
+}
+
 func TestOAuthProxyPort(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -290,6 +351,108 @@ func TestOAuthProxyPort(t *testing.T) {
 	}
 }
 
+func TestKubeRBACProxyPolicy(t *testing.T) {
+	tempo := v1alpha1.TempoStack{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "myinstance",
+			Namespace: "something",
+		},
+		Spec: v1alpha1.TempoStackSpec{
+			Template: v1alpha1.TempoTemplateSpec{
+				QueryFrontend: v1alpha1.TempoQueryFrontendSpec{
+					JaegerQuery: v1alpha1.JaegerQuerySpec{
+						Enabled: true,
+						Authentication: &v1alpha1.JaegerQueryAuthenticationSpec{
+							Enabled:  true,
+							Provider: v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy,
+						},
+					},
+				},
+			},
+		},
+	}
+	apiServerPort := networkingv1.NetworkPolicyPort{
+		Protocol: ptr.To(corev1.ProtocolTCP),
+		Port:     ptr.To(intstr.FromInt(6443)),
+	}
+	params := manifestutils.Params{
+		Tempo: tempo,
+		KubeAPIServer: manifestutils.KubeAPIServerInfo{
+			Ports: []networkingv1.NetworkPolicyPort{apiServerPort},
+			IPs:   []string{"10.0.0.1"},
+		},
+	}
+
+	np := generatePolicyFor(params, manifestutils.QueryFrontendComponentName)
+	require.NotNil(t, np)
+	assert.Contains(t, np.Spec.Egress, networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{apiServerPort},
+		To:    []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.1/32"}}},
+	})
+}
+
+func TestKubeRBACProxyOIDCPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		issuerURL string
+		port      int
+	}{
+		{
+			name:      "default port",
+			issuerURL: "https://keycloak.example.com/realms/tempo",
+			port:      443,
+		},
+		{
+			name:      "custom port",
+			issuerURL: "https://keycloak.keycloak.svc:8443/realms/tempo",
+			port:      8443,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := manifestutils.Params{
+				Tempo: v1alpha1.TempoStack{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myinstance",
+						Namespace: "something",
+					},
+					Spec: v1alpha1.TempoStackSpec{
+						Template: v1alpha1.TempoTemplateSpec{
+							QueryFrontend: v1alpha1.TempoQueryFrontendSpec{
+								JaegerQuery: v1alpha1.JaegerQuerySpec{
+									Enabled: true,
+									Authentication: &v1alpha1.JaegerQueryAuthenticationSpec{
+										Enabled:  true,
+										Provider: v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy,
+										OIDC: &v1alpha1.JaegerUIOIDCSpec{
+											IssuerURL: tc.issuerURL,
+											Secret:    "oidc",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			np := generatePolicyFor(params, manifestutils.QueryFrontendComponentName)
+			require.NotNil(t, np)
+			assert.Contains(t, np.Spec.Egress, networkingv1.NetworkPolicyEgressRule{
+				Ports: []networkingv1.NetworkPolicyPort{{
+					Protocol: ptr.To(corev1.ProtocolTCP),
+					Port:     ptr.To(intstr.FromInt(tc.port)),
+				}},
+				To: []networkingv1.NetworkPolicyPeer{
+					{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"}},
+					{NamespaceSelector: &metav1.LabelSelector{}},
+				},
+			})
+		})
+	}
+}
+
 func TestJaegerQueryPorts(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -454,6 +617,55 @@ func TestGatewayPorts(t *testing.T) {
 	assert.Contains(t, np.Spec.PolicyTypes, networkingv1.PolicyTypeIngress)
 }
 
+func TestGatewayRateLimiterPolicy(t *testing.T) {
+	tempo := v1alpha1.TempoStack{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "myinstance",
+			Namespace: "something",
+		},
+		Spec: v1alpha1.TempoStackSpec{
+			Template: v1alpha1.TempoTemplateSpec{
+				Gateway: v1alpha1.TempoGatewaySpec{
+					Enabled: true,
+					RateLimits: &v1alpha1.GatewayRateLimitsSpec{
+						Service: v1alpha1.GatewayRateLimitServiceSpec{Enabled: true},
+					},
+				},
+			},
+		},
+	}
+	params := manifestutils.Params{Tempo: tempo}
+
+	grpcPort := networkingv1.NetworkPolicyPort{
+		Protocol: ptr.To(corev1.ProtocolTCP),
+		Port:     ptr.To(intstr.FromInt(manifestutils.PortGRPCServer)),
+	}
+	gatewayPeer := networkingv1.NetworkPolicyPeer{
+		PodSelector: &metav1.LabelSelector{MatchLabels: manifestutils.ComponentLabels(manifestutils.GatewayComponentName, tempo.Name)},
+	}
+	rateLimiterPeer := networkingv1.NetworkPolicyPeer{
+		PodSelector: &metav1.LabelSelector{MatchLabels: manifestutils.ComponentLabels(manifestutils.GatewayRateLimiterComponentName, tempo.Name)},
+	}
+
+	np := generatePolicyFor(params, manifestutils.GatewayRateLimiterComponentName)
+	require.NotNil(t, np)
+	assert.Equal(t, naming.Name(manifestutils.GatewayRateLimiterComponentName, tempo.Name), np.ObjectMeta.Name)
+	assert.Equal(t, []networkingv1.NetworkPolicyEgressRule{
+		{Ports: []networkingv1.NetworkPolicyPort{grpcPort}, To: []networkingv1.NetworkPolicyPeer{rateLimiterPeer}},
+	}, np.Spec.Egress)
+	assert.Equal(t, []networkingv1.NetworkPolicyIngressRule{
+		{Ports: []networkingv1.NetworkPolicyPort{grpcPort}, From: []networkingv1.NetworkPolicyPeer{gatewayPeer}},
+		{Ports: []networkingv1.NetworkPolicyPort{grpcPort}, From: []networkingv1.NetworkPolicyPeer{rateLimiterPeer}},
+	}, np.Spec.Ingress)
+
+	npGateway := generatePolicyFor(params, manifestutils.GatewayComponentName)
+	require.NotNil(t, npGateway)
+	assert.Contains(t, npGateway.Spec.Egress, networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{grpcPort},
+		To:    []networkingv1.NetworkPolicyPeer{rateLimiterPeer},
+	})
+}
+
 func TestMetricsGeneratorPolicy(t *testing.T) {
 	tempo := v1alpha1.TempoStack{
 		ObjectMeta: metav1.ObjectMeta{
@@ -19,6 +19,7 @@ func GenerateOperandPolicies(params manifestutils.Params) []client.Object {
 	policies := []client.Object{
 		policyTempoGossip(tempo.Name, tempo.Namespace, labels),
 		policyIngressToOperandMetrics(tempo.Name, tempo.Namespace, labels),
+		policyIngressFromOperator(tempo.Name, tempo.Namespace, labels),
 	}
 
 	// Add platform-specific DNS policy
@@ -36,6 +37,10 @@ func GenerateOperandPolicies(params manifestutils.Params) []client.Object {
 
 	if tempo.Spec.Template.Gateway.Enabled {
 		policies = append(policies, generatePolicyFor(params, manifestutils.GatewayComponentName))
+
+		if tempo.Spec.Template.Gateway.RateLimits != nil && tempo.Spec.Template.Gateway.RateLimits.Service.Enabled {
+			policies = append(policies, generatePolicyFor(params, manifestutils.GatewayRateLimiterComponentName))
+		}
 	}
 
 	if tempo.Spec.Template.MetricsGenerator.Enabled {
@@ -16,6 +16,7 @@ func GenerateOperatorPolicies(namespace string, apiServerInfo manifestutils.Kube
 		policyAPIServer(instanceName, namespace, apiServerInfo),
 		policyDenyAll(instanceName, namespace, labels),
 		policyIngressToMetrics(instanceName, namespace, labels),
+		policyEgressToOperands(instanceName, namespace),
 	}
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
 		objs = append(objs, policyWebhook(instanceName, namespace))
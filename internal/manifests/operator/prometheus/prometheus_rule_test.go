@@ -21,5 +21,10 @@ func TestPrometheusRule(t *testing.T) {
 			"openshift.io/prometheus-rule-evaluation-scope": "leaf-prometheus",
 		}),
 	}, prometheusrule.ObjectMeta)
-	assert.Len(t, prometheusrule.Spec.Groups[0].Rules, 5)
+	assert.Len(t, prometheusrule.Spec.Groups[0].Rules, 6)
+
+	notConverging := prometheusrule.Spec.Groups[0].Rules[4]
+	assert.Equal(t, "TempoOperatorInstanceNotConverging", notConverging.Alert)
+	assert.Contains(t, notConverging.Expr.String(), `increase(tempooperator_reconcile_duration_seconds_count{result="error"}[1h]) > 0`)
+	assert.Contains(t, notConverging.Annotations["message"], "{{ $labels.stack_namespace }}/{{ $labels.stack_name }}")
 }
@@ -0,0 +1,230 @@
+package overrides
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+const (
+	componentLabel = "app.kubernetes.io/component"
+	// checksumAnnotation is the annotation of the pod templates with the checksum of the patched ConfigMaps and Secrets.
+	checksumAnnotation = "tempo.grafana.com/overrides.hash"
+)
+
+// Apply patches the generated objects with the overrides of the instance.
+// Every override is applied to all matching objects, in the order of the overrides.
+// The checksum annotations of the pod templates are computed before the overrides are applied,
+// therefore the pods referencing a patched ConfigMap or Secret get an additional checksum annotation,
+// which restarts the pods if the patched data changes.
+func Apply(objects []client.Object, overrides []v1alpha1.ObjectOverrideSpec, path *field.Path) ([]client.Object, error) {
+	if len(overrides) == 0 {
+		return objects, nil
+	}
+	if errs := Validate(overrides, path); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	patched := make([]client.Object, 0, len(objects))
+	patchedData := map[string]any{}
+	for _, obj := range objects {
+		isPatched := false
+		for i, override := range overrides {
+			if !matches(obj, override.Target) {
+				continue
+			}
+
+			result, err := patch(obj, override)
+			if err != nil {
+				return nil, fmt.Errorf("failed to apply %s to %s %s: %w", path.Index(i), kind(obj), obj.GetName(), err)
+			}
+			obj = result
+			isPatched = true
+		}
+
+		if isPatched {
+			switch typed := obj.(type) {
+			case *corev1.ConfigMap:
+				patchedData[dataKey("ConfigMap", typed.Name)] = []any{typed.Data, typed.BinaryData}
+			case *corev1.Secret:
+				patchedData[dataKey("Secret", typed.Name)] = []any{typed.Data, typed.StringData}
+			}
+		}
+		patched = append(patched, obj)
+	}
+
+	if len(patchedData) > 0 {
+		for _, obj := range patched {
+			if err := annotateChecksum(obj, patchedData); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return patched, nil
+}
+
+func dataKey(kind string, name string) string {
+	return kind + "/" + name
+}
+
+func podTemplate(obj client.Object) *corev1.PodTemplateSpec {
+	switch typed := obj.(type) {
+	case *appsv1.Deployment:
+		return &typed.Spec.Template
+	case *appsv1.StatefulSet:
+		return &typed.Spec.Template
+	case *appsv1.DaemonSet:
+		return &typed.Spec.Template
+	}
+	return nil
+}
+
+// referencedData returns the keys of the ConfigMaps and Secrets referenced by the volumes and containers of a pod.
+func referencedData(spec corev1.PodSpec) []string {
+	var keys []string
+	for _, volume := range spec.Volumes {
+		if volume.ConfigMap != nil {
+			keys = append(keys, dataKey("ConfigMap", volume.ConfigMap.Name))
+		}
+		if volume.Secret != nil {
+			keys = append(keys, dataKey("Secret", volume.Secret.SecretName))
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					keys = append(keys, dataKey("ConfigMap", source.ConfigMap.Name))
+				}
+				if source.Secret != nil {
+					keys = append(keys, dataKey("Secret", source.Secret.Name))
+				}
+			}
+		}
+	}
+
+	for _, container := range slices.Concat(spec.InitContainers, spec.Containers) {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				keys = append(keys, dataKey("ConfigMap", envFrom.ConfigMapRef.Name))
+			}
+			if envFrom.SecretRef != nil {
+				keys = append(keys, dataKey("Secret", envFrom.SecretRef.Name))
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				keys = append(keys, dataKey("ConfigMap", env.ValueFrom.ConfigMapKeyRef.Name))
+			}
+			if env.ValueFrom.SecretKeyRef != nil {
+				keys = append(keys, dataKey("Secret", env.ValueFrom.SecretKeyRef.Name))
+			}
+		}
+	}
+
+	slices.Sort(keys)
+	return slices.Compact(keys)
+}
+
+// annotateChecksum sets the checksum of the patched ConfigMaps and Secrets referenced by the pod template of a workload.
+func annotateChecksum(obj client.Object, patchedData map[string]any) error {
+	template := podTemplate(obj)
+	if template == nil {
+		return nil
+	}
+
+	h := sha256.New()
+	referenced := false
+	for _, key := range referencedData(template.Spec) {
+		data, ok := patchedData[key]
+		if !ok {
+			continue
+		}
+		content, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		referenced = true
+		h.Write([]byte(key))
+		h.Write(content)
+	}
+	if !referenced {
+		return nil
+	}
+
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[checksumAnnotation] = fmt.Sprintf("%x", h.Sum(nil))
+	return nil
+}
+
+// kind returns the kind of a generated object.
+// The generated objects are typed objects and mostly do not set the TypeMeta,
+// therefore the kind is derived from the name of the Go type.
+func kind(obj client.Object) string {
+	if k := obj.GetObjectKind().GroupVersionKind().Kind; k != "" {
+		return k
+	}
+	return reflect.TypeOf(obj).Elem().Name()
+}
+
+func matches(obj client.Object, target v1alpha1.ObjectOverrideTarget) bool {
+	if target.Kind != "" && target.Kind != kind(obj) {
+		return false
+	}
+	if target.Name != "" && target.Name != obj.GetName() {
+		return false
+	}
+	if target.Component != "" && target.Component != obj.GetLabels()[componentLabel] {
+		return false
+	}
+	return true
+}
+
+func patch(obj client.Object, override v1alpha1.ObjectOverrideSpec) (client.Object, error) {
+	original, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var patched []byte
+	switch override.Type {
+	case v1alpha1.ObjectOverridePatchTypeJSON:
+		jsonPatch, err := jsonpatch.DecodePatch(override.Patch.Raw)
+		if err != nil {
+			return nil, err
+		}
+		patched, err = jsonPatch.Apply(original)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		patched, err = strategicpatch.StrategicMergePatch(original, override.Patch.Raw, obj)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Unknown fields are rejected, otherwise a typo in the patch would be silently ignored.
+	result := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(client.Object)
+	decoder := json.NewDecoder(bytes.NewReader(patched))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
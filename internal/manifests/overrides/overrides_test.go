@@ -0,0 +1,209 @@
+package overrides
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+func generatedObjects() []client.Object {
+	return []client.Object{
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "tempo-simplest-compactor",
+				Labels: map[string]string{componentLabel: "compactor"},
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Name: "http", Port: 3200}},
+			},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "tempo-simplest-compactor",
+				Labels: map[string]string{componentLabel: "compactor"},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{"tempo.grafana.com/config.hash": "abc"},
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "tempo", Image: "tempo-image"}},
+						Volumes: []corev1.Volume{{
+							Name: "tempo-conf",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "tempo-simplest"},
+								},
+							},
+						}},
+					},
+				},
+			},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "tempo-simplest-distributor",
+				Labels: map[string]string{componentLabel: "distributor"},
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "tempo-simplest",
+				Labels: map[string]string{componentLabel: "config"},
+			},
+			Data: map[string]string{"tempo.yaml": "server: {}"},
+		},
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides []v1alpha1.ObjectOverrideSpec
+		verify    func(t *testing.T, objects []client.Object)
+		err       string
+	}{
+		{
+			name: "no overrides",
+			verify: func(t *testing.T, objects []client.Object) {
+				assert.Equal(t, generatedObjects(), objects)
+			},
+		},
+		{
+			name: "strategic merge patch of one service",
+			overrides: []v1alpha1.ObjectOverrideSpec{{
+				Target: v1alpha1.ObjectOverrideTarget{Kind: "Service", Name: "tempo-simplest-compactor"},
+				Patch: apiextensionsv1.JSON{Raw: []byte(`{
+					"metadata": {"annotations": {"service.beta.kubernetes.io/aws-load-balancer-internal": "true"}},
+					"spec": {"ports": [{"name": "extra", "port": 9999}]}
+				}`)},
+			}},
+			verify: func(t *testing.T, objects []client.Object) {
+				service := objects[0].(*corev1.Service)
+				assert.Equal(t, map[string]string{"service.beta.kubernetes.io/aws-load-balancer-internal": "true"}, service.Annotations)
+				assert.Equal(t, []corev1.ServicePort{{Name: "extra", Port: 9999}, {Name: "http", Port: 3200}}, service.Spec.Ports)
+
+				assert.Empty(t, objects[1].GetAnnotations())
+				assert.Empty(t, objects[2].GetAnnotations())
+			},
+		},
+		{
+			name: "JSON patch of a component",
+			overrides: []v1alpha1.ObjectOverrideSpec{{
+				Target: v1alpha1.ObjectOverrideTarget{Kind: "Deployment", Component: "compactor"},
+				Type:   v1alpha1.ObjectOverridePatchTypeJSON,
+				Patch:  apiextensionsv1.JSON{Raw: []byte(`[{"op": "add", "path": "/spec/template/spec/containers/-", "value": {"name": "sidecar", "image": "sidecar-image"}}]`)},
+			}},
+			verify: func(t *testing.T, objects []client.Object) {
+				deployment := objects[1].(*appsv1.Deployment)
+				assert.Equal(t, []corev1.Container{
+					{Name: "tempo", Image: "tempo-image"},
+					{Name: "sidecar", Image: "sidecar-image"},
+				}, deployment.Spec.Template.Spec.Containers)
+			},
+		},
+		{
+			name: "overrides are applied in order",
+			overrides: []v1alpha1.ObjectOverrideSpec{
+				{
+					Target: v1alpha1.ObjectOverrideTarget{Kind: "Service"},
+					Patch:  apiextensionsv1.JSON{Raw: []byte(`{"metadata": {"annotations": {"team": "a"}}}`)},
+				},
+				{
+					Target: v1alpha1.ObjectOverrideTarget{Component: "distributor"},
+					Patch:  apiextensionsv1.JSON{Raw: []byte(`{"metadata": {"annotations": {"team": "b"}}}`)},
+				},
+			},
+			verify: func(t *testing.T, objects []client.Object) {
+				assert.Equal(t, map[string]string{"team": "a"}, objects[0].GetAnnotations())
+				assert.Empty(t, objects[1].GetAnnotations())
+				assert.Equal(t, map[string]string{"team": "b"}, objects[2].GetAnnotations())
+			},
+		},
+		{
+			name: "patch of a ConfigMap",
+			overrides: []v1alpha1.ObjectOverrideSpec{{
+				Target: v1alpha1.ObjectOverrideTarget{Kind: "ConfigMap", Name: "tempo-simplest"},
+				Patch:  apiextensionsv1.JSON{Raw: []byte(`{"data": {"tempo.yaml": "server: {log_level: debug}"}}`)},
+			}},
+			verify: func(t *testing.T, objects []client.Object) {
+				assert.Equal(t, "server: {log_level: debug}", objects[3].(*corev1.ConfigMap).Data["tempo.yaml"])
+
+				// the pods referencing the patched ConfigMap are restarted if the patch changes
+				annotations := objects[1].(*appsv1.Deployment).Spec.Template.Annotations
+				assert.Equal(t, "abc", annotations["tempo.grafana.com/config.hash"])
+				assert.NotEmpty(t, annotations[checksumAnnotation])
+
+				other, err := Apply(generatedObjects(), []v1alpha1.ObjectOverrideSpec{{
+					Target: v1alpha1.ObjectOverrideTarget{Kind: "ConfigMap", Name: "tempo-simplest"},
+					Patch:  apiextensionsv1.JSON{Raw: []byte(`{"data": {"tempo.yaml": "server: {log_level: warn}"}}`)},
+				}}, field.NewPath("spec", "overrides"))
+				require.NoError(t, err)
+				assert.NotEqual(t, annotations[checksumAnnotation], other[1].(*appsv1.Deployment).Spec.Template.Annotations[checksumAnnotation])
+			},
+		},
+		{
+			name: "patch of a ConfigMap which is not referenced by a pod",
+			overrides: []v1alpha1.ObjectOverrideSpec{{
+				Target: v1alpha1.ObjectOverrideTarget{Kind: "Deployment"},
+				Type:   v1alpha1.ObjectOverridePatchTypeJSON,
+				Patch:  apiextensionsv1.JSON{Raw: []byte(`[{"op": "remove", "path": "/spec/template/spec/volumes/0"}]`)},
+			}, {
+				Target: v1alpha1.ObjectOverrideTarget{Kind: "ConfigMap"},
+				Patch:  apiextensionsv1.JSON{Raw: []byte(`{"data": {"tempo.yaml": "server: {log_level: debug}"}}`)},
+			}},
+			verify: func(t *testing.T, objects []client.Object) {
+				assert.Equal(t, map[string]string{"tempo.grafana.com/config.hash": "abc"}, objects[1].(*appsv1.Deployment).Spec.Template.Annotations)
+			},
+		},
+		{
+			name: "no matching object",
+			overrides: []v1alpha1.ObjectOverrideSpec{{
+				Target: v1alpha1.ObjectOverrideTarget{Kind: "Ingress"},
+				Patch:  apiextensionsv1.JSON{Raw: []byte(`{"metadata": {"annotations": {"team": "a"}}}`)},
+			}},
+			verify: func(t *testing.T, objects []client.Object) {
+				assert.Equal(t, generatedObjects(), objects)
+			},
+		},
+		{
+			name: "unknown field",
+			overrides: []v1alpha1.ObjectOverrideSpec{{
+				Target: v1alpha1.ObjectOverrideTarget{Kind: "Deployment"},
+				Type:   v1alpha1.ObjectOverridePatchTypeJSON,
+				Patch:  apiextensionsv1.JSON{Raw: []byte(`[{"op": "add", "path": "/spec/replica", "value": 2}]`)},
+			}},
+			err: `failed to apply spec.overrides[0] to Deployment tempo-simplest-compactor: json: unknown field "replica"`,
+		},
+		{
+			name: "patch of an immutable field",
+			overrides: []v1alpha1.ObjectOverrideSpec{{
+				Target: v1alpha1.ObjectOverrideTarget{Kind: "Deployment"},
+				Patch:  apiextensionsv1.JSON{Raw: []byte(`{"spec": {"selector": {"matchLabels": {"app": "tempo"}}}}`)},
+			}},
+			err: "spec.overrides[0].patch: Forbidden: the field spec.selector must not be patched",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			objects, err := Apply(generatedObjects(), tc.overrides, field.NewPath("spec", "overrides"))
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			tc.verify(t, objects)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package overrides
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+// protectedFields are the fields which must not be patched: the identity of the objects,
+// selectors and pod labels (changing them orphans the existing pods or breaks the selectors of the Services)
+// and immutable fields of StatefulSets and Services.
+var protectedFields = [][]string{
+	{"apiVersion"},
+	{"kind"},
+	{"metadata", "name"},
+	{"metadata", "namespace"},
+	{"metadata", "ownerReferences"},
+	{"spec", "selector"},
+	{"spec", "template", "metadata", "labels"},
+	{"spec", "serviceName"},
+	{"spec", "podManagementPolicy"},
+	{"spec", "volumeClaimTemplates"},
+	{"spec", "clusterIP"},
+	{"spec", "clusterIPs"},
+}
+
+// Validate validates the overrides of an instance.
+func Validate(overrides []v1alpha1.ObjectOverrideSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, override := range overrides {
+		overridePath := path.Index(i)
+		if override.Target == (v1alpha1.ObjectOverrideTarget{}) {
+			errs = append(errs, field.Required(overridePath.Child("target"), "at least one of kind, name or component must be set"))
+		}
+
+		patchPath := overridePath.Child("patch")
+		if len(override.Patch.Raw) == 0 {
+			errs = append(errs, field.Required(patchPath, "the patch must be set"))
+			continue
+		}
+
+		switch override.Type {
+		case v1alpha1.ObjectOverridePatchTypeJSON:
+			errs = append(errs, validateJSONPatch(override.Patch.Raw, patchPath)...)
+		default:
+			errs = append(errs, validateStrategicMergePatch(override.Patch.Raw, patchPath)...)
+		}
+	}
+	return errs
+}
+
+func validateStrategicMergePatch(raw []byte, path *field.Path) field.ErrorList {
+	var patch map[string]interface{}
+	if err := json.Unmarshal(raw, &patch); err != nil {
+		return field.ErrorList{field.Invalid(path, string(raw), fmt.Sprintf("a strategic merge patch must be an object: %v", err))}
+	}
+
+	var errs field.ErrorList
+	for _, protected := range protectedFields {
+		if touchesProtectedField(patch, protected) {
+			errs = append(errs, protectedFieldError(path, protected))
+		}
+	}
+	return errs
+}
+
+// touchesProtectedField returns true if the strategic merge patch sets the protected field,
+// or replaces or deletes one of its parents with a $patch directive.
+func touchesProtectedField(patch map[string]interface{}, protected []string) bool {
+	current := patch
+	for i, key := range protected {
+		if _, ok := current["$patch"]; ok {
+			return true
+		}
+		value, ok := current[key]
+		if !ok {
+			return false
+		}
+		if i == len(protected)-1 {
+			return true
+		}
+		next, ok := value.(map[string]interface{})
+		if !ok {
+			// e.g. "spec": null removes the whole spec
+			return true
+		}
+		current = next
+	}
+	return false
+}
+
+func validateJSONPatch(raw []byte, path *field.Path) field.ErrorList {
+	// DecodePatch also validates the operations and their paths.
+	patch, err := jsonpatch.DecodePatch(raw)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, string(raw), fmt.Sprintf("a JSON patch must be a list of operations: %v", err))}
+	}
+
+	var errs field.ErrorList
+	for i, operation := range patch {
+		// test operations do not modify the object
+		if operation.Kind() == "test" {
+			continue
+		}
+
+		pointer, _ := operation.Path()
+		pointers := []string{pointer}
+		// move removes the source field
+		if operation.Kind() == "move" {
+			from, _ := operation.From()
+			pointers = append(pointers, from)
+		}
+
+		for _, pointer := range pointers {
+			segments := parsePointer(pointer)
+			for _, protected := range protectedFields {
+				if isPrefix(segments, protected) || isPrefix(protected, segments) {
+					errs = append(errs, protectedFieldError(path.Index(i), protected))
+					break
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// parsePointer splits a JSON pointer (RFC 6901) into its unescaped segments.
+func parsePointer(pointer string) []string {
+	if pointer == "" {
+		return nil
+	}
+	segments := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+	}
+	return segments
+}
+
+func isPrefix(prefix []string, segments []string) bool {
+	return len(prefix) <= len(segments) && slices.Equal(prefix, segments[:len(prefix)])
+}
+
+func protectedFieldError(path *field.Path, protected []string) *field.Error {
+	return field.Forbidden(path, fmt.Sprintf("the field %s must not be patched", strings.Join(protected, ".")))
+}
@@ -0,0 +1,137 @@
+package overrides
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+func TestValidate(t *testing.T) {
+	path := field.NewPath("spec", "overrides")
+	target := v1alpha1.ObjectOverrideTarget{Kind: "StatefulSet"}
+
+	tests := []struct {
+		name     string
+		override v1alpha1.ObjectOverrideSpec
+		expected field.ErrorList
+	}{
+		{
+			name: "valid strategic merge patch",
+			override: v1alpha1.ObjectOverrideSpec{
+				Target: target,
+				Patch:  apiextensionsv1.JSON{Raw: []byte(`{"spec": {"template": {"metadata": {"annotations": {"sidecar.istio.io/inject": "true"}}}}}`)},
+			},
+		},
+		{
+			name: "valid JSON patch",
+			override: v1alpha1.ObjectOverrideSpec{
+				Target: target,
+				Type:   v1alpha1.ObjectOverridePatchTypeJSON,
+				Patch: apiextensionsv1.JSON{Raw: []byte(`[
+					{"op": "test", "path": "/spec/selector/matchLabels/app", "value": "tempo"},
+					{"op": "replace", "path": "/spec/replicas", "value": 2}
+				]`)},
+			},
+		},
+		{
+			name: "missing target and patch",
+			override: v1alpha1.ObjectOverrideSpec{
+				Type: v1alpha1.ObjectOverridePatchTypeStrategic,
+			},
+			expected: field.ErrorList{
+				field.Required(path.Index(0).Child("target"), "at least one of kind, name or component must be set"),
+				field.Required(path.Index(0).Child("patch"), "the patch must be set"),
+			},
+		},
+		{
+			name: "strategic merge patch is not an object",
+			override: v1alpha1.ObjectOverrideSpec{
+				Target: target,
+				Patch:  apiextensionsv1.JSON{Raw: []byte(`[{"op": "remove", "path": "/spec/replicas"}]`)},
+			},
+			expected: field.ErrorList{
+				field.Invalid(path.Index(0).Child("patch"), `[{"op": "remove", "path": "/spec/replicas"}]`, "a strategic merge patch must be an object: json: cannot unmarshal array into Go value of type map[string]interface {}"),
+			},
+		},
+		{
+			name: "strategic merge patch of protected fields",
+			override: v1alpha1.ObjectOverrideSpec{
+				Target: target,
+				Patch:  apiextensionsv1.JSON{Raw: []byte(`{"metadata": {"name": "other"}, "spec": {"selector": {"matchLabels": {"app": "tempo"}}, "volumeClaimTemplates": []}}`)},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(path.Index(0).Child("patch"), "the field metadata.name must not be patched"),
+				field.Forbidden(path.Index(0).Child("patch"), "the field spec.selector must not be patched"),
+				field.Forbidden(path.Index(0).Child("patch"), "the field spec.volumeClaimTemplates must not be patched"),
+			},
+		},
+		{
+			name: "strategic merge patch replacing the spec",
+			override: v1alpha1.ObjectOverrideSpec{
+				Target: target,
+				Patch:  apiextensionsv1.JSON{Raw: []byte(`{"spec": {"$patch": "replace", "replicas": 1}}`)},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(path.Index(0).Child("patch"), "the field spec.selector must not be patched"),
+				field.Forbidden(path.Index(0).Child("patch"), "the field spec.template.metadata.labels must not be patched"),
+				field.Forbidden(path.Index(0).Child("patch"), "the field spec.serviceName must not be patched"),
+				field.Forbidden(path.Index(0).Child("patch"), "the field spec.podManagementPolicy must not be patched"),
+				field.Forbidden(path.Index(0).Child("patch"), "the field spec.volumeClaimTemplates must not be patched"),
+				field.Forbidden(path.Index(0).Child("patch"), "the field spec.clusterIP must not be patched"),
+				field.Forbidden(path.Index(0).Child("patch"), "the field spec.clusterIPs must not be patched"),
+			},
+		},
+		{
+			name: "patch of the pod labels",
+			override: v1alpha1.ObjectOverrideSpec{
+				Target: target,
+				Patch:  apiextensionsv1.JSON{Raw: []byte(`{"spec": {"template": {"metadata": {"labels": {"team": "a"}, "annotations": {"team": "a"}}}}}`)},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(path.Index(0).Child("patch"), "the field spec.template.metadata.labels must not be patched"),
+			},
+		},
+		{
+			name: "JSON patch of protected fields",
+			override: v1alpha1.ObjectOverrideSpec{
+				Target: target,
+				Type:   v1alpha1.ObjectOverridePatchTypeJSON,
+				Patch: apiextensionsv1.JSON{Raw: []byte(`[
+					{"op": "add", "path": "/spec/selector/matchLabels/app", "value": "tempo"},
+					{"op": "remove", "path": "/spec"},
+					{"op": "move", "from": "/metadata/namespace", "path": "/metadata/annotations/namespace"},
+					{"op": "add", "path": "/spec/template/metadata/labels/team", "value": "a"}
+				]`)},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(path.Index(0).Child("patch").Index(0), "the field spec.selector must not be patched"),
+				field.Forbidden(path.Index(0).Child("patch").Index(1), "the field spec.selector must not be patched"),
+				field.Forbidden(path.Index(0).Child("patch").Index(2), "the field metadata.namespace must not be patched"),
+				field.Forbidden(path.Index(0).Child("patch").Index(3), "the field spec.template.metadata.labels must not be patched"),
+			},
+		},
+		{
+			name: "invalid JSON patch",
+			override: v1alpha1.ObjectOverrideSpec{
+				Target: target,
+				Type:   v1alpha1.ObjectOverridePatchTypeJSON,
+				Patch:  apiextensionsv1.JSON{Raw: []byte(`[{"op": "merge", "path": "/spec"}, {"op": "add", "value": 1}]`)},
+			},
+			expected: field.ErrorList{
+				field.Invalid(path.Index(0).Child("patch"), `[{"op": "merge", "path": "/spec"}, {"op": "add", "value": 1}]`,
+					`a JSON patch must be a list of operations: invalid operation {"op":"merge","path":"/spec"}: unsupported operation`),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := Validate([]v1alpha1.ObjectOverrideSpec{tc.override}, path)
+			assert.Equal(t, tc.expected, errs)
+		})
+	}
+}
@@ -26,7 +26,7 @@ func BuildQuerier(params manifestutils.Params) ([]client.Object, error) {
 		return nil, err
 	}
 
-	d.Spec.Template, err = manifestutils.PatchTracingEnvConfiguration(params.Tempo, d.Spec.Template)
+	d.Spec.Template, err = manifestutils.PatchTracingEnvConfiguration(params, d.Spec.Template)
 	if err != nil {
 		return nil, err
 	}
@@ -50,6 +50,7 @@ func BuildQuerier(params manifestutils.Params) ([]client.Object, error) {
 	}
 
 	manifestutils.PatchEnvVars(&d.Spec.Template.Spec, "tempo", tempo.Spec.Env, tempo.Spec.EnvFrom)
+	manifestutils.PatchExtraArgs(&d.Spec.Template.Spec, "tempo", tempo.Spec.Template.Querier.ExtraArgs)
 
 	return []client.Object{
 		d,
@@ -68,7 +69,7 @@ func resources(tempo v1alpha1.TempoStack) corev1.ResourceRequirements {
 func deployment(params manifestutils.Params) (*v1.Deployment, error) {
 	tempo := params.Tempo
 	labels := manifestutils.ComponentLabels(manifestutils.QuerierComponentName, tempo.Name)
-	annotations := manifestutils.CommonAnnotations(params)
+	annotations := manifestutils.ComponentAnnotations(params, manifestutils.QuerierComponentName)
 	cfg := tempo.Spec.Template.Querier
 	image := tempo.Spec.Images.Tempo
 	if image == "" {
@@ -106,7 +107,7 @@ func deployment(params manifestutils.Params) (*v1.Deployment, error) {
 							Env:   proxy.ReadProxyVarsFromEnv(),
 							Args: []string{
 								"-target=querier",
-								"-config.file=/conf/tempo.yaml",
+								"-config.file=" + manifestutils.TempoConfigFile(params, manifestutils.QuerierComponentName),
 								"-log.level=info",
 								"-config.expand-env=true",
 							},
@@ -129,6 +130,7 @@ func deployment(params manifestutils.Params) (*v1.Deployment, error) {
 									MountPath: "/conf",
 									ReadOnly:  true,
 								},
+								manifestutils.TenantOverridesVolumeMount(),
 								{
 									Name:      manifestutils.TmpStorageVolumeName,
 									MountPath: manifestutils.TmpTempoStoragePath,
@@ -149,6 +151,7 @@ func deployment(params manifestutils.Params) (*v1.Deployment, error) {
 								},
 							},
 						},
+						manifestutils.TenantOverridesVolume(tempo.Name),
 						{
 							Name: manifestutils.TmpStorageVolumeName,
 							VolumeSource: corev1.VolumeSource{
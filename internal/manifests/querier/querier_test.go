@@ -137,6 +137,11 @@ func TestBuildQuerier(t *testing.T) {
 									MountPath: "/conf",
 									ReadOnly:  true,
 								},
+								{
+									Name:      manifestutils.TenantOverridesVolumeName,
+									MountPath: manifestutils.TenantOverridesDir,
+									ReadOnly:  true,
+								},
 								{
 									Name:      manifestutils.TmpStorageVolumeName,
 									MountPath: manifestutils.TmpTempoStoragePath,
@@ -189,6 +194,16 @@ func TestBuildQuerier(t *testing.T) {
 								},
 							},
 						},
+						{
+							Name: manifestutils.TenantOverridesVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "tempo-test-overrides",
+									},
+								},
+							},
+						},
 						{
 							Name: manifestutils.TmpStorageVolumeName,
 							VolumeSource: corev1.VolumeSource{
@@ -241,3 +256,38 @@ func TestOverrideResources(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, dep.Spec.Template.Spec.Containers[0].Resources, overrideResources)
 }
+
+func TestComponentConfigAndExtraArgs(t *testing.T) {
+	objects, err := BuildQuerier(manifestutils.Params{
+		ConfigChecksum: "shared",
+		ComponentConfigChecksums: map[string]string{
+			manifestutils.QuerierComponentName: "querier",
+		},
+		Tempo: v1alpha1.TempoStack{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "project1",
+			},
+			Spec: v1alpha1.TempoStackSpec{
+				Template: v1alpha1.TempoTemplateSpec{
+					Querier: v1alpha1.TempoComponentSpec{
+						Replicas:  ptr.To(int32(1)),
+						ExtraArgs: []string{"-log.level=debug"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	dep, ok := objects[0].(*v1.Deployment)
+	require.True(t, ok)
+
+	assert.Equal(t, "querier", dep.Spec.Template.Annotations["tempo.grafana.com/config.hash"])
+	assert.Equal(t, []string{
+		"-target=querier",
+		"-config.file=/conf/tempo-querier.yaml",
+		"-log.level=info",
+		"-config.expand-env=true",
+		"-log.level=debug",
+	}, dep.Spec.Template.Spec.Containers[0].Args)
+}
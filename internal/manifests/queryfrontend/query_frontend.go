@@ -18,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/kuberbacproxy"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/manifests/memberlist"
 	"github.com/grafana/tempo-operator/internal/manifests/naming"
@@ -51,7 +52,7 @@ func BuildQueryFrontend(params manifestutils.Params) ([]client.Object, error) {
 		return nil, err
 	}
 
-	d.Spec.Template, err = manifestutils.PatchTracingEnvConfiguration(params.Tempo, d.Spec.Template)
+	d.Spec.Template, err = manifestutils.PatchTracingEnvConfiguration(params, d.Spec.Template)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +80,7 @@ func BuildQueryFrontend(params manifestutils.Params) ([]client.Object, error) {
 		manifestutils.SetGoMemLimit(target, &d.Spec.Template.Spec)
 	}
 	manifestutils.PatchEnvVars(&d.Spec.Template.Spec, containerNameTempo, tempo.Spec.Env, tempo.Spec.EnvFrom)
+	manifestutils.PatchExtraArgs(&d.Spec.Template.Spec, containerNameTempo, tempo.Spec.Template.QueryFrontend.ExtraArgs)
 
 	svcs := services(params)
 	for _, s := range svcs {
@@ -86,19 +88,29 @@ func BuildQueryFrontend(params manifestutils.Params) ([]client.Object, error) {
 	}
 
 	if !tempo.Spec.Template.Gateway.Enabled {
+		jaegerUIAuthentication := tempo.Spec.Template.QueryFrontend.JaegerQuery.Authentication
+
+		if usesKubeRBACProxy(tempo) {
+			objs, err := patchKubeRBACProxy(params, d, getQueryFrontendService(tempo, svcs))
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, objs...)
+		}
+
 		//exhaustive:ignore
 		switch tempo.Spec.Template.QueryFrontend.JaegerQuery.Ingress.Type {
 		case v1alpha1.IngressTypeIngress:
-			manifests = append(manifests, ingress(tempo))
+			manifests = append(manifests, ingress(tempo, gates.OpenShift.ServingCertsService))
 		case v1alpha1.IngressTypeRoute:
 			routeObj, err := route(tempo)
 			if err != nil {
 				return nil, err
 			}
 
-			jaegerUIAuthentication := tempo.Spec.Template.QueryFrontend.JaegerQuery.Authentication
-
-			if jaegerUIAuthentication != nil && jaegerUIAuthentication.Enabled {
+			if usesKubeRBACProxy(tempo) {
+				oauthproxy.PatchRouteForOauthProxy(routeObj)
+			} else if jaegerUIAuthentication != nil && jaegerUIAuthentication.Enabled {
 				defaultOauthProxyResources := manifestutils.Resources(tempo, manifestutils.QueryFrontendOauthProxyComponentName, tempo.Spec.Template.QueryFrontend.Replicas)
 
 				oauthproxy.PatchDeploymentForOauthProxy(
@@ -166,7 +178,7 @@ func jaegerQueryResources(tempo v1alpha1.TempoStack) corev1.ResourceRequirements
 func deployment(params manifestutils.Params) (*appsv1.Deployment, error) {
 	tempo := params.Tempo
 	labels := manifestutils.ComponentLabels(manifestutils.QueryFrontendComponentName, tempo.Name)
-	annotations := manifestutils.CommonAnnotations(params)
+	annotations := manifestutils.ComponentAnnotations(params, manifestutils.QueryFrontendComponentName)
 	cfg := tempo.Spec.Template.QueryFrontend
 	tempoImage := tempo.Spec.Images.Tempo
 	if tempoImage == "" {
@@ -237,6 +249,7 @@ func deployment(params manifestutils.Params) (*appsv1.Deployment, error) {
 									MountPath: "/conf",
 									ReadOnly:  true,
 								},
+								manifestutils.TenantOverridesVolumeMount(),
 								{
 									Name:      manifestutils.TmpStorageVolumeName,
 									MountPath: manifestutils.TmpTempoStoragePath,
@@ -257,6 +270,7 @@ func deployment(params manifestutils.Params) (*appsv1.Deployment, error) {
 								},
 							},
 						},
+						manifestutils.TenantOverridesVolume(tempo.Name),
 						{
 							Name: manifestutils.TmpStorageVolumeName,
 							VolumeSource: corev1.VolumeSource{
@@ -581,7 +595,7 @@ func services(params manifestutils.Params) []*corev1.Service {
 	return []*corev1.Service{frontEndService, frontEndDiscoveryService}
 }
 
-func ingress(tempo v1alpha1.TempoStack) *networkingv1.Ingress {
+func ingress(tempo v1alpha1.TempoStack, servingCerts bool) *networkingv1.Ingress {
 	queryFrontendName := naming.Name(manifestutils.QueryFrontendComponentName, tempo.Name)
 	labels := manifestutils.ComponentLabels(manifestutils.QueryFrontendComponentName, tempo.Name)
 
@@ -597,11 +611,18 @@ func ingress(tempo v1alpha1.TempoStack) *networkingv1.Ingress {
 		},
 	}
 
+	portName := manifestutils.JaegerUIPortName
+	if usesKubeRBACProxy(tempo) {
+		portName = manifestutils.OAuthProxyPortName
+		ingress.Annotations = kuberbacproxy.IngressAnnotations(ingress.Annotations,
+			tempo.Spec.Template.QueryFrontend.JaegerQuery.Authentication, servingCerts)
+	}
+
 	backend := networkingv1.IngressBackend{
 		Service: &networkingv1.IngressServiceBackend{
 			Name: queryFrontendName,
 			Port: networkingv1.ServiceBackendPort{
-				Name: manifestutils.JaegerUIPortName,
+				Name: portName,
 			},
 		},
 	}
@@ -679,3 +700,44 @@ func route(tempo v1alpha1.TempoStack) (*routev1.Route, error) {
 		},
 	}, nil
 }
+
+// usesKubeRBACProxy returns true if the Jaeger UI is protected by kube-rbac-proxy.
+func usesKubeRBACProxy(tempo v1alpha1.TempoStack) bool {
+	return !tempo.Spec.Template.Gateway.Enabled &&
+		tempo.Spec.Template.QueryFrontend.JaegerQuery.Enabled &&
+		tempo.Spec.Template.QueryFrontend.JaegerQuery.Authentication.IsKubeRBACProxy()
+}
+
+// patchKubeRBACProxy adds the kube-rbac-proxy sidecar, which authenticates and authorizes
+// the requests to the Jaeger UI with the Kubernetes API.
+func patchKubeRBACProxy(params manifestutils.Params, d *appsv1.Deployment, service *corev1.Service) ([]client.Object, error) {
+	tempo := params.Tempo
+	servingCerts := params.CtrlConfig.Gates.OpenShift.ServingCertsService
+
+	image := tempo.Spec.Images.KubeRBACProxy
+	if image == "" {
+		image = params.CtrlConfig.DefaultImages.KubeRBACProxy
+	}
+	oauth2ProxyImage := tempo.Spec.Images.OAuth2Proxy
+	if oauth2ProxyImage == "" {
+		oauth2ProxyImage = params.CtrlConfig.DefaultImages.OAuth2Proxy
+	}
+
+	objs, err := kuberbacproxy.PatchPodTemplate(kuberbacproxy.Options{
+		Tempo:               tempo.ObjectMeta,
+		Component:           manifestutils.QueryFrontendComponentName,
+		Labels:              manifestutils.ComponentLabels(manifestutils.QueryFrontendComponentName, tempo.Name),
+		ClusterScopedLabels: manifestutils.ClusterScopedComponentLabels(tempo.ObjectMeta, manifestutils.QueryFrontendComponentName),
+		AuthSpec:            tempo.Spec.Template.QueryFrontend.JaegerQuery.Authentication,
+		Image:               image,
+		OAuth2ProxyImage:    oauth2ProxyImage,
+		ServingCerts:        servingCerts,
+		Resources:           manifestutils.Resources(tempo, manifestutils.QueryFrontendOauthProxyComponentName, tempo.Spec.Template.QueryFrontend.Replicas),
+	}, &d.Spec.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	kuberbacproxy.PatchService(service, tempo.Name, servingCerts)
+	return objs, nil
+}
@@ -180,6 +180,11 @@ func getExpectedDeployment(withJaeger bool) *v1.Deployment {
 									MountPath: "/conf",
 									ReadOnly:  true,
 								},
+								{
+									Name:      manifestutils.TenantOverridesVolumeName,
+									MountPath: manifestutils.TenantOverridesDir,
+									ReadOnly:  true,
+								},
 								{
 									Name:      manifestutils.TmpStorageVolumeName,
 									MountPath: manifestutils.TmpTempoStoragePath,
@@ -209,6 +214,16 @@ func getExpectedDeployment(withJaeger bool) *v1.Deployment {
 								},
 							},
 						},
+						{
+							Name: manifestutils.TenantOverridesVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "tempo-test-overrides",
+									},
+								},
+							},
+						},
 						{
 							Name: manifestutils.TmpStorageVolumeName,
 							VolumeSource: corev1.VolumeSource{
@@ -902,3 +917,153 @@ func TestQueryFrontendJaegerRouteSecured(t *testing.T) {
 		},
 	}, objects[3].(*routev1.Route))
 }
+
+func TestQueryFrontendJaegerIngressKubeRBACProxy(t *testing.T) {
+	objects, err := BuildQueryFrontend(manifestutils.Params{
+		Tempo: v1alpha1.TempoStack{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "project1",
+			},
+			Spec: v1alpha1.TempoStackSpec{
+				ServiceAccount: "tempo-test",
+				Template: v1alpha1.TempoTemplateSpec{
+					QueryFrontend: v1alpha1.TempoQueryFrontendSpec{
+						JaegerQuery: v1alpha1.JaegerQuerySpec{
+							Enabled: true,
+							Authentication: &v1alpha1.JaegerQueryAuthenticationSpec{
+								Enabled:  true,
+								Provider: v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy,
+								SAR:      `{"namespace": "project1", "resource": "pods", "verb": "get"}`,
+							},
+							Ingress: v1alpha1.IngressSpec{
+								Type: v1alpha1.IngressTypeIngress,
+							},
+						},
+					},
+				},
+			},
+		},
+		CtrlConfig: configv1alpha1.ProjectConfig{
+			DefaultImages: configv1alpha1.ImagesSpec{
+				KubeRBACProxy: "quay.io/brancz/kube-rbac-proxy:latest",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	var (
+		deployment         *v1.Deployment
+		service            *corev1.Service
+		ingress            *networkingv1.Ingress
+		configMap          *corev1.ConfigMap
+		clusterRoleBinding *rbacv1.ClusterRoleBinding
+	)
+	for _, obj := range objects {
+		switch typed := obj.(type) {
+		case *v1.Deployment:
+			deployment = typed
+		case *corev1.Service:
+			if typed.Name == naming.Name(manifestutils.QueryFrontendComponentName, "test") {
+				service = typed
+			}
+		case *networkingv1.Ingress:
+			ingress = typed
+		case *corev1.ConfigMap:
+			configMap = typed
+		case *rbacv1.ClusterRoleBinding:
+			clusterRoleBinding = typed
+		}
+	}
+
+	require.NotNil(t, deployment)
+	containers := deployment.Spec.Template.Spec.Containers
+	assert.Equal(t, "kube-rbac-proxy", containers[len(containers)-1].Name)
+	assert.Equal(t, "quay.io/brancz/kube-rbac-proxy:latest", containers[len(containers)-1].Image)
+	assert.Equal(t, "tempo-test", deployment.Spec.Template.Spec.ServiceAccountName)
+
+	require.NotNil(t, service)
+	assert.Contains(t, service.Spec.Ports, corev1.ServicePort{
+		Name:       manifestutils.OAuthProxyPortName,
+		Port:       manifestutils.OAuthProxyPort,
+		TargetPort: intstr.FromString(manifestutils.OAuthProxyPortName),
+	})
+	assert.Empty(t, service.Annotations)
+
+	require.NotNil(t, ingress)
+	assert.Equal(t, manifestutils.OAuthProxyPortName, ingress.Spec.DefaultBackend.Service.Port.Name)
+	// kube-rbac-proxy only serves HTTPS
+	assert.Equal(t, map[string]string{"nginx.ingress.kubernetes.io/backend-protocol": "HTTPS"}, ingress.Annotations)
+
+	require.NotNil(t, configMap)
+	assert.Equal(t, "tempo-test-query-frontend-kube-rbac-proxy", configMap.Name)
+
+	require.NotNil(t, clusterRoleBinding)
+	assert.Equal(t, []rbacv1.Subject{{Kind: "ServiceAccount", Name: "tempo-test", Namespace: "project1"}}, clusterRoleBinding.Subjects)
+}
+
+func TestQueryFrontendJaegerIngressKubeRBACProxyOIDC(t *testing.T) {
+	objects, err := BuildQueryFrontend(manifestutils.Params{
+		Tempo: v1alpha1.TempoStack{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "project1",
+			},
+			Spec: v1alpha1.TempoStackSpec{
+				Images: configv1alpha1.ImagesSpec{
+					OAuth2Proxy: "quay.io/oauth2-proxy/oauth2-proxy:custom",
+				},
+				Template: v1alpha1.TempoTemplateSpec{
+					QueryFrontend: v1alpha1.TempoQueryFrontendSpec{
+						JaegerQuery: v1alpha1.JaegerQuerySpec{
+							Enabled: true,
+							Authentication: &v1alpha1.JaegerQueryAuthenticationSpec{
+								Enabled:  true,
+								Provider: v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy,
+								SAR:      `{"namespace": "project1", "resource": "pods", "verb": "get"}`,
+								OIDC: &v1alpha1.JaegerUIOIDCSpec{
+									IssuerURL: "https://keycloak.example.com/realms/tempo",
+									Secret:    "oidc",
+								},
+							},
+							Ingress: v1alpha1.IngressSpec{
+								Type: v1alpha1.IngressTypeIngress,
+							},
+						},
+					},
+				},
+			},
+		},
+		CtrlConfig: configv1alpha1.ProjectConfig{
+			DefaultImages: configv1alpha1.ImagesSpec{
+				KubeRBACProxy: "quay.io/brancz/kube-rbac-proxy:latest",
+				OAuth2Proxy:   "quay.io/oauth2-proxy/oauth2-proxy:latest",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	var (
+		deployment *v1.Deployment
+		ingress    *networkingv1.Ingress
+	)
+	for _, obj := range objects {
+		switch typed := obj.(type) {
+		case *v1.Deployment:
+			deployment = typed
+		case *networkingv1.Ingress:
+			ingress = typed
+		}
+	}
+
+	require.NotNil(t, deployment)
+	containers := deployment.Spec.Template.Spec.Containers
+	assert.Equal(t, "kube-rbac-proxy", containers[len(containers)-2].Name)
+	assert.Equal(t, "oauth2-proxy", containers[len(containers)-1].Name)
+	assert.Equal(t, "quay.io/oauth2-proxy/oauth2-proxy:custom", containers[len(containers)-1].Image)
+
+	require.NotNil(t, ingress)
+	assert.Equal(t, manifestutils.OAuthProxyPortName, ingress.Spec.DefaultBackend.Service.Port.Name)
+	// oauth2-proxy serves HTTP without the service serving certificates
+	assert.Empty(t, ingress.Annotations)
+}
@@ -0,0 +1,46 @@
+package servicemonitor
+
+import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+	"github.com/grafana/tempo-operator/internal/manifests/naming"
+)
+
+// AnnotateServices adds the prometheus.io/* annotations, which are used by annotation-based scraping,
+// to the Services of the monitored Tempo components.
+func AnnotateServices(objects []client.Object, params manifestutils.Params) {
+	services := map[string]target{}
+	for _, t := range targets(params) {
+		services[naming.Name(t.component, params.Tempo.Name)] = t
+	}
+
+	for _, obj := range objects {
+		service, ok := obj.(*corev1.Service)
+		if !ok {
+			continue
+		}
+		t, ok := services[service.Name]
+		if !ok {
+			continue
+		}
+
+		if service.Annotations == nil {
+			service.Annotations = map[string]string{}
+		}
+		service.Annotations["prometheus.io/scrape"] = "true"
+		service.Annotations["prometheus.io/port"] = strconv.Itoa(int(t.portNumber))
+		service.Annotations["prometheus.io/path"] = "/metrics"
+		service.Annotations["prometheus.io/scheme"] = scheme(t.tls)
+	}
+}
+
+func scheme(tls bool) string {
+	if tls {
+		return "https"
+	}
+	return "http"
+}
@@ -0,0 +1,57 @@
+package servicemonitor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+func TestAnnotateServices(t *testing.T) {
+	params := manifestutils.Params{
+		CtrlConfig: configv1alpha1.ProjectConfig{
+			Gates: configv1alpha1.FeatureGates{
+				HTTPEncryption: true,
+			},
+		},
+		Tempo: v1alpha1.TempoStack{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "project1",
+			},
+			Spec: v1alpha1.TempoStackSpec{
+				Template: v1alpha1.TempoTemplateSpec{
+					Gateway: v1alpha1.TempoGatewaySpec{Enabled: true},
+				},
+			},
+		},
+	}
+	compactor := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "tempo-test-compactor"}}
+	gateway := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "tempo-test-gateway", Annotations: map[string]string{"existing": "true"}}}
+	discovery := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "tempo-test-query-frontend-discovery"}}
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "tempo-test-compactor"}}
+
+	AnnotateServices([]client.Object{compactor, gateway, discovery, configMap}, params)
+
+	assert.Equal(t, map[string]string{
+		"prometheus.io/scrape": "true",
+		"prometheus.io/port":   "3200",
+		"prometheus.io/path":   "/metrics",
+		"prometheus.io/scheme": "https",
+	}, compactor.Annotations)
+	assert.Equal(t, map[string]string{
+		"existing":             "true",
+		"prometheus.io/scrape": "true",
+		"prometheus.io/port":   "8081",
+		"prometheus.io/path":   "/metrics",
+		"prometheus.io/scheme": "https",
+	}, gateway.Annotations)
+	assert.Empty(t, discovery.Annotations)
+	assert.Empty(t, configMap.Annotations)
+}
@@ -0,0 +1,169 @@
+package servicemonitor
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/certrotation"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+	"github.com/grafana/tempo-operator/internal/manifests/naming"
+)
+
+const (
+	// ScrapeConfigKey is the key of the OpenTelemetry Collector configuration in the scrape config ConfigMap.
+	ScrapeConfigKey = "collector.yaml"
+
+	// CertificatesDir is the directory of the TLS certificates in the OpenTelemetry Collector.
+	// The CA bundle ConfigMap and the TLS certificate Secrets of the components must be mounted
+	// at <CertificatesDir>/<ConfigMap or Secret name>.
+	CertificatesDir = "/etc/tempo-metrics"
+)
+
+type collectorConfig struct {
+	Receivers map[string]prometheusReceiver `json:"receivers"`
+}
+
+type prometheusReceiver struct {
+	Config prometheusConfig `json:"config"`
+}
+
+type prometheusConfig struct {
+	ScrapeConfigs []scrapeConfig `json:"scrape_configs"`
+}
+
+type scrapeConfig struct {
+	JobName              string               `json:"job_name"`
+	ScrapeInterval       string               `json:"scrape_interval,omitempty"`
+	ScrapeTimeout        string               `json:"scrape_timeout,omitempty"`
+	SampleLimit          int                  `json:"sample_limit,omitempty"`
+	Scheme               string               `json:"scheme"`
+	MetricsPath          string               `json:"metrics_path"`
+	TLSConfig            *tlsConfig           `json:"tls_config,omitempty"`
+	KubernetesSDConfigs  []kubernetesSDConfig `json:"kubernetes_sd_configs"`
+	RelabelConfigs       []relabelConfig      `json:"relabel_configs"`
+	MetricRelabelConfigs []relabelConfig      `json:"metric_relabel_configs,omitempty"`
+}
+
+type tlsConfig struct {
+	CAFile     string `json:"ca_file"`
+	CertFile   string `json:"cert_file"`
+	KeyFile    string `json:"key_file"`
+	ServerName string `json:"server_name"`
+}
+
+type kubernetesSDConfig struct {
+	Role       string               `json:"role"`
+	Namespaces kubernetesNamespaces `json:"namespaces"`
+}
+
+type kubernetesNamespaces struct {
+	Names []string `json:"names"`
+}
+
+type relabelConfig struct {
+	SourceLabels []string `json:"source_labels,omitempty"`
+	Separator    *string  `json:"separator,omitempty"`
+	Regex        string   `json:"regex,omitempty"`
+	TargetLabel  string   `json:"target_label,omitempty"`
+	Replacement  *string  `json:"replacement,omitempty"`
+	Action       string   `json:"action,omitempty"`
+}
+
+// BuildScrapeConfig creates a ConfigMap with the configuration of an OpenTelemetry Collector prometheus receiver,
+// which scrapes the metrics endpoints of the Tempo components.
+// The ConfigMap can be passed as an additional configuration file to the collector,
+// the receiver must be added to a metrics pipeline of the collector.
+func BuildScrapeConfig(params manifestutils.Params) (*corev1.ConfigMap, error) {
+	tempo := params.Tempo
+	scrape := tempo.Spec.Observability.Metrics.MetricsScrapeSpec
+
+	var scrapeConfigs []scrapeConfig
+	for _, t := range targets(params) {
+		serviceName := naming.Name(t.component, tempo.Name)
+		config := scrapeConfig{
+			JobName:        serviceName,
+			ScrapeInterval: string(duration(scrape.ScrapeInterval)),
+			ScrapeTimeout:  string(duration(scrape.ScrapeTimeout)),
+			Scheme:         scheme(t.tls),
+			MetricsPath:    "/metrics",
+			KubernetesSDConfigs: []kubernetesSDConfig{{
+				Role:       "endpoints",
+				Namespaces: kubernetesNamespaces{Names: []string{tempo.Namespace}},
+			}},
+			// Same labels as the ServiceMonitors, to be compatible with the predefined Tempo dashboards.
+			RelabelConfigs: []relabelConfig{
+				{SourceLabels: []string{"__meta_kubernetes_service_name"}, Regex: serviceName, Action: "keep"},
+				{SourceLabels: []string{"__meta_kubernetes_endpoint_port_name"}, Regex: t.port, Action: "keep"},
+				{SourceLabels: []string{"__meta_kubernetes_service_label_app_kubernetes_io_instance"}, TargetLabel: "cluster"},
+				{
+					SourceLabels: []string{"__meta_kubernetes_namespace", "__meta_kubernetes_service_label_app_kubernetes_io_component"},
+					Separator:    ptr.To("/"),
+					TargetLabel:  "job",
+				},
+			},
+			MetricRelabelConfigs: otelMetricRelabelConfigs(scrape.MetricRelabelings),
+		}
+		if scrape.SampleLimit != nil {
+			config.SampleLimit = *scrape.SampleLimit
+		}
+		if t.tls {
+			tlsSecret := naming.TLSSecretName(t.component, tempo.Name)
+			config.TLSConfig = &tlsConfig{
+				CAFile:     path.Join(CertificatesDir, naming.SigningCABundleName(tempo.Name), certrotation.CAFile),
+				CertFile:   path.Join(CertificatesDir, tlsSecret, corev1.TLSCertKey),
+				KeyFile:    path.Join(CertificatesDir, tlsSecret, corev1.TLSPrivateKeyKey),
+				ServerName: naming.ServiceFqdn(tempo.Namespace, tempo.Name, t.component),
+			}
+		}
+		scrapeConfigs = append(scrapeConfigs, config)
+	}
+
+	config := collectorConfig{
+		Receivers: map[string]prometheusReceiver{
+			fmt.Sprintf("prometheus/%s", naming.Name("", tempo.Name)): {Config: prometheusConfig{ScrapeConfigs: scrapeConfigs}},
+		},
+	}
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.Name("scrape-config", tempo.Name),
+			Namespace: tempo.Namespace,
+			Labels:    manifestutils.CommonLabels(tempo.Name),
+		},
+		Data: map[string]string{
+			// The collector expands environment variables in the configuration, e.g. ${env:VAR},
+			// therefore a literal $ (e.g. in regular expressions or replacements) must be escaped as $$.
+			ScrapeConfigKey: strings.ReplaceAll(string(out), "$", "$$"),
+		},
+	}, nil
+}
+
+func otelMetricRelabelConfigs(relabelings []v1alpha1.MetricRelabelConfig) []relabelConfig {
+	var result []relabelConfig
+	for _, relabeling := range relabelings {
+		result = append(result, relabelConfig{
+			SourceLabels: relabeling.SourceLabels,
+			Separator:    relabeling.Separator,
+			Regex:        relabeling.Regex,
+			TargetLabel:  relabeling.TargetLabel,
+			Replacement:  relabeling.Replacement,
+			Action:       relabeling.Action,
+		})
+	}
+	return result
+}
@@ -0,0 +1,100 @@
+package servicemonitor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+func TestBuildScrapeConfig(t *testing.T) {
+	configMap, err := BuildScrapeConfig(manifestutils.Params{
+		CtrlConfig: configv1alpha1.ProjectConfig{
+			Gates: configv1alpha1.FeatureGates{
+				HTTPEncryption: true,
+			},
+		},
+		Tempo: v1alpha1.TempoStack{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "project1",
+			},
+			Spec: v1alpha1.TempoStackSpec{
+				Observability: v1alpha1.ObservabilitySpec{
+					Metrics: v1alpha1.MetricsConfigSpec{
+						ScrapeMode: v1alpha1.MetricsScrapeModeOTelCollector,
+						MetricsScrapeSpec: v1alpha1.MetricsScrapeSpec{
+							ScrapeInterval: &metav1.Duration{Duration: time.Minute},
+							SampleLimit:    ptr.To(10000),
+							MetricRelabelings: []v1alpha1.MetricRelabelConfig{{
+								SourceLabels: []string{"route"},
+								Regex:        "(.*)_v1$",
+								TargetLabel:  "route",
+								Replacement:  ptr.To("$1"),
+							}},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "tempo-test-scrape-config", configMap.Name)
+	assert.Equal(t, "project1", configMap.Namespace)
+	assert.Equal(t, manifestutils.CommonLabels("test"), configMap.Labels)
+
+	config := configMap.Data["collector.yaml"]
+	assert.Contains(t, config, `receivers:
+  prometheus/tempo-test:
+    config:
+      scrape_configs:
+      - job_name: tempo-test-compactor
+        kubernetes_sd_configs:
+        - namespaces:
+            names:
+            - project1
+          role: endpoints
+        metric_relabel_configs:
+        - regex: (.*)_v1$$
+          replacement: $$1
+          source_labels:
+          - route
+          target_label: route
+        metrics_path: /metrics
+        relabel_configs:
+        - action: keep
+          regex: tempo-test-compactor
+          source_labels:
+          - __meta_kubernetes_service_name
+        - action: keep
+          regex: http
+          source_labels:
+          - __meta_kubernetes_endpoint_port_name
+        - source_labels:
+          - __meta_kubernetes_service_label_app_kubernetes_io_instance
+          target_label: cluster
+        - separator: /
+          source_labels:
+          - __meta_kubernetes_namespace
+          - __meta_kubernetes_service_label_app_kubernetes_io_component
+          target_label: job
+        sample_limit: 10000
+        scheme: https
+        scrape_interval: 1m
+        tls_config:
+          ca_file: /etc/tempo-metrics/tempo-test-ca-bundle/service-ca.crt
+          cert_file: /etc/tempo-metrics/tempo-test-compactor-mtls/tls.crt
+          key_file: /etc/tempo-metrics/tempo-test-compactor-mtls/tls.key
+          server_name: tempo-test-compactor.project1.svc.cluster.local
+`)
+	assert.Contains(t, config, "job_name: tempo-test-query-frontend")
+	assert.NotContains(t, config, "job_name: tempo-test-gateway")
+}
@@ -2,49 +2,66 @@ package servicemonitor
 
 import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/certrotation"
+	"github.com/grafana/tempo-operator/internal/manifests/gateway"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/manifests/naming"
 )
 
-// BuildServiceMonitors creates ServiceMonitor objects.
-func BuildServiceMonitors(params manifestutils.Params) []client.Object {
-	// Create one ServiceMonitor instance per monitored service.
-	// Each tempo component has its own TLS certificate, therefore we need separate
-	// ServiceMonitor instances for each component.
-	monitors := []client.Object{
-		buildServiceMonitor(params, manifestutils.CompactorComponentName, manifestutils.HttpPortName),
-		buildServiceMonitor(params, manifestutils.DistributorComponentName, manifestutils.HttpPortName),
-		buildServiceMonitor(params, manifestutils.IngesterComponentName, manifestutils.HttpPortName),
-		buildServiceMonitor(params, manifestutils.QuerierComponentName, manifestutils.HttpPortName),
-		buildFrontEndServiceMonitor(params, manifestutils.HttpPortName),
+// target is a metrics endpoint of a Tempo component.
+type target struct {
+	component  string
+	port       string
+	portNumber int32
+	tls        bool
+}
+
+// targets returns the metrics endpoints of the Tempo components.
+func targets(params manifestutils.Params) []target {
+	httpEncryption := params.CtrlConfig.Gates.HTTPEncryption
+	result := []target{
+		{component: manifestutils.CompactorComponentName, port: manifestutils.HttpPortName, portNumber: manifestutils.PortHTTPServer, tls: httpEncryption},
+		{component: manifestutils.DistributorComponentName, port: manifestutils.HttpPortName, portNumber: manifestutils.PortHTTPServer, tls: httpEncryption},
+		{component: manifestutils.IngesterComponentName, port: manifestutils.HttpPortName, portNumber: manifestutils.PortHTTPServer, tls: httpEncryption},
+		{component: manifestutils.QuerierComponentName, port: manifestutils.HttpPortName, portNumber: manifestutils.PortHTTPServer, tls: httpEncryption},
+		{component: manifestutils.QueryFrontendComponentName, port: manifestutils.HttpPortName, portNumber: manifestutils.PortHTTPServer,
+			tls: httpEncryption && params.Tempo.Spec.Template.Gateway.Enabled},
 	}
 
 	if params.Tempo.Spec.Template.Gateway.Enabled {
-		monitors = append(monitors, buildServiceMonitor(params, manifestutils.GatewayComponentName, manifestutils.GatewayInternalHttpPortName))
+		result = append(result, target{component: manifestutils.GatewayComponentName, port: manifestutils.GatewayInternalHttpPortName,
+			portNumber: manifestutils.GatewayPortInternalHTTPServer, tls: httpEncryption})
 	}
 
-	return monitors
-}
-
-func buildServiceMonitor(params manifestutils.Params, component string, port string) *monitoringv1.ServiceMonitor {
-	labels := manifestutils.ComponentLabels(component, params.Tempo.Name)
-	extraLabels := params.Tempo.Spec.Observability.Metrics.ExtraServiceMonitorLabels
-	return NewServiceMonitor(params.Tempo.Namespace, params.Tempo.Name, labels, extraLabels, params.CtrlConfig.Gates.HTTPEncryption, component, []string{port})
+	if gateway.UsesRateLimitService(params.Tempo) {
+		// The rate limit service does not use the internal certificates of the Tempo components.
+		result = append(result, target{component: manifestutils.GatewayRateLimiterComponentName, port: manifestutils.HttpPortName,
+			portNumber: manifestutils.PortHTTPServer})
+	}
+	return result
 }
 
-func buildFrontEndServiceMonitor(params manifestutils.Params, port string) *monitoringv1.ServiceMonitor {
-	labels := manifestutils.ComponentLabels(manifestutils.QueryFrontendComponentName, params.Tempo.Name)
-	extraLabels := params.Tempo.Spec.Observability.Metrics.ExtraServiceMonitorLabels
-	tls := params.CtrlConfig.Gates.HTTPEncryption && params.Tempo.Spec.Template.Gateway.Enabled
-	return NewServiceMonitor(params.Tempo.Namespace, params.Tempo.Name, labels, extraLabels, tls,
-		manifestutils.QueryFrontendComponentName, []string{port})
+// BuildServiceMonitors creates ServiceMonitor objects.
+func BuildServiceMonitors(params manifestutils.Params) []client.Object {
+	// Create one ServiceMonitor instance per monitored service.
+	// Each tempo component has its own TLS certificate, therefore we need separate
+	// ServiceMonitor instances for each component.
+	var monitors []client.Object
+	for _, t := range targets(params) {
+		labels := manifestutils.ComponentLabels(t.component, params.Tempo.Name)
+		extraLabels := params.Tempo.Spec.Observability.Metrics.ExtraServiceMonitorLabels
+		monitors = append(monitors, NewServiceMonitor(params.Tempo.Namespace, params.Tempo.Name, labels, extraLabels, t.tls,
+			t.component, []string{t.port}, params.Tempo.Spec.Observability.Metrics.MetricsScrapeSpec))
+	}
+	return monitors
 }
 
 // NewServiceMonitor creates a ServiceMonitor.
@@ -56,6 +73,7 @@ func NewServiceMonitor(
 	tls bool,
 	component string,
 	ports []string,
+	scrape v1alpha1.MetricsScrapeSpec,
 ) *monitoringv1.ServiceMonitor {
 	scheme := "http"
 	var tlsConfig *monitoringv1.TLSConfig
@@ -115,9 +133,17 @@ func NewServiceMonitor(
 					TargetLabel:  "job",
 				},
 			},
+			Interval:             duration(scrape.ScrapeInterval),
+			ScrapeTimeout:        duration(scrape.ScrapeTimeout),
+			MetricRelabelConfigs: metricRelabelConfigs(scrape.MetricRelabelings),
 		})
 	}
 
+	var sampleLimit *uint64
+	if scrape.SampleLimit != nil {
+		sampleLimit = ptr.To(uint64(*scrape.SampleLimit))
+	}
+
 	return &monitoringv1.ServiceMonitor{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: monitoringv1.SchemeGroupVersion.String(),
@@ -136,6 +162,34 @@ func NewServiceMonitor(
 			Selector: metav1.LabelSelector{
 				MatchLabels: selectorLabels,
 			},
+			SampleLimit: sampleLimit,
 		},
 	}
 }
+
+// duration converts a duration to the format of Prometheus, e.g. 15m instead of 15m0s.
+func duration(d *metav1.Duration) monitoringv1.Duration {
+	if d == nil {
+		return ""
+	}
+	return monitoringv1.Duration(model.Duration(d.Duration).String())
+}
+
+func metricRelabelConfigs(relabelings []v1alpha1.MetricRelabelConfig) []monitoringv1.RelabelConfig {
+	var result []monitoringv1.RelabelConfig
+	for _, relabeling := range relabelings {
+		var sourceLabels []monitoringv1.LabelName
+		for _, label := range relabeling.SourceLabels {
+			sourceLabels = append(sourceLabels, monitoringv1.LabelName(label))
+		}
+		result = append(result, monitoringv1.RelabelConfig{
+			SourceLabels: sourceLabels,
+			Separator:    relabeling.Separator,
+			Regex:        relabeling.Regex,
+			TargetLabel:  relabeling.TargetLabel,
+			Replacement:  relabeling.Replacement,
+			Action:       relabeling.Action,
+		})
+	}
+	return result
+}
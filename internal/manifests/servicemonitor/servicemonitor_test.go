@@ -2,11 +2,14 @@ package servicemonitor
 
 import (
 	"testing"
+	"time"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/utils/ptr"
 
 	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
@@ -330,3 +333,80 @@ func TestBuildServiceMonitorsWithExtraLabels(t *testing.T) {
 	}
 	assert.Equal(t, expectedLabels, sm.ObjectMeta.Labels)
 }
+
+func TestBuildRateLimiterServiceMonitor(t *testing.T) {
+	objects := BuildServiceMonitors(manifestutils.Params{
+		CtrlConfig: configv1alpha1.ProjectConfig{
+			Gates: configv1alpha1.FeatureGates{
+				HTTPEncryption: true,
+			},
+		},
+		Tempo: v1alpha1.TempoStack{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "project1",
+			},
+			Spec: v1alpha1.TempoStackSpec{
+				Template: v1alpha1.TempoTemplateSpec{
+					Gateway: v1alpha1.TempoGatewaySpec{
+						Enabled: true,
+						RateLimits: &v1alpha1.GatewayRateLimitsSpec{
+							Service: v1alpha1.GatewayRateLimitServiceSpec{Enabled: true},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	require.Len(t, objects, 7)
+	monitor := objects[6].(*monitoringv1.ServiceMonitor)
+	assert.Equal(t, "tempo-test-gateway-ratelimiter", monitor.Name)
+	assert.Equal(t, manifestutils.ComponentLabels(manifestutils.GatewayRateLimiterComponentName, "test"), labels.Set(monitor.Spec.Selector.MatchLabels))
+	// the rate limit service does not use the internal certificates
+	require.Len(t, monitor.Spec.Endpoints, 1)
+	assert.Equal(t, "http", monitor.Spec.Endpoints[0].Scheme)
+	assert.Equal(t, "http", monitor.Spec.Endpoints[0].Port)
+	assert.Nil(t, monitor.Spec.Endpoints[0].TLSConfig)
+}
+
+func TestBuildServiceMonitorsScrapeSettings(t *testing.T) {
+	objects := BuildServiceMonitors(manifestutils.Params{Tempo: v1alpha1.TempoStack{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "project1",
+		},
+		Spec: v1alpha1.TempoStackSpec{
+			Observability: v1alpha1.ObservabilitySpec{
+				Metrics: v1alpha1.MetricsConfigSpec{
+					CreateServiceMonitors: true,
+					MetricsScrapeSpec: v1alpha1.MetricsScrapeSpec{
+						ScrapeInterval: &metav1.Duration{Duration: time.Minute},
+						ScrapeTimeout:  &metav1.Duration{Duration: 15 * time.Second},
+						SampleLimit:    ptr.To(10000),
+						MetricRelabelings: []v1alpha1.MetricRelabelConfig{{
+							SourceLabels: []string{"__name__"},
+							Regex:        "tempo_request_duration_seconds_bucket",
+							Action:       "drop",
+						}},
+					},
+				},
+			},
+		},
+	}})
+
+	require.Len(t, objects, 5)
+	for _, obj := range objects {
+		serviceMonitor := obj.(*monitoringv1.ServiceMonitor)
+		assert.Equal(t, ptr.To(uint64(10000)), serviceMonitor.Spec.SampleLimit)
+		require.Len(t, serviceMonitor.Spec.Endpoints, 1)
+		endpoint := serviceMonitor.Spec.Endpoints[0]
+		assert.Equal(t, monitoringv1.Duration("1m"), endpoint.Interval)
+		assert.Equal(t, monitoringv1.Duration("15s"), endpoint.ScrapeTimeout)
+		assert.Equal(t, []monitoringv1.RelabelConfig{{
+			SourceLabels: []monitoringv1.LabelName{"__name__"},
+			Regex:        "tempo_request_duration_seconds_bucket",
+			Action:       "drop",
+		}}, endpoint.MetricRelabelConfigs)
+	}
+}
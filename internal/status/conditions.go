@@ -2,6 +2,7 @@ package status
 
 import (
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -24,6 +25,18 @@ func (e *ConfigurationError) Error() string {
 	return fmt.Sprintf("invalid configuration: %s", e.Message)
 }
 
+// FieldManagerConflictError occurs if server-side apply of a managed object conflicts with
+// the fields owned by another field manager (e.g. a HPA owning the replicas of a Deployment).
+type FieldManagerConflictError struct {
+	Kind      string
+	Name      string
+	Conflicts []string
+}
+
+func (e *FieldManagerConflictError) Error() string {
+	return fmt.Sprintf("%s %s has conflicting field managers: %s", e.Kind, e.Name, strings.Join(e.Conflicts, ", "))
+}
+
 // ReadyCondition updates or appends the condition Ready to the TempoStack status conditions.
 // In addition it resets all other Status conditions to false.
 func ReadyCondition(tempo v1alpha1.TempoStack) []metav1.Condition {
@@ -19,6 +19,7 @@ import (
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/manifests/monolithic"
+	"github.com/grafana/tempo-operator/internal/tracing"
 	"github.com/grafana/tempo-operator/internal/version"
 )
 
@@ -133,9 +134,14 @@ func updateConditions(conditions *[]metav1.Condition, componentsStatus v1alpha1.
 	// or if any pod of any component is in failed phase
 	var failed metav1.Condition
 	if reconcileError != nil && cerr == nil {
+		reason := v1alpha1.ReasonFailedReconciliation
+		var conflictErr *FieldManagerConflictError
+		if errors.As(reconcileError, &conflictErr) {
+			reason = v1alpha1.ReasonFieldManagerConflict
+		}
 		failed = metav1.Condition{
 			Type:    string(v1alpha1.ConditionFailed),
-			Reason:  string(v1alpha1.ReasonFailedReconciliation),
+			Reason:  string(reason),
 			Message: reconcileError.Error(),
 			Status:  metav1.ConditionTrue,
 		}
@@ -198,7 +204,9 @@ func HandleTempoMonolithicStatus(ctx context.Context, client client.Client, temp
 
 	updateMetrics(metricTempoMonolithicStatusCondition, status.Conditions, tempo.Namespace, tempo.Name)
 
-	err = updateStatus(ctx, client, tempo, &status)
+	statusCtx, span := tracing.Start(ctx, "UpdateStatus")
+	err = updateStatus(statusCtx, client, tempo, &status)
+	tracing.End(span, err)
 	if err != nil {
 		return err
 	}
@@ -361,6 +361,44 @@ func TestUpdateConditions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "field manager conflict",
+			componentsStatus: v1alpha1.MonolithicComponentStatus{
+				Tempo: v1alpha1.PodStatusMap{
+					v1alpha1.PodReady: []string{"tempo-1"},
+				},
+			},
+			reconcileError: fmt.Errorf("failed to create objects for sample: %w", &FieldManagerConflictError{
+				Kind:      "StatefulSet",
+				Name:      "tempo-sample",
+				Conflicts: []string{`.spec.replicas: conflict with "kube-controller-manager"`},
+			}),
+			expectedConditions: []metav1.Condition{
+				{
+					Type:    string(v1alpha1.ConditionPending),
+					Reason:  string(v1alpha1.ReasonPendingComponents),
+					Message: messagePending,
+					Status:  metav1.ConditionFalse,
+				},
+				{
+					Type:   string(v1alpha1.ConditionConfigurationError),
+					Reason: string(v1alpha1.ReasonInvalidStorageConfig),
+					Status: metav1.ConditionFalse,
+				},
+				{
+					Type:    string(v1alpha1.ConditionFailed),
+					Reason:  string(v1alpha1.ReasonFieldManagerConflict),
+					Message: `failed to create objects for sample: StatefulSet tempo-sample has conflicting field managers: .spec.replicas: conflict with "kube-controller-manager"`,
+					Status:  metav1.ConditionTrue,
+				},
+				{
+					Type:    string(v1alpha1.ConditionReady),
+					Reason:  string(v1alpha1.ReasonReady),
+					Message: messageReady,
+					Status:  metav1.ConditionFalse,
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,193 @@
+package status
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+	"github.com/prometheus/common/model"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+// TenantOverrides returns the status of the per-tenant overrides, i.e. the version generated by the operator
+// and the version which is confirmed to be loaded by all ready Tempo pods.
+// The second return value reports whether some Tempo pods did not load the current per-tenant overrides yet.
+//
+// The loaded runtime configuration is queried from the /status/runtime_config endpoint of every ready Tempo pod.
+// This endpoint is not reachable by the operator if the HTTP server of Tempo requires client certificates,
+// therefore the applied version is not verified if httpEncryption is enabled.
+func TenantOverrides(ctx context.Context, k StatusClient, httpClient *http.Client, tempo v1alpha1.TempoStack, httpEncryption bool) (*v1alpha1.TenantOverridesStatus, bool, error) {
+	cm := &corev1.ConfigMap{}
+	key := client.ObjectKey{Namespace: tempo.Namespace, Name: manifestutils.TenantOverridesConfigMapName(tempo.Name)}
+	if err := k.Get(ctx, key, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return tempo.Status.TenantOverrides, false, kverrors.Wrap(err, "failed to get per-tenant overrides", "name", key.Name)
+	}
+
+	overrides := []byte(cm.Data[manifestutils.TenantOverridesFileName])
+	status := &v1alpha1.TenantOverridesStatus{
+		Version: fmt.Sprintf("%x", sha256.Sum256(overrides)),
+	}
+	if tempo.Status.TenantOverrides != nil {
+		status.AppliedVersion = tempo.Status.TenantOverrides.AppliedVersion
+	}
+	if status.AppliedVersion == status.Version || httpEncryption {
+		return status, false, nil
+	}
+
+	components := []string{
+		manifestutils.DistributorComponentName,
+		manifestutils.IngesterComponentName,
+		manifestutils.QuerierComponentName,
+		manifestutils.QueryFrontendComponentName,
+		manifestutils.CompactorComponentName,
+	}
+	if tempo.Spec.Template.MetricsGenerator.Enabled {
+		components = append(components, manifestutils.MetricsGeneratorComponentName)
+	}
+
+	checked := 0
+	for _, component := range components {
+		pods, err := k.GetPodsComponent(ctx, component, tempo)
+		if err != nil {
+			return status, false, kverrors.Wrap(err, "failed to list pods for TempoStack component", "component", component)
+		}
+
+		for _, pod := range pods.Items {
+			// Pods which are not ready yet will load the current overrides during startup.
+			if !pod.DeletionTimestamp.IsZero() || podStatus(&pod) != v1alpha1.PodReady || pod.Status.PodIP == "" {
+				continue
+			}
+
+			baseURL := "http://" + net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(manifestutils.PortHTTPServer))
+			applied, err := RuntimeConfigApplied(ctx, httpClient, baseURL, overrides)
+			if err != nil {
+				return status, true, kverrors.Wrap(err, "failed to query runtime config", "pod", pod.Name)
+			}
+			if !applied {
+				return status, true, nil
+			}
+			checked++
+		}
+	}
+
+	// Without any ready pods the overrides cannot be verified yet.
+	// The status will be refreshed once the pods become ready.
+	if checked > 0 {
+		status.AppliedVersion = status.Version
+	}
+	return status, false, nil
+}
+
+// RuntimeConfigApplied queries the /status/runtime_config endpoint of a Tempo pod and reports
+// whether the given per-tenant overrides are currently loaded by Tempo.
+func RuntimeConfigApplied(ctx context.Context, httpClient *http.Client, baseURL string, overrides []byte) (bool, error) {
+	var desired struct {
+		Overrides map[string]interface{} `yaml:"overrides"`
+	}
+	if err := yaml.Unmarshal(overrides, &desired); err != nil {
+		return false, kverrors.Wrap(err, "failed to parse per-tenant overrides")
+	}
+
+	loaded, err := loadedOverrides(ctx, httpClient, baseURL)
+	if err != nil {
+		return false, err
+	}
+
+	// Tempo shows all settings of a tenant (including the defaults),
+	// therefore only the settings generated by the operator are compared.
+	if len(desired.Overrides) != len(loaded) {
+		return false, nil
+	}
+	for tenant, settings := range desired.Overrides {
+		loadedSettings, ok := loaded[tenant]
+		if !ok || !containsSettings(settings, loadedSettings) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// loadedOverrides queries the /status/runtime_config endpoint of a Tempo pod
+// and returns the per-tenant overrides which are currently loaded by Tempo, keyed by the tenant ID.
+func loadedOverrides(ctx context.Context, httpClient *http.Client, baseURL string) (map[string]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+manifestutils.TempoRuntimeConfigPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, kverrors.New("unexpected status code", "code", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var loaded struct {
+		Overrides map[string]interface{} `yaml:"overrides"`
+	}
+	if err := yaml.Unmarshal(body, &loaded); err != nil {
+		return nil, kverrors.Wrap(err, "failed to parse runtime config")
+	}
+	return loaded.Overrides, nil
+}
+
+// containsSettings reports whether all settings of desired are present in loaded.
+func containsSettings(desired interface{}, loaded interface{}) bool {
+	switch d := desired.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		l, ok := loaded.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range d {
+			if !containsSettings(value, l[key]) {
+				return false
+			}
+		}
+		return true
+	case string:
+		// Tempo prints durations in the Prometheus format (e.g. 1d instead of 24h0m0s).
+		l, ok := loaded.(string)
+		if !ok {
+			return false
+		}
+		if d == l {
+			return true
+		}
+		dd, derr := parseDuration(d)
+		ld, lerr := parseDuration(l)
+		return derr == nil && lerr == nil && dd == ld
+	default:
+		return fmt.Sprint(desired) == fmt.Sprint(loaded)
+	}
+}
+
+func parseDuration(s string) (time.Duration, error) {
+	if d, err := model.ParseDuration(s); err == nil {
+		return time.Duration(d), nil
+	}
+	return time.ParseDuration(s)
+}
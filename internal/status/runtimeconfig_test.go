@@ -0,0 +1,216 @@
+package status
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+const desiredOverrides = `
+overrides:
+  "tenant-a":
+    ingestion:
+      rate_limit_bytes: 1000
+    read:
+    compaction:
+      block_retention: 24h0m0s
+`
+
+const loadedRuntimeConfig = `
+defaults:
+  ingestion:
+    rate_limit_bytes: 15000000
+overrides:
+  tenant-a:
+    ingestion:
+      rate_limit_bytes: 1000
+      burst_size_bytes: 20000000
+    read:
+      max_bytes_per_tag_values_query: 1000000
+    compaction:
+      block_retention: 1d
+`
+
+func runtimeConfigServer(t *testing.T, statusCode int, body string) (*httptest.Server, *http.Client) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/status/runtime_config", r.URL.Path)
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	// Route all requests (e.g. to pod IPs) to the test server.
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, srv.Listener.Addr().String())
+			},
+		},
+	}
+	return srv, httpClient
+}
+
+func TestRuntimeConfigApplied(t *testing.T) {
+	tests := []struct {
+		name       string
+		desired    string
+		statusCode int
+		loaded     string
+		applied    bool
+		err        bool
+	}{
+		{
+			name:       "applied",
+			desired:    desiredOverrides,
+			statusCode: http.StatusOK,
+			loaded:     loadedRuntimeConfig,
+			applied:    true,
+		},
+		{
+			name:       "no per-tenant overrides",
+			desired:    "overrides:\n",
+			statusCode: http.StatusOK,
+			loaded:     "defaults:\n  ingestion:\n    rate_limit_bytes: 15000000\n",
+			applied:    true,
+		},
+		{
+			name:       "previous overrides loaded",
+			desired:    desiredOverrides,
+			statusCode: http.StatusOK,
+			loaded: `
+overrides:
+  tenant-a:
+    ingestion:
+      rate_limit_bytes: 500
+    compaction:
+      block_retention: 1d
+`,
+		},
+		{
+			name:       "tenant missing",
+			desired:    desiredOverrides,
+			statusCode: http.StatusOK,
+			loaded:     "overrides: {}\n",
+		},
+		{
+			name:       "removed tenant still loaded",
+			desired:    "overrides:\n",
+			statusCode: http.StatusOK,
+			loaded:     loadedRuntimeConfig,
+		},
+		{
+			name:       "server error",
+			desired:    desiredOverrides,
+			statusCode: http.StatusInternalServerError,
+			err:        true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, _ := runtimeConfigServer(t, tc.statusCode, tc.loaded)
+
+			applied, err := RuntimeConfigApplied(context.Background(), srv.Client(), srv.URL, []byte(tc.desired))
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.applied, applied)
+		})
+	}
+}
+
+func TestTenantOverrides(t *testing.T) {
+	version := fmt.Sprintf("%x", sha256.Sum256([]byte(desiredOverrides)))
+	stack := v1alpha1.TempoStack{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		loaded         string
+		httpEncryption bool
+		previous       *v1alpha1.TenantOverridesStatus
+		expected       *v1alpha1.TenantOverridesStatus
+		pending        bool
+	}{
+		{
+			name:     "applied",
+			loaded:   loadedRuntimeConfig,
+			expected: &v1alpha1.TenantOverridesStatus{Version: version, AppliedVersion: version},
+		},
+		{
+			name:     "not applied yet",
+			loaded:   "overrides: {}\n",
+			previous: &v1alpha1.TenantOverridesStatus{Version: "old", AppliedVersion: "old"},
+			expected: &v1alpha1.TenantOverridesStatus{Version: version, AppliedVersion: "old"},
+			pending:  true,
+		},
+		{
+			name:           "http encryption enabled",
+			loaded:         loadedRuntimeConfig,
+			httpEncryption: true,
+			previous:       &v1alpha1.TenantOverridesStatus{Version: "old", AppliedVersion: "old"},
+			expected:       &v1alpha1.TenantOverridesStatus{Version: version, AppliedVersion: "old"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, httpClient := runtimeConfigServer(t, http.StatusOK, tc.loaded)
+
+			k := &statusClientStub{}
+			k.GetStub = func(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				assert.Equal(t, "tempo-test-overrides", key.Name)
+				obj.(*corev1.ConfigMap).Data = map[string]string{"overrides.yaml": desiredOverrides}
+				return nil
+			}
+			k.GetPodsComponentStub = func(ctx context.Context, componentName string, stack v1alpha1.TempoStack) (*corev1.PodList, error) {
+				return &corev1.PodList{
+					Items: []corev1.Pod{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: componentName},
+							Status: corev1.PodStatus{
+								Phase: corev1.PodRunning,
+								PodIP: "10.0.0.1",
+								ContainerStatuses: []corev1.ContainerStatus{
+									{Ready: true},
+								},
+							},
+						},
+						{
+							// pods which are not ready are not queried
+							ObjectMeta: metav1.ObjectMeta{Name: componentName + "-pending"},
+							Status: corev1.PodStatus{
+								Phase: corev1.PodPending,
+							},
+						},
+					},
+				}, nil
+			}
+
+			tempo := *stack.DeepCopy()
+			tempo.Status.TenantOverrides = tc.previous
+			status, pending, err := TenantOverrides(context.Background(), k, httpClient, tempo, tc.httpEncryption)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, status)
+			assert.Equal(t, tc.pending, pending)
+		})
+	}
+}
@@ -8,11 +8,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/tracing"
 	"github.com/grafana/tempo-operator/internal/version"
 )
 
 // Refresh updates the status field with the Tempo versions and updates the tempostack_status_condition metric.
-func Refresh(ctx context.Context, k StatusClient, tempo v1alpha1.TempoStack, status *v1alpha1.TempoStackStatus) error {
+func Refresh(ctx context.Context, k StatusClient, tempo v1alpha1.TempoStack, status *v1alpha1.TempoStackStatus) (retErr error) {
+	ctx, span := tracing.Start(ctx, "UpdateStatus")
+	defer func() { tracing.End(span, retErr) }()
+
 	// The version fields in the status are empty for new CRs
 	if status.OperatorVersion == "" {
 		status.OperatorVersion = version.Get().OperatorVersion
@@ -0,0 +1,166 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/ViaQ/logerr/v2/kverrors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
+)
+
+// UserConfigurableOverrides returns the user-configurable and the effective overrides of all tenants
+// which have user-configurable overrides configured.
+//
+// The overrides are queried from the overrides API and the /status/runtime_config endpoint of a ready query-frontend pod.
+// The effective overrides are the runtime overrides of the tenant merged with the user-configurable overrides,
+// which take precedence in Tempo.
+// This API is not reachable by the operator if the HTTP server of Tempo requires client certificates,
+// therefore the previous status is returned if httpEncryption is enabled.
+func UserConfigurableOverrides(ctx context.Context, k StatusClient, httpClient *http.Client, tempo v1alpha1.TempoStack, httpEncryption bool) ([]v1alpha1.UserConfigurableOverridesStatus, error) {
+	if !tempo.Spec.UserConfigurableOverridesEnabled() {
+		return nil, nil
+	}
+
+	var previous []v1alpha1.UserConfigurableOverridesStatus
+	if tempo.Status.TenantOverrides != nil {
+		previous = tempo.Status.TenantOverrides.UserConfigurable
+	}
+	if httpEncryption {
+		return previous, nil
+	}
+
+	pods, err := k.GetPodsComponent(ctx, manifestutils.QueryFrontendComponentName, tempo)
+	if err != nil {
+		return previous, kverrors.Wrap(err, "failed to list pods for TempoStack component", "component", manifestutils.QueryFrontendComponentName)
+	}
+
+	baseURL := ""
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp.IsZero() && podStatus(&pod) == v1alpha1.PodReady && pod.Status.PodIP != "" {
+			baseURL = "http://" + net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(manifestutils.PortHTTPServer))
+			break
+		}
+	}
+	if baseURL == "" {
+		return previous, nil
+	}
+
+	// The gateway sets the X-Scope-OrgID header to the ID of the tenant,
+	// therefore Tempo stores the overrides under the tenant ID.
+	tenants := []tenant{{name: manifestutils.SingleTenantID, id: manifestutils.SingleTenantID}}
+	if tempo.Spec.Tenants != nil {
+		tenants = make([]tenant, 0, len(tempo.Spec.Tenants.Authentication))
+		for _, auth := range tempo.Spec.Tenants.Authentication {
+			tenants = append(tenants, tenant{name: auth.TenantName, id: auth.TenantID})
+		}
+	}
+
+	runtimeOverrides, err := loadedOverrides(ctx, httpClient, baseURL)
+	if err != nil {
+		return previous, kverrors.Wrap(err, "failed to query runtime config")
+	}
+
+	var result []v1alpha1.UserConfigurableOverridesStatus
+	for _, t := range tenants {
+		overrides, err := getUserConfigurableOverrides(ctx, httpClient, baseURL, t.id)
+		if err != nil {
+			return previous, kverrors.Wrap(err, "failed to query user-configurable overrides", "tenant", t.name)
+		}
+		if overrides == nil {
+			continue
+		}
+		effective, err := effectiveOverrides(runtimeOverrides, t.id, overrides)
+		if err != nil {
+			return previous, kverrors.Wrap(err, "failed to merge overrides", "tenant", t.name)
+		}
+		result = append(result, v1alpha1.UserConfigurableOverridesStatus{
+			Tenant:    t.name,
+			Overrides: apiextensionsv1.JSON{Raw: overrides},
+			Effective: apiextensionsv1.JSON{Raw: effective},
+		})
+	}
+	return result, nil
+}
+
+// wildcardTenant is the tenant ID of the runtime overrides which apply to all tenants without own overrides.
+const wildcardTenant = "*"
+
+type tenant struct {
+	name string
+	id   string
+}
+
+// getUserConfigurableOverrides returns the user-configurable overrides of a tenant,
+// or nil if the tenant does not have any overrides configured.
+func getUserConfigurableOverrides(ctx context.Context, httpClient *http.Client, baseURL string, tenantID string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+manifestutils.TempoUserConfigurableOverridesPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(manifestutils.TenantHeader, tenantID)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, kverrors.New("unexpected status code", "code", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(body) {
+		return nil, kverrors.New("invalid JSON response")
+	}
+	return body, nil
+}
+
+// effectiveOverrides merges the user-configurable overrides of a tenant into the runtime overrides of the tenant.
+// Tempo applies the runtime overrides of the wildcard tenant (*) to tenants without runtime overrides.
+func effectiveOverrides(runtimeOverrides map[string]interface{}, tenantID string, userConfigurable []byte) ([]byte, error) {
+	var overrides interface{}
+	if err := json.Unmarshal(userConfigurable, &overrides); err != nil {
+		return nil, err
+	}
+
+	runtime, ok := runtimeOverrides[tenantID]
+	if !ok {
+		runtime = runtimeOverrides[wildcardTenant]
+	}
+	return json.Marshal(mergeSettings(runtime, overrides))
+}
+
+// mergeSettings returns the settings of base overridden with the settings of override.
+// Maps are merged recursively, all other values of override replace the values of base.
+func mergeSettings(base interface{}, override interface{}) interface{} {
+	b, bok := base.(map[string]interface{})
+	o, ook := override.(map[string]interface{})
+	if !bok || !ook {
+		if override == nil {
+			return base
+		}
+		return override
+	}
+
+	result := make(map[string]interface{}, len(b)+len(o))
+	for key, value := range b {
+		result[key] = value
+	}
+	for key, value := range o {
+		result[key] = mergeSettings(b[key], value)
+	}
+	return result
+}
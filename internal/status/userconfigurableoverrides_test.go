@@ -0,0 +1,191 @@
+package status
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+)
+
+func TestUserConfigurableOverrides(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/status/runtime_config" {
+			_, _ = w.Write([]byte(`overrides:
+  "1610b0c3-c509-4592-a256-a1871353dbfa":
+    ingestion:
+      rate_limit_bytes: 1000
+    forwarders: []
+  "*":
+    read:
+      max_search_duration: 1h
+`))
+			return
+		}
+		assert.Equal(t, "/api/overrides", r.URL.Path)
+		switch r.Header.Get("x-scope-orgid") {
+		case "single-tenant", "1610b0c3-c509-4592-a256-a1871353dbfa":
+			_, _ = w.Write([]byte(`{"forwarders":["otel"]}`))
+		case "6094cb5e-a1e4-4d2a-b4b4-5d3e2c8f4f1a":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	// Route all requests (e.g. to pod IPs) to the test server.
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, srv.Listener.Addr().String())
+			},
+		},
+	}
+
+	previous := []v1alpha1.UserConfigurableOverridesStatus{
+		{Tenant: "dev", Overrides: apiextensionsv1.JSON{Raw: []byte(`{}`)}},
+	}
+	overrides := apiextensionsv1.JSON{Raw: []byte(`{"forwarders":["otel"]}`)}
+	readyPod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "query-frontend"},
+		Status: corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			PodIP:             "10.0.0.1",
+			ContainerStatuses: []corev1.ContainerStatus{{Ready: true}},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		spec           v1alpha1.TempoStackSpec
+		pods           []corev1.Pod
+		httpEncryption bool
+		expected       []v1alpha1.UserConfigurableOverridesStatus
+		err            bool
+	}{
+		{
+			name: "disabled",
+			pods: []corev1.Pod{readyPod},
+		},
+		{
+			name: "single tenant",
+			spec: v1alpha1.TempoStackSpec{
+				UserConfigurableOverrides: &v1alpha1.UserConfigurableOverridesSpec{Enabled: true},
+			},
+			pods: []corev1.Pod{readyPod},
+			expected: []v1alpha1.UserConfigurableOverridesStatus{{
+				Tenant:    "single-tenant",
+				Overrides: overrides,
+				Effective: apiextensionsv1.JSON{Raw: []byte(`{"forwarders":["otel"],"read":{"max_search_duration":"1h"}}`)},
+			}},
+		},
+		{
+			name: "multiple tenants",
+			spec: v1alpha1.TempoStackSpec{
+				UserConfigurableOverrides: &v1alpha1.UserConfigurableOverridesSpec{Enabled: true},
+				Tenants: &v1alpha1.TenantsSpec{
+					Authentication: []v1alpha1.AuthenticationSpec{
+						{TenantName: "dev", TenantID: "1610b0c3-c509-4592-a256-a1871353dbfa"},
+						{TenantName: "prod", TenantID: "b4c7a5b3-2d5e-4b7f-9a1c-3c8d2e6f7a90"},
+					},
+				},
+			},
+			pods: []corev1.Pod{readyPod},
+			expected: []v1alpha1.UserConfigurableOverridesStatus{{
+				Tenant:    "dev",
+				Overrides: overrides,
+				Effective: apiextensionsv1.JSON{Raw: []byte(`{"forwarders":["otel"],"ingestion":{"rate_limit_bytes":1000}}`)},
+			}},
+		},
+		{
+			name: "no ready pods",
+			spec: v1alpha1.TempoStackSpec{
+				UserConfigurableOverrides: &v1alpha1.UserConfigurableOverridesSpec{Enabled: true},
+			},
+			pods: []corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "query-frontend"},
+					Status:     corev1.PodStatus{Phase: corev1.PodPending},
+				},
+			},
+			expected: previous,
+		},
+		{
+			name: "http encryption enabled",
+			spec: v1alpha1.TempoStackSpec{
+				UserConfigurableOverrides: &v1alpha1.UserConfigurableOverridesSpec{Enabled: true, PollInterval: &metav1.Duration{Duration: time.Minute}},
+			},
+			pods:           []corev1.Pod{readyPod},
+			httpEncryption: true,
+			expected:       previous,
+		},
+		{
+			name: "server error",
+			spec: v1alpha1.TempoStackSpec{
+				UserConfigurableOverrides: &v1alpha1.UserConfigurableOverridesSpec{Enabled: true},
+				Tenants: &v1alpha1.TenantsSpec{
+					Authentication: []v1alpha1.AuthenticationSpec{
+						{TenantName: "broken", TenantID: "6094cb5e-a1e4-4d2a-b4b4-5d3e2c8f4f1a"},
+					},
+				},
+			},
+			pods:     []corev1.Pod{readyPod},
+			expected: previous,
+			err:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			k := &statusClientStub{}
+			k.GetPodsComponentStub = func(ctx context.Context, componentName string, stack v1alpha1.TempoStack) (*corev1.PodList, error) {
+				assert.Equal(t, "query-frontend", componentName)
+				return &corev1.PodList{Items: tc.pods}, nil
+			}
+
+			tempo := v1alpha1.TempoStack{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+				Spec:       tc.spec,
+				Status: v1alpha1.TempoStackStatus{
+					TenantOverrides: &v1alpha1.TenantOverridesStatus{UserConfigurable: previous},
+				},
+			}
+			status, err := UserConfigurableOverrides(context.Background(), k, httpClient, tempo, tc.httpEncryption)
+			if tc.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, status)
+		})
+	}
+}
+
+func TestEffectiveOverrides(t *testing.T) {
+	runtimeOverrides := map[string]interface{}{
+		"1": map[string]interface{}{
+			"metrics_generator": map[string]interface{}{
+				"processors":          []interface{}{"service-graphs"},
+				"collection_interval": "15s",
+			},
+		},
+	}
+
+	effective, err := effectiveOverrides(runtimeOverrides, "1", []byte(`{"metrics_generator":{"processors":["span-metrics"]}}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"metrics_generator":{"processors":["span-metrics"],"collection_interval":"15s"}}`, string(effective))
+
+	effective, err = effectiveOverrides(runtimeOverrides, "2", []byte(`{"forwarders":["otel"]}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"forwarders":["otel"]}`, string(effective))
+}
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+)
+
+const serviceName = "tempo-operator"
+
+// Bootstrap configures the OpenTelemetry tracer provider with the OTLP/gRPC exporter, if tracing is enabled.
+// The returned function flushes the pending spans and stops the exporter.
+func Bootstrap(ctx context.Context, cfg configv1alpha1.TracingFeatureGates, operatorVersion string) (func(context.Context) error, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := newTracerProvider(exporter, cfg, operatorVersion)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+func newTracerProvider(exporter sdktrace.SpanExporter, cfg configv1alpha1.TracingFeatureGates, operatorVersion string) *sdktrace.TracerProvider {
+	// The sampling ratio is verified when the operator config is loaded.
+	ratio := 1.0
+	if cfg.SamplingRatio != "" {
+		ratio, _ = strconv.ParseFloat(cfg.SamplingRatio, 64)
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+			semconv.ServiceVersion(operatorVersion),
+		)),
+	)
+}
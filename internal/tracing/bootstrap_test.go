@@ -0,0 +1,123 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	collectortracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+)
+
+// receiver is an in-process OTLP/gRPC trace receiver.
+type receiver struct {
+	collectortracepb.UnimplementedTraceServiceServer
+
+	mu    sync.Mutex
+	spans []*tracepb.Span
+}
+
+func (r *receiver) Export(_ context.Context, req *collectortracepb.ExportTraceServiceRequest) (*collectortracepb.ExportTraceServiceResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rs := range req.ResourceSpans {
+		for _, ss := range rs.ScopeSpans {
+			r.spans = append(r.spans, ss.Spans...)
+		}
+	}
+	return &collectortracepb.ExportTraceServiceResponse{}, nil
+}
+
+func (r *receiver) spanNames() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := []string{}
+	for _, span := range r.spans {
+		names = append(names, span.Name)
+	}
+	return names
+}
+
+func startReceiver(t *testing.T) (*receiver, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	r := &receiver{}
+	server := grpc.NewServer()
+	collectortracepb.RegisterTraceServiceServer(server, r)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	return r, listener.Addr().String()
+}
+
+func TestBootstrapDisabled(t *testing.T) {
+	shutdown, err := Bootstrap(context.Background(), configv1alpha1.TracingFeatureGates{}, "0.0.1")
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+}
+
+func TestBootstrap(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	r, endpoint := startReceiver(t)
+	shutdown, err := Bootstrap(context.Background(), configv1alpha1.TracingFeatureGates{
+		Enabled:      true,
+		OTLPEndpoint: endpoint,
+		Insecure:     true,
+	}, "0.0.1")
+	require.NoError(t, err)
+
+	reconciler := NewReconciler("TempoStack", reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		_, span := Start(ctx, "BuildManifests")
+		End(span, errors.New("invalid manifest"))
+		return reconcile.Result{}, nil
+	}))
+	_, err = reconciler.Reconcile(context.Background(), reconcile.Request{})
+	require.NoError(t, err)
+
+	require.NoError(t, shutdown(context.Background()))
+	assert.ElementsMatch(t, []string{"TempoStack.Reconcile", "BuildManifests"}, r.spanNames())
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, span := range r.spans {
+		if span.Name == "BuildManifests" {
+			assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, span.Status.Code)
+		} else {
+			assert.Equal(t, tracepb.Status_STATUS_CODE_UNSET, span.Status.Code)
+		}
+	}
+}
+
+func TestSamplingRatio(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	r, endpoint := startReceiver(t)
+	shutdown, err := Bootstrap(context.Background(), configv1alpha1.TracingFeatureGates{
+		Enabled:       true,
+		OTLPEndpoint:  endpoint,
+		Insecure:      true,
+		SamplingRatio: "0",
+	}, "0.0.1")
+	require.NoError(t, err)
+
+	_, span := Start(context.Background(), "UpdateStatus")
+	End(span, nil)
+
+	require.NoError(t, shutdown(context.Background()))
+	assert.Empty(t, r.spanNames())
+}
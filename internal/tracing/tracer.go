@@ -0,0 +1,59 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const instrumentationName = "github.com/grafana/tempo-operator"
+
+// Start creates a span and a context containing the span.
+// The spans are dropped if tracing of the operator is disabled.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the error, if any, and ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// InstanceAttributes returns the attributes identifying a Tempo instance.
+func InstanceAttributes(kind string, namespace string, name string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		semconv.K8SNamespaceName(namespace),
+		attribute.String("tempo.kind", kind),
+		attribute.String("tempo.name", name),
+	}
+}
+
+// ObjectAttributes returns the attributes identifying a Kubernetes object.
+func ObjectAttributes(kind string, name string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("k8s.object.kind", kind),
+		attribute.String("k8s.object.name", name),
+	}
+}
+
+// NewReconciler wraps a reconciler and records a span for every reconcile request.
+func NewReconciler(kind string, reconciler reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		ctx, span := Start(ctx, kind+".Reconcile", InstanceAttributes(kind, req.Namespace, req.Name)...)
+		result, err := reconciler.Reconcile(ctx, req)
+		if result.RequeueAfter > 0 {
+			span.SetAttributes(attribute.String("reconcile.requeue_after", result.RequeueAfter.String()))
+		}
+		End(span, err)
+		return result, err
+	})
+}
@@ -17,6 +17,7 @@ import (
 	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
 	tempov1alpha1 "github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/handlers/storage"
+	"github.com/grafana/tempo-operator/internal/manifests/alerts"
 	"github.com/grafana/tempo-operator/internal/status"
 )
 
@@ -86,14 +87,36 @@ func (v *monolithicValidator) validateTempoMonolithic(ctx context.Context, tempo
 	}
 
 	errors = append(errors, validateName(tempo.Name)...)
+	errors = append(errors, v.validateNamespaceScope(tempo)...)
 	addValidationResults(v.validateStorage(ctx, tempo))
 	errors = append(errors, v.validateJaegerUI(tempo)...)
+	errors = append(errors, v.validateJaegerUIAuthentication(ctx, tempo)...)
 	addValidationResults(v.validateMultitenancy(ctx, tempo))
 	errors = append(errors, v.validateObservability(tempo)...)
+	if tempo.Spec.Observability != nil && tempo.Spec.Observability.Metrics != nil && tempo.Spec.Observability.Metrics.PrometheusRules != nil {
+		errors = append(errors, alerts.Validate(tempo.Spec.Observability.Metrics.PrometheusRules.Alerts,
+			field.NewPath("spec", "observability", "metrics", "prometheusRules", "alerts"))...)
+	}
+	if tempo.Spec.Observability != nil {
+		errors = append(errors, validateOTLPTracing(tempo.Spec.Observability.Tracing, tempo.Spec.Multitenancy.IsGatewayEnabled(),
+			field.NewPath("spec", "observability", "tracing"))...)
+	}
+	if tempo.Spec.Observability != nil && tempo.Spec.Observability.Grafana != nil {
+		if tempo.Spec.Observability.Grafana.DataSource != nil {
+			errors = append(errors, validateGrafanaDataSourceLinks(tempo.Spec.Observability.Grafana.DataSource.GrafanaDataSourceLinksSpec,
+				field.NewPath("spec", "observability", "grafana", "dataSource"))...)
+			errors = append(errors, validateGrafanaDataSourceAuthentication(tempo.Spec.Observability.Grafana.DataSource.Authentication,
+				field.NewPath("spec", "observability", "grafana", "dataSource", "authentication"))...)
+		}
+		addValidationResults(validateGrafanaDashboards(tempo.Spec.Observability.Grafana.Dashboards, v.ctrlConfig.Gates.GrafanaOperator,
+			field.NewPath("spec", "observability", "grafana", "dashboards")))
+	}
 	errors = append(errors, v.validateServiceAccount(ctx, tempo)...)
+	errors = append(errors, validateTuning(tempo.Spec.Tuning, field.NewPath("spec", "tuning"))...)
 	errors = append(errors, v.validateConflictWithTempoStack(ctx, tempo)...)
 
-	warnings = append(warnings, v.validateExtraConfig(tempo)...)
+	addValidationResults(v.validateExtraConfig(tempo))
+	addValidationResults(validateOverrides(tempo.Spec.Overrides, field.NewPath("spec", "overrides")))
 	warnings = append(warnings, v.validateJaegerUIDeprecation(tempo)...)
 
 	return warnings, errors
@@ -160,6 +183,61 @@ func (v *monolithicValidator) validateJaegerUI(tempo tempov1alpha1.TempoMonolith
 	return nil
 }
 
+// validateJaegerUIAuthentication validates the kube-rbac-proxy authentication and the OIDC login of the Jaeger UI.
+func (v *monolithicValidator) validateJaegerUIAuthentication(ctx context.Context, tempo tempov1alpha1.TempoMonolithic) field.ErrorList {
+	if tempo.Spec.JaegerUI == nil || !tempo.Spec.JaegerUI.Enabled {
+		return nil
+	}
+
+	path := field.NewPath("spec", "jaegerui", "authentication")
+	if !tempo.Spec.JaegerUI.Authentication.IsKubeRBACProxy() {
+		return validateJaegerUIOIDC(ctx, v.client, path, tempo.Namespace, tempo.Spec.JaegerUI.Authentication, "")
+	}
+
+	if tempo.Spec.Multitenancy.IsGatewayEnabled() {
+		return field.ErrorList{field.Invalid(
+			path.Child("provider"),
+			tempo.Spec.JaegerUI.Authentication.Provider,
+			"the kube-rbac-proxy provider cannot be used together with the gateway, the gateway authenticates all requests",
+		)}
+	}
+
+	route := tempo.Spec.JaegerUI.Route != nil && tempo.Spec.JaegerUI.Route.Enabled
+	errs := validateKubeRBACProxy(ctx, v.client, path, tempo.Spec.JaegerUI.Authentication, v.ctrlConfig.DefaultImages.KubeRBACProxy,
+		route, v.ctrlConfig.Gates.OpenShift.ServingCertsService)
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return validateJaegerUIOIDC(ctx, v.client, path, tempo.Namespace, tempo.Spec.JaegerUI.Authentication, v.ctrlConfig.DefaultImages.OAuth2Proxy)
+}
+
+// validateNamespaceScope rejects instances outside of the watched namespaces and
+// features which require cluster-scoped objects if the operator is namespace-scoped.
+func (v *monolithicValidator) validateNamespaceScope(tempo tempov1alpha1.TempoMonolithic) field.ErrorList {
+	if !v.ctrlConfig.IsNamespaceScoped() {
+		return nil
+	}
+
+	errs := validateWatchedNamespace(v.ctrlConfig, tempo.Namespace)
+
+	if tempo.Spec.Multitenancy.IsGatewayEnabled() && tempo.Spec.Multitenancy.Mode == tempov1alpha1.ModeOpenShift {
+		errs = append(errs, namespaceScopedError(
+			field.NewPath("spec", "multitenancy", "mode"),
+			fmt.Sprintf("the %s tenancy mode", tempo.Spec.Multitenancy.Mode),
+		))
+	}
+
+	if tempo.Spec.JaegerUI != nil && tempo.Spec.JaegerUI.Enabled && tempo.Spec.JaegerUI.Authentication.IsKubeRBACProxy() {
+		errs = append(errs, namespaceScopedError(
+			field.NewPath("spec", "jaegerui", "authentication", "provider"),
+			"the kube-rbac-proxy provider",
+		))
+	}
+
+	return errs
+}
+
 func (v *monolithicValidator) validateMultitenancy(ctx context.Context, tempo tempov1alpha1.TempoMonolithic) (admission.Warnings, field.ErrorList) {
 	if tempo.Spec.Query != nil && tempo.Spec.Query.RBAC.Enabled && (tempo.Spec.Multitenancy == nil || !tempo.Spec.Multitenancy.Enabled) {
 		return nil, field.ErrorList{
@@ -168,6 +246,38 @@ func (v *monolithicValidator) validateMultitenancy(ctx context.Context, tempo te
 			)}
 	}
 
+	if tempo.Spec.Multitenancy != nil && tempo.Spec.Multitenancy.TempoTenantSelector != nil {
+		return nil, field.ErrorList{
+			field.Forbidden(field.NewPath("spec", "multitenancy", "tempoTenantSelector"),
+				"TempoTenants are only supported by TempoStack",
+			)}
+	}
+
+	if tempo.Spec.Multitenancy != nil && tempo.Spec.Multitenancy.Mode == tempov1alpha1.ModeKubernetes {
+		return nil, field.ErrorList{
+			field.Invalid(field.NewPath("spec", "multitenancy", "mode"), tempo.Spec.Multitenancy.Mode,
+				"kubernetes mode is only supported by TempoStack",
+			)}
+	}
+
+	if tempo.Spec.Multitenancy != nil {
+		for i, auth := range tempo.Spec.Multitenancy.Authentication {
+			if auth.MTLS != nil {
+				return nil, field.ErrorList{
+					field.Forbidden(field.NewPath("spec", "multitenancy", "authentication").Index(i).Child("mTLS"),
+						"mTLS authentication is only supported by TempoStack",
+					)}
+			}
+		}
+	}
+
+	if tempo.Spec.Multitenancy != nil && tempo.Spec.Multitenancy.Authorization != nil && tempo.Spec.Multitenancy.Authorization.OPA != nil {
+		return nil, field.ErrorList{
+			field.Forbidden(field.NewPath("spec", "multitenancy", "authorization", "opa"),
+				"custom OPA policies are only supported by TempoStack",
+			)}
+	}
+
 	if v.ctrlConfig.Gates.OpenShift.NoAuthWarning && !tempo.Spec.Multitenancy.IsGatewayEnabled() {
 		return admission.Warnings{"TempoMonolithic instances without multi-tenancy provide no authentication or authorization on the ingest or query paths, and are not supported on OpenShift"}, nil
 	}
@@ -245,15 +355,6 @@ func (v *monolithicValidator) validateObservability(tempo tempov1alpha1.TempoMon
 				"the grafanaOperator feature gate must be enabled to create a data source for Tempo",
 			)}
 		}
-
-		if tempo.Spec.Observability.Grafana.DataSource != nil && tempo.Spec.Observability.Grafana.DataSource.Enabled &&
-			tempo.Spec.Multitenancy.IsGatewayEnabled() {
-			return field.ErrorList{field.Invalid(
-				grafanaBase.Child("dataSource", "enabled"),
-				tempo.Spec.Observability.Grafana.DataSource.Enabled,
-				"creating a data source for Tempo is not support if the gateway is enabled",
-			)}
-		}
 	}
 
 	return nil
@@ -285,11 +386,13 @@ func (v *monolithicValidator) validateServiceAccount(ctx context.Context, tempo
 	return nil
 }
 
-func (v *monolithicValidator) validateExtraConfig(tempo tempov1alpha1.TempoMonolithic) admission.Warnings {
-	if tempo.Spec.ExtraConfig != nil && len(tempo.Spec.ExtraConfig.Tempo.Raw) > 0 {
-		return admission.Warnings{"overriding Tempo configuration could potentially break the deployment, use it carefully"}
+func (v *monolithicValidator) validateExtraConfig(tempo tempov1alpha1.TempoMonolithic) (admission.Warnings, field.ErrorList) {
+	if tempo.Spec.ExtraConfig == nil || len(tempo.Spec.ExtraConfig.Tempo.Raw) == 0 {
+		return nil, nil
 	}
-	return nil
+
+	warnings, errs := validateExtraConfig(tempo.Spec.ExtraConfig, field.NewPath("spec", "extraConfig"))
+	return append(admission.Warnings{"overriding Tempo configuration could potentially break the deployment, use it carefully"}, warnings...), errs
 }
 
 func (v *monolithicValidator) validateConflictWithTempoStack(ctx context.Context, tempo tempov1alpha1.TempoMonolithic) field.ErrorList {
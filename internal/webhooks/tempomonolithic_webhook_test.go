@@ -125,6 +125,134 @@ func TestMonolithicValidate(t *testing.T) {
 			warnings: admission.Warnings{jaegerUIDeprecationWarning},
 			errors:   field.ErrorList{},
 		},
+		{
+			name: "JaegerUI kube-rbac-proxy authentication",
+			ctrlConfig: configv1alpha1.ProjectConfig{
+				DefaultImages: configv1alpha1.ImagesSpec{
+					KubeRBACProxy: "quay.io/brancz/kube-rbac-proxy",
+				},
+			},
+			tempo: v1alpha1.TempoMonolithic{
+				Spec: v1alpha1.TempoMonolithicSpec{
+					JaegerUI: &v1alpha1.MonolithicJaegerUISpec{
+						Enabled: true,
+						Ingress: &v1alpha1.MonolithicJaegerUIIngressSpec{
+							Enabled: true,
+						},
+						Authentication: &v1alpha1.JaegerQueryAuthenticationSpec{
+							Enabled:  true,
+							Provider: v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy,
+						},
+					},
+				},
+			},
+			warnings: admission.Warnings{jaegerUIDeprecationWarning},
+			errors:   field.ErrorList{},
+		},
+		{
+			name: "JaegerUI kube-rbac-proxy authentication with invalid SAR",
+			ctrlConfig: configv1alpha1.ProjectConfig{
+				DefaultImages: configv1alpha1.ImagesSpec{
+					KubeRBACProxy: "quay.io/brancz/kube-rbac-proxy",
+				},
+			},
+			tempo: v1alpha1.TempoMonolithic{
+				Spec: v1alpha1.TempoMonolithicSpec{
+					JaegerUI: &v1alpha1.MonolithicJaegerUISpec{
+						Enabled: true,
+						Authentication: &v1alpha1.JaegerQueryAuthenticationSpec{
+							Enabled:  true,
+							Provider: v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy,
+							SAR:      `{"namespace": "default"}`,
+						},
+					},
+				},
+			},
+			warnings: admission.Warnings{jaegerUIDeprecationWarning},
+			errors: field.ErrorList{field.Invalid(
+				field.NewPath("spec", "jaegerui", "authentication", "sar"),
+				`{"namespace": "default"}`,
+				"invalid SAR: the resource must be set",
+			)},
+		},
+		{
+			name: "JaegerUI kube-rbac-proxy authentication with OIDC login and missing Secret",
+			ctrlConfig: configv1alpha1.ProjectConfig{
+				DefaultImages: configv1alpha1.ImagesSpec{
+					KubeRBACProxy: "quay.io/brancz/kube-rbac-proxy",
+					OAuth2Proxy:   "quay.io/oauth2-proxy/oauth2-proxy",
+				},
+			},
+			tempo: v1alpha1.TempoMonolithic{
+				Spec: v1alpha1.TempoMonolithicSpec{
+					JaegerUI: &v1alpha1.MonolithicJaegerUISpec{
+						Enabled: true,
+						Authentication: &v1alpha1.JaegerQueryAuthenticationSpec{
+							Enabled:  true,
+							Provider: v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy,
+							OIDC: &v1alpha1.JaegerUIOIDCSpec{
+								IssuerURL: "https://keycloak.example.com/realms/tempo",
+								Secret:    "oidc",
+							},
+						},
+					},
+				},
+			},
+			warnings: admission.Warnings{jaegerUIDeprecationWarning},
+			errors: field.ErrorList{field.Invalid(
+				field.NewPath("spec", "jaegerui", "authentication", "oidc", "secret"),
+				"oidc",
+				"mock: fails always",
+			)},
+		},
+
+		// namespace-scoped operator
+		{
+			name: "namespace-scoped operator, namespace not watched",
+			ctrlConfig: configv1alpha1.ProjectConfig{
+				WatchNamespaces: []string{"team-a"},
+			},
+			tempo: v1alpha1.TempoMonolithic{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "sample",
+					Namespace: "default",
+				},
+			},
+			warnings: admission.Warnings{},
+			errors: field.ErrorList{field.Forbidden(
+				field.NewPath("metadata", "namespace"),
+				"the operator watches only the namespaces team-a",
+			)},
+		},
+		{
+			name: "namespace-scoped operator, JaegerUI kube-rbac-proxy authentication",
+			ctrlConfig: configv1alpha1.ProjectConfig{
+				DefaultImages: configv1alpha1.ImagesSpec{
+					KubeRBACProxy: "quay.io/brancz/kube-rbac-proxy",
+				},
+				WatchNamespaces: []string{"team-a"},
+			},
+			tempo: v1alpha1.TempoMonolithic{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "sample",
+					Namespace: "team-a",
+				},
+				Spec: v1alpha1.TempoMonolithicSpec{
+					JaegerUI: &v1alpha1.MonolithicJaegerUISpec{
+						Enabled: true,
+						Authentication: &v1alpha1.JaegerQueryAuthenticationSpec{
+							Enabled:  true,
+							Provider: v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy,
+						},
+					},
+				},
+			},
+			warnings: admission.Warnings{jaegerUIDeprecationWarning},
+			errors: field.ErrorList{field.Forbidden(
+				field.NewPath("spec", "jaegerui", "authentication", "provider"),
+				"the kube-rbac-proxy provider requires cluster-scoped objects, which are not supported if the operator is restricted to namespaces",
+			)},
+		},
 
 		// multitenancy
 		{
@@ -150,6 +278,86 @@ func TestMonolithicValidate(t *testing.T) {
 				"spec.tenants.authorization should not be defined in openshift mode",
 			)},
 		},
+		{
+			name: "multi-tenancy enabled, TempoTenant selector set",
+			tempo: v1alpha1.TempoMonolithic{
+				Spec: v1alpha1.TempoMonolithicSpec{
+					Multitenancy: &v1alpha1.MonolithicMultitenancySpec{
+						Enabled: true,
+						TenantsSpec: v1alpha1.TenantsSpec{
+							Mode:                v1alpha1.ModeStatic,
+							TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{},
+						},
+					},
+				},
+			},
+			warnings: admission.Warnings{},
+			errors: field.ErrorList{field.Forbidden(
+				field.NewPath("spec", "multitenancy", "tempoTenantSelector"),
+				"TempoTenants are only supported by TempoStack",
+			)},
+		},
+		{
+			name: "multi-tenancy enabled, kubernetes mode",
+			tempo: v1alpha1.TempoMonolithic{
+				Spec: v1alpha1.TempoMonolithicSpec{
+					Multitenancy: &v1alpha1.MonolithicMultitenancySpec{
+						Enabled: true,
+						TenantsSpec: v1alpha1.TenantsSpec{
+							Mode: v1alpha1.ModeKubernetes,
+						},
+					},
+				},
+			},
+			warnings: admission.Warnings{},
+			errors: field.ErrorList{field.Invalid(
+				field.NewPath("spec", "multitenancy", "mode"),
+				v1alpha1.ModeKubernetes,
+				"kubernetes mode is only supported by TempoStack",
+			)},
+		},
+		{
+			name: "multi-tenancy enabled, mTLS authentication",
+			tempo: v1alpha1.TempoMonolithic{
+				Spec: v1alpha1.TempoMonolithicSpec{
+					Multitenancy: &v1alpha1.MonolithicMultitenancySpec{
+						Enabled: true,
+						TenantsSpec: v1alpha1.TenantsSpec{
+							Mode: v1alpha1.ModeStatic,
+							Authentication: []v1alpha1.AuthenticationSpec{
+								{TenantName: "dev", TenantID: "dev", MTLS: &v1alpha1.MTLSSpec{CA: "ca"}},
+							},
+						},
+					},
+				},
+			},
+			warnings: admission.Warnings{},
+			errors: field.ErrorList{field.Forbidden(
+				field.NewPath("spec", "multitenancy", "authentication").Index(0).Child("mTLS"),
+				"mTLS authentication is only supported by TempoStack",
+			)},
+		},
+		{
+			name: "multi-tenancy enabled, custom OPA policies",
+			tempo: v1alpha1.TempoMonolithic{
+				Spec: v1alpha1.TempoMonolithicSpec{
+					Multitenancy: &v1alpha1.MonolithicMultitenancySpec{
+						Enabled: true,
+						TenantsSpec: v1alpha1.TenantsSpec{
+							Mode: v1alpha1.ModeStatic,
+							Authorization: &v1alpha1.AuthorizationSpec{
+								OPA: &v1alpha1.OPAPolicySpec{ConfigMap: "policies", Package: "tempo"},
+							},
+						},
+					},
+				},
+			},
+			warnings: admission.Warnings{},
+			errors: field.ErrorList{field.Forbidden(
+				field.NewPath("spec", "multitenancy", "authorization", "opa"),
+				"custom OPA policies are only supported by TempoStack",
+			)},
+		},
 		{
 			name: "RBAC and jaeger UI enabled",
 			tempo: v1alpha1.TempoMonolithic{
@@ -346,11 +554,7 @@ func TestMonolithicValidate(t *testing.T) {
 				},
 			},
 			warnings: admission.Warnings{},
-			errors: field.ErrorList{field.Invalid(
-				field.NewPath("spec", "observability", "grafana", "dataSource", "enabled"),
-				true,
-				"creating a data source for Tempo is not support if the gateway is enabled",
-			)},
+			errors:   field.ErrorList{},
 		},
 		{
 			name: "valid observability config",
@@ -421,6 +625,22 @@ func TestMonolithicValidate(t *testing.T) {
 			warnings: admission.Warnings{"overriding Tempo configuration could potentially break the deployment, use it carefully"},
 			errors:   field.ErrorList{},
 		},
+		{
+			name: "extra config with unknown key and operator managed key",
+			tempo: v1alpha1.TempoMonolithic{
+				Spec: v1alpha1.TempoMonolithicSpec{
+					ExtraConfig: &v1alpha1.ExtraConfigSpec{
+						Tempo: apiextensionsv1.JSON{Raw: []byte(`{"querrier": {}, "server": {"http_tls_config": {}}}`)},
+					},
+				},
+			},
+			warnings: admission.Warnings{
+				"overriding Tempo configuration could potentially break the deployment, use it carefully",
+				`spec.extraConfig.tempo.querrier: unknown Tempo configuration key, did you mean "querier"?`,
+				"spec.extraConfig.tempo.server.http_tls_config overrides configuration managed by the operator (TLS)",
+			},
+			errors: field.ErrorList{},
+		},
 	}
 
 	for _, test := range tests {
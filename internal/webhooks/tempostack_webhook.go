@@ -3,14 +3,17 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"maps"
 	"math"
 	"net"
+	"net/url"
 	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/imdario/mergo"
+	"github.com/open-policy-agent/opa/v1/ast"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -27,6 +30,8 @@ import (
 	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
 	"github.com/grafana/tempo-operator/internal/autodetect"
 	"github.com/grafana/tempo-operator/internal/handlers/storage"
+	"github.com/grafana/tempo-operator/internal/manifests/alerts"
+	"github.com/grafana/tempo-operator/internal/manifests/gateway"
 	"github.com/grafana/tempo-operator/internal/manifests/manifestutils"
 	"github.com/grafana/tempo-operator/internal/manifests/naming"
 	"github.com/grafana/tempo-operator/internal/status"
@@ -193,6 +198,10 @@ func (d *Defaulter) Default(ctx context.Context, r *v1alpha1.TempoStack) error {
 
 	if r.Spec.Template.Gateway.Ingress.Type == v1alpha1.IngressTypeRoute && r.Spec.Template.Gateway.Ingress.Route.Termination == "" {
 		r.Spec.Template.Gateway.Ingress.Route.Termination = defaultRouteGatewayTLSTermination
+		// The client certificates of tenants using mTLS must reach the gateway.
+		if gateway.UsesMTLS(r.Spec.Tenants) {
+			r.Spec.Template.Gateway.Ingress.Route.Termination = v1alpha1.TLSRouteTerminationTypePassthrough
+		}
 	}
 
 	// Terminate TLS of the JaegerQuery Route on the Edge by default
@@ -346,6 +355,89 @@ func (v *validator) validateReplicationZones(tempo v1alpha1.TempoStack) field.Er
 	return nil
 }
 
+// validateNamespaceScope rejects instances outside of the watched namespaces and
+// features which require cluster-scoped objects if the operator is namespace-scoped.
+func (v *validator) validateNamespaceScope(tempo v1alpha1.TempoStack) field.ErrorList {
+	if !v.ctrlConfig.IsNamespaceScoped() {
+		return nil
+	}
+
+	errs := validateWatchedNamespace(v.ctrlConfig, tempo.Namespace)
+
+	if tempo.Spec.Template.Gateway.Enabled && tempo.Spec.Tenants != nil &&
+		(tempo.Spec.Tenants.Mode == v1alpha1.ModeOpenShift || tempo.Spec.Tenants.Mode == v1alpha1.ModeKubernetes) {
+		errs = append(errs, namespaceScopedError(
+			field.NewPath("spec", "tenants", "mode"),
+			fmt.Sprintf("the %s tenancy mode", tempo.Spec.Tenants.Mode),
+		))
+	}
+
+	if tempo.Spec.Tenants != nil && tempo.Spec.Tenants.TempoTenantSelector != nil && tempo.Spec.Tenants.TempoTenantSelector.NamespaceSelector != nil {
+		errs = append(errs, namespaceScopedError(
+			field.NewPath("spec", "tenants", "tempoTenantSelector", "namespaceSelector"),
+			"selecting TempoTenants by namespace labels",
+		))
+	}
+
+	if tempo.Spec.Template.QueryFrontend.JaegerQuery.Authentication.IsKubeRBACProxy() {
+		errs = append(errs, namespaceScopedError(
+			field.NewPath("spec", "template", "queryFrontend", "jaegerQuery", "authentication", "provider"),
+			"the kube-rbac-proxy provider",
+		))
+	}
+
+	if tempo.Spec.ZoneAwarenessEnabled() {
+		errs = append(errs, namespaceScopedError(
+			field.NewPath("spec", "replicationZones"),
+			"zone-aware replication",
+		))
+	}
+
+	return errs
+}
+
+// validateJaegerUIAuthentication validates the kube-rbac-proxy authentication and the OIDC login of the Jaeger UI.
+func (v *validator) validateJaegerUIAuthentication(ctx context.Context, tempo v1alpha1.TempoStack) field.ErrorList {
+	jaegerQuery := tempo.Spec.Template.QueryFrontend.JaegerQuery
+	path := field.NewPath("spec", "template", "queryFrontend", "jaegerQuery", "authentication")
+	if !jaegerQuery.Authentication.IsKubeRBACProxy() {
+		return validateJaegerUIOIDC(ctx, v.client, path, tempo.Namespace, jaegerQuery.Authentication, "")
+	}
+
+	if tempo.Spec.Template.Gateway.Enabled {
+		return field.ErrorList{field.Invalid(
+			path.Child("provider"),
+			jaegerQuery.Authentication.Provider,
+			"the kube-rbac-proxy provider cannot be used together with the gateway, the gateway authenticates all requests",
+		)}
+	}
+
+	if !jaegerQuery.Enabled {
+		return field.ErrorList{field.Invalid(
+			path.Child("provider"),
+			jaegerQuery.Authentication.Provider,
+			"jaegerQuery must be enabled to use the kube-rbac-proxy provider",
+		)}
+	}
+
+	image := tempo.Spec.Images.KubeRBACProxy
+	if image == "" {
+		image = v.ctrlConfig.DefaultImages.KubeRBACProxy
+	}
+
+	errs := validateKubeRBACProxy(ctx, v.client, path, jaegerQuery.Authentication, image,
+		jaegerQuery.Ingress.Type == v1alpha1.IngressTypeRoute, v.ctrlConfig.Gates.OpenShift.ServingCertsService)
+	if len(errs) > 0 {
+		return errs
+	}
+
+	oauth2ProxyImage := tempo.Spec.Images.OAuth2Proxy
+	if oauth2ProxyImage == "" {
+		oauth2ProxyImage = v.ctrlConfig.DefaultImages.OAuth2Proxy
+	}
+	return validateJaegerUIOIDC(ctx, v.client, path, tempo.Namespace, jaegerQuery.Authentication, oauth2ProxyImage)
+}
+
 // jaegerQueryDeprecationWarning is returned when the deprecated Jaeger Query component is enabled.
 const jaegerQueryDeprecationWarning = "spec.template.queryFrontend.jaegerQuery.enabled is deprecated and will be removed in a future release"
 
@@ -440,6 +532,15 @@ func (v *validator) validateGateway(ctx context.Context, tempo v1alpha1.TempoSta
 				)}
 			}
 		}
+
+		if tempo.Spec.Tenants != nil && tempo.Spec.Tenants.Mode == v1alpha1.ModeKubernetes && tempo.Spec.Template.Gateway.RBAC.Enabled {
+			// The namespaces of the query RBAC are listed with the OpenShift projects API.
+			return nil, field.ErrorList{field.Invalid(
+				field.NewPath("spec").Child("template").Child("gateway").Child("rbac").Child("enabled"),
+				tempo.Spec.Template.Gateway.RBAC.Enabled,
+				"query RBAC is not supported in kubernetes mode",
+			)}
+		}
 	} else {
 		// Gateway disabled
 
@@ -469,7 +570,9 @@ func (v *validator) validateObservability(tempo v1alpha1.TempoStack) field.Error
 			)}
 	}
 
-	if tempo.Spec.Observability.Metrics.CreatePrometheusRules && !tempo.Spec.Observability.Metrics.CreateServiceMonitors {
+	scrapeMode := tempo.Spec.Observability.Metrics.ScrapeMode
+	serviceMonitorMode := scrapeMode == "" || scrapeMode == v1alpha1.MetricsScrapeModeServiceMonitor
+	if tempo.Spec.Observability.Metrics.CreatePrometheusRules && !tempo.Spec.Observability.Metrics.CreateServiceMonitors && serviceMonitorMode {
 		return field.ErrorList{
 			field.Invalid(metricsBase.Child("createPrometheusRules"), tempo.Spec.Observability.Metrics.CreatePrometheusRules,
 				"the Prometheus rules alert based on collected metrics, therefore the createServiceMonitors feature must be enabled when enabling the createPrometheusRules feature",
@@ -508,14 +611,6 @@ func (v *validator) validateObservability(tempo v1alpha1.TempoStack) field.Error
 			)}
 	}
 
-	if tempo.Spec.Observability.Grafana.CreateDatasource && tempo.Spec.Template.Gateway.Enabled {
-		return field.ErrorList{field.Invalid(
-			grafanaBase.Child("createDatasource"),
-			tempo.Spec.Observability.Grafana.CreateDatasource,
-			"creating a data source for Tempo is not support if the gateway is enabled",
-		)}
-	}
-
 	return nil
 }
 
@@ -531,6 +626,193 @@ func (v *validator) validateTenantConfigs(tempo v1alpha1.TempoStack) field.Error
 	return nil
 }
 
+func (v *validator) validateTempoTenantSelector(tempo v1alpha1.TempoStack) field.ErrorList {
+	if tempo.Spec.Tenants == nil || tempo.Spec.Tenants.TempoTenantSelector == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	selectorPath := field.NewPath("spec", "tenants", "tempoTenantSelector")
+	selector := tempo.Spec.Tenants.TempoTenantSelector
+	if selector.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(selector.NamespaceSelector); err != nil {
+			errs = append(errs, field.Invalid(selectorPath.Child("namespaceSelector"), selector.NamespaceSelector, err.Error()))
+		}
+	}
+	if selector.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(selector.Selector); err != nil {
+			errs = append(errs, field.Invalid(selectorPath.Child("selector"), selector.Selector, err.Error()))
+		}
+	}
+	return errs
+}
+
+func (v *validator) validateGatewayTLS(tempo v1alpha1.TempoStack) field.ErrorList {
+	spec := tempo.Spec.Template.Gateway.TLS
+	if !tempo.Spec.Template.Gateway.Enabled || !spec.Enabled {
+		return nil
+	}
+
+	tlsPath := field.NewPath("spec", "template", "gateway", "tls")
+	if spec.Cert == "" && !v.ctrlConfig.Gates.OpenShift.ServingCertsService {
+		return field.ErrorList{field.Invalid(tlsPath.Child("certName"), spec.Cert, "need to specify cert secret name")}
+	}
+	if spec.Cert != "" && spec.CA == "" {
+		return field.ErrorList{field.Invalid(tlsPath.Child("caName"), spec.CA, "need to specify the CA ConfigMap name of the certificate")}
+	}
+	return nil
+}
+
+func (v *validator) validateMTLS(ctx context.Context, tempo v1alpha1.TempoStack) field.ErrorList {
+	if !tempo.Spec.Template.Gateway.Enabled || !gateway.UsesMTLS(tempo.Spec.Tenants) {
+		return nil
+	}
+
+	var errs field.ErrorList
+	if !tempo.Spec.Template.Gateway.TLS.Enabled {
+		errs = append(errs, field.Invalid(
+			field.NewPath("spec", "template", "gateway", "tls", "enabled"),
+			tempo.Spec.Template.Gateway.TLS.Enabled,
+			"TLS must be enabled on the gateway to use mTLS authentication",
+		))
+	}
+
+	ingress := tempo.Spec.Template.Gateway.Ingress
+	if ingress.Type == v1alpha1.IngressTypeRoute && ingress.Route.Termination != v1alpha1.TLSRouteTerminationTypePassthrough {
+		errs = append(errs, field.Invalid(
+			field.NewPath("spec", "template", "gateway", "ingress", "route", "termination"),
+			ingress.Route.Termination,
+			"the route termination must be passthrough to use mTLS authentication",
+		))
+	}
+
+	for i, auth := range tempo.Spec.Tenants.Authentication {
+		if auth.MTLS == nil {
+			continue
+		}
+
+		caPath := field.NewPath("spec", "tenants", "authentication").Index(i).Child("mTLS", "caName")
+		if auth.MTLS.CA == "" {
+			errs = append(errs, field.Required(caPath, "the CA ConfigMap is required"))
+			continue
+		}
+
+		configMap := &corev1.ConfigMap{}
+		err := v.client.Get(ctx, types.NamespacedName{Namespace: tempo.Namespace, Name: auth.MTLS.CA}, configMap)
+		if err != nil {
+			errs = append(errs, field.Invalid(caPath, auth.MTLS.CA, err.Error()))
+		}
+	}
+	return errs
+}
+
+// validateOPAPolicies verifies that the Rego policies of the ConfigMap compile and that a policy declares the package.
+func (v *validator) validateOPAPolicies(ctx context.Context, tempo v1alpha1.TempoStack) field.ErrorList {
+	if !tempo.Spec.Template.Gateway.Enabled || !gateway.UsesOPAPolicies(tempo.Spec.Tenants) {
+		return nil
+	}
+
+	opaPath := field.NewPath("spec", "tenants", "authorization", "opa")
+	spec := tempo.Spec.Tenants.Authorization.OPA
+	if v.ctrlConfig.DefaultImages.OPA == "" {
+		return field.ErrorList{field.Invalid(opaPath, spec.ConfigMap,
+			fmt.Sprintf("the OPA image is not configured, please set the %s environment variable of the operator", configv1alpha1.EnvRelatedImageOPA),
+		)}
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err := v.client.Get(ctx, types.NamespacedName{Namespace: tempo.Namespace, Name: spec.ConfigMap}, configMap)
+	if err != nil {
+		return field.ErrorList{field.Invalid(opaPath.Child("configMap"), spec.ConfigMap, err.Error())}
+	}
+
+	policies := map[string]string{}
+	for key, policy := range configMap.Data {
+		if strings.HasSuffix(key, ".rego") {
+			policies[key] = policy
+		}
+	}
+	if len(policies) == 0 {
+		return field.ErrorList{field.Invalid(opaPath.Child("configMap"), spec.ConfigMap,
+			"the ConfigMap does not contain any Rego policy, the keys of the policies must have the .rego suffix",
+		)}
+	}
+
+	compiler, err := ast.CompileModules(policies)
+	if err != nil {
+		return field.ErrorList{field.Invalid(opaPath.Child("configMap"), spec.ConfigMap,
+			fmt.Sprintf("the Rego policies do not compile: %s", err.Error()),
+		)}
+	}
+
+	packageDeclared := false
+	for _, module := range compiler.Modules {
+		if strings.TrimPrefix(module.Package.Path.String(), "data.") == spec.Package {
+			packageDeclared = true
+		}
+	}
+	if !packageDeclared {
+		return field.ErrorList{field.Invalid(opaPath.Child("package"), spec.Package,
+			fmt.Sprintf("no policy of the ConfigMap %s declares this package", spec.ConfigMap),
+		)}
+	}
+	return nil
+}
+
+func validateRequestRateLimit(spec *v1alpha1.RequestRateLimitSpec, path *field.Path) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+	if spec.Window.Duration < 0 || spec.Window.Duration%time.Millisecond != 0 {
+		return field.ErrorList{field.Invalid(path.Child("window"), spec.Window.Duration.String(),
+			"the window must be a positive multiple of 1ms",
+		)}
+	}
+	return nil
+}
+
+func (v *validator) validateGatewayRateLimits(tempo v1alpha1.TempoStack) field.ErrorList {
+	spec := tempo.Spec.Template.Gateway.RateLimits
+	if !tempo.Spec.Template.Gateway.Enabled || spec == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	rateLimitsPath := field.NewPath("spec", "template", "gateway", "rateLimits")
+	errs = append(errs, validateRequestRateLimit(spec.Global.Write, rateLimitsPath.Child("global", "write"))...)
+	errs = append(errs, validateRequestRateLimit(spec.Global.Read, rateLimitsPath.Child("global", "read"))...)
+
+	tenantNames := map[string]bool{}
+	if tempo.Spec.Tenants != nil {
+		for _, auth := range tempo.Spec.Tenants.Authentication {
+			tenantNames[auth.TenantName] = true
+		}
+	}
+	tenants := slices.Sorted(maps.Keys(spec.PerTenant))
+	for _, tenant := range tenants {
+		tenantPath := rateLimitsPath.Child("perTenant").Key(tenant)
+		// The tenants of TempoTenant resources are only known during the reconciliation.
+		if !tenantNames[tenant] && (tempo.Spec.Tenants == nil || tempo.Spec.Tenants.TempoTenantSelector == nil) {
+			errs = append(errs, field.NotFound(tenantPath, tenant))
+		}
+		errs = append(errs, validateRequestRateLimit(spec.PerTenant[tenant].Write, tenantPath.Child("write"))...)
+		errs = append(errs, validateRequestRateLimit(spec.PerTenant[tenant].Read, tenantPath.Child("read"))...)
+	}
+
+	if spec.Concurrency != nil && spec.Concurrency.BacklogDuration.Duration < 0 {
+		errs = append(errs, field.Invalid(rateLimitsPath.Child("concurrency", "backlogDuration"), spec.Concurrency.BacklogDuration.Duration.String(),
+			"the backlog duration must not be negative",
+		))
+	}
+
+	if spec.Service.Enabled && v.ctrlConfig.DefaultImages.Gubernator == "" {
+		errs = append(errs, field.Invalid(rateLimitsPath.Child("service", "enabled"), spec.Service.Enabled,
+			fmt.Sprintf("the rate limit service image is not configured, please set the %s environment variable of the operator", configv1alpha1.EnvRelatedImageGubernator),
+		))
+	}
+	return errs
+}
+
 func (v *validator) validateDeprecatedFields(tempo v1alpha1.TempoStack) field.ErrorList {
 	if tempo.Spec.LimitSpec.Global.Query.MaxSearchBytesPerTrace != nil {
 		return field.ErrorList{
@@ -601,6 +883,68 @@ func (v *validator) validateMetricsGenerator(tempo v1alpha1.TempoStack) field.Er
 	return nil
 }
 
+func (v *validator) validateUserConfigurableOverrides(tempo v1alpha1.TempoStack) field.ErrorList {
+	uco := tempo.Spec.UserConfigurableOverrides
+	if uco == nil || !uco.Enabled || uco.PollInterval == nil {
+		return nil
+	}
+
+	if uco.PollInterval.Duration <= 0 {
+		return field.ErrorList{
+			field.Invalid(field.NewPath("spec", "userConfigurableOverrides", "pollInterval"), uco.PollInterval.Duration.String(), "pollInterval must be greater than zero"),
+		}
+	}
+
+	return nil
+}
+
+// validateComponentExtraConfig validates the component-specific extra configuration and arguments.
+func (v *validator) validateComponentExtraConfig(tempo v1alpha1.TempoStack) (admission.Warnings, field.ErrorList) {
+	templatePath := field.NewPath("spec", "template")
+	components := []struct {
+		name string
+		spec v1alpha1.TempoComponentSpec
+	}{
+		{name: "distributor", spec: tempo.Spec.Template.Distributor.TempoComponentSpec},
+		{name: "ingester", spec: tempo.Spec.Template.Ingester},
+		{name: "querier", spec: tempo.Spec.Template.Querier},
+		{name: "queryFrontend", spec: tempo.Spec.Template.QueryFrontend.TempoComponentSpec},
+		{name: "compactor", spec: tempo.Spec.Template.Compactor},
+		{name: "metricsGenerator", spec: tempo.Spec.Template.MetricsGenerator.TempoComponentSpec},
+		{name: "gateway", spec: tempo.Spec.Template.Gateway.TempoComponentSpec},
+	}
+
+	var warnings admission.Warnings
+	var errs field.ErrorList
+	for _, component := range components {
+		componentPath := templatePath.Child(component.name)
+
+		if component.spec.ExtraConfig != nil && len(component.spec.ExtraConfig.Tempo.Raw) > 0 {
+			if component.name == "gateway" {
+				errs = append(errs, field.Forbidden(
+					componentPath.Child("extraConfig"),
+					"extra Tempo configuration is not supported for the gateway",
+				))
+			} else {
+				w, e := validateExtraConfig(component.spec.ExtraConfig, componentPath.Child("extraConfig"))
+				warnings = append(warnings, w...)
+				errs = append(errs, e...)
+			}
+		}
+
+		for i, arg := range component.spec.ExtraArgs {
+			if strings.HasPrefix(arg, "-config.file") || strings.HasPrefix(arg, "--config.file") {
+				warnings = append(warnings, fmt.Sprintf(
+					"%s overrides the configuration file managed by the operator",
+					componentPath.Child("extraArgs").Index(i),
+				))
+			}
+		}
+	}
+
+	return warnings, errs
+}
+
 func (v *validator) validateConflictWithMonolithic(ctx context.Context, tempo *v1alpha1.TempoStack) field.ErrorList {
 	return validateTempoNameConflict(func() error {
 		monolithic := &v1alpha1.TempoMonolithic{}
@@ -623,6 +967,7 @@ func (v *validator) validate(ctx context.Context, tempo *v1alpha1.TempoStack) (a
 	}
 
 	allErrors = append(allErrors, validateName(tempo.Name)...)
+	allErrors = append(allErrors, v.validateNamespaceScope(*tempo)...)
 	allErrors = append(allErrors, v.validateServiceAccount(ctx, *tempo)...)
 	addValidationResults(v.validateStorage(ctx, *tempo))
 
@@ -630,9 +975,11 @@ func (v *validator) validate(ctx context.Context, tempo *v1alpha1.TempoStack) (a
 		allWarnings = append(allWarnings, admission.Warnings{
 			"override tempo configuration could potentially break the stack, use it carefully",
 		}...)
-
+		addValidationResults(validateExtraConfig(tempo.Spec.ExtraConfig, field.NewPath("spec", "extraConfig")))
 	}
 
+	addValidationResults(validateOverrides(tempo.Spec.Overrides, field.NewPath("spec", "overrides")))
+
 	allErrors = append(allErrors, v.validateSize(*tempo)...)
 
 	// Warn if both size and resources.total are specified (size takes precedence)
@@ -645,13 +992,34 @@ func (v *validator) validate(ctx context.Context, tempo *v1alpha1.TempoStack) (a
 	allErrors = append(allErrors, v.validateReplicationFactor(*tempo)...)
 	allErrors = append(allErrors, v.validateReplicationZones(*tempo)...)
 	allErrors = append(allErrors, v.validateQueryFrontend(*tempo)...)
+	allErrors = append(allErrors, v.validateJaegerUIAuthentication(ctx, *tempo)...)
 	allWarnings = append(allWarnings, v.validateJaegerQueryDeprecation(*tempo)...)
 	addValidationResults(v.validateGateway(ctx, *tempo))
 	allErrors = append(allErrors, v.validateTenantConfigs(*tempo)...)
+	allErrors = append(allErrors, v.validateTempoTenantSelector(*tempo)...)
+	allErrors = append(allErrors, v.validateGatewayTLS(*tempo)...)
+	allErrors = append(allErrors, v.validateMTLS(ctx, *tempo)...)
+	allErrors = append(allErrors, v.validateOPAPolicies(ctx, *tempo)...)
+	allErrors = append(allErrors, v.validateGatewayRateLimits(*tempo)...)
 	allErrors = append(allErrors, v.validateObservability(*tempo)...)
+	allErrors = append(allErrors, alerts.Validate(tempo.Spec.Observability.Metrics.Alerts,
+		field.NewPath("spec", "observability", "metrics", "alerts"))...)
+	allErrors = append(allErrors, validateOTLPTracing(tempo.Spec.Observability.Tracing.OTLP, tempo.Spec.Template.Gateway.Enabled,
+		field.NewPath("spec", "observability", "tracing", "otlp"))...)
+	addValidationResults(validateMetricsScrape(tempo.Spec.Observability.Metrics, v.ctrlConfig.Gates.HTTPEncryption,
+		field.NewPath("spec", "observability", "metrics")))
+	allErrors = append(allErrors, validateGrafanaDataSourceLinks(tempo.Spec.Observability.Grafana.GrafanaDataSourceLinksSpec,
+		field.NewPath("spec", "observability", "grafana"))...)
+	allErrors = append(allErrors, validateGrafanaDataSourceAuthentication(tempo.Spec.Observability.Grafana.Authentication,
+		field.NewPath("spec", "observability", "grafana", "authentication"))...)
+	addValidationResults(validateGrafanaDashboards(tempo.Spec.Observability.Grafana.Dashboards, v.ctrlConfig.Gates.GrafanaOperator,
+		field.NewPath("spec", "observability", "grafana", "dashboards")))
 	allErrors = append(allErrors, v.validateDeprecatedFields(*tempo)...)
 	allErrors = append(allErrors, v.validateReceiverTLS(*tempo)...)
 	allErrors = append(allErrors, v.validateMetricsGenerator(*tempo)...)
+	allErrors = append(allErrors, v.validateUserConfigurableOverrides(*tempo)...)
+	addValidationResults(v.validateComponentExtraConfig(*tempo))
+	allErrors = append(allErrors, validateTuning(tempo.Spec.Tuning, field.NewPath("spec", "tuning"))...)
 	allErrors = append(allErrors, v.validateConflictWithMonolithic(ctx, tempo)...)
 
 	if len(allErrors) == 0 {
@@ -662,7 +1030,11 @@ func (v *validator) validate(ctx context.Context, tempo *v1alpha1.TempoStack) (a
 
 func validateTenantsOICD(spec *v1alpha1.TenantsSpec) error {
 	for _, authSpec := range spec.Authentication {
-		if authSpec.OIDC == nil {
+		if authSpec.OIDC != nil && authSpec.MTLS != nil {
+			return fmt.Errorf("spec.tenants.authentication.oidc and spec.tenants.authentication.mTLS cannot be defined for the same tenant")
+		}
+		// Tenants using mTLS authentication don't need OIDC.
+		if authSpec.OIDC == nil && authSpec.MTLS == nil {
 			return fmt.Errorf("spec.tenants.authorization.oidc is required for each tenant in static mode")
 		}
 	}
@@ -675,37 +1047,62 @@ func ValidateTenantConfigs(tenants *v1alpha1.TenantsSpec, gatewayEnabled bool) e
 		return nil
 	}
 
+	if tenants.Kubernetes != nil {
+		if tenants.Mode != v1alpha1.ModeKubernetes {
+			return fmt.Errorf("spec.tenants.kubernetes is only supported in kubernetes mode")
+		}
+		if tenants.Kubernetes.IssuerURL != "" {
+			u, err := url.Parse(tenants.Kubernetes.IssuerURL)
+			if err != nil || u.Scheme != "https" || u.Host == "" {
+				return fmt.Errorf("spec.tenants.kubernetes.issuerURL must be a valid https URL")
+			}
+		}
+	}
+
 	if tenants.Mode == v1alpha1.ModeStatic {
 		// If the static mode is combined with the gateway, we will need the following fields
 		// otherwise this will just enable tempo multitenancy without the gateway
 		if gatewayEnabled {
-			if tenants.Authentication == nil {
-				return fmt.Errorf("spec.tenants.authentication is required in static mode")
-			}
-
-			if tenants.Authorization == nil {
-				return fmt.Errorf("spec.tenants.authorization is required in static mode")
-			}
-
-			if tenants.Authorization.Roles == nil {
-				return fmt.Errorf("spec.tenants.authorization.roles is required in static mode")
-			}
-
-			if tenants.Authorization.RoleBindings == nil {
-				return fmt.Errorf("spec.tenants.authorization.roleBindings is required in static mode")
+			// Tenants and their authorization can also be defined by TempoTenant resources.
+			if tenants.TempoTenantSelector == nil {
+				if tenants.Authentication == nil {
+					return fmt.Errorf("spec.tenants.authentication is required in static mode")
+				}
+
+				if tenants.Authorization == nil {
+					return fmt.Errorf("spec.tenants.authorization is required in static mode")
+				}
+
+				// Custom OPA policies replace the static roles and role bindings.
+				if tenants.Authorization.OPA == nil {
+					if tenants.Authorization.Roles == nil {
+						return fmt.Errorf("spec.tenants.authorization.roles is required in static mode")
+					}
+
+					if tenants.Authorization.RoleBindings == nil {
+						return fmt.Errorf("spec.tenants.authorization.roleBindings is required in static mode")
+					}
+				}
 			}
 			return validateTenantsOICD(tenants)
 		}
-	} else if tenants.Mode == v1alpha1.ModeOpenShift {
+	} else if tenants.Mode == v1alpha1.ModeOpenShift || tenants.Mode == v1alpha1.ModeKubernetes {
+		// Both modes authorize the tenants with the Kubernetes RBAC of the cluster.
+		if tenants.TempoTenantSelector != nil {
+			return fmt.Errorf("spec.tenants.tempoTenantSelector is only supported in static mode")
+		}
 		if !gatewayEnabled {
-			return fmt.Errorf("openshift mode requires gateway enabled")
+			return fmt.Errorf("%s mode requires gateway enabled", tenants.Mode)
 		}
 		if tenants.Authorization != nil {
-			return fmt.Errorf("spec.tenants.authorization should not be defined in openshift mode")
+			return fmt.Errorf("spec.tenants.authorization should not be defined in %s mode", tenants.Mode)
 		}
 		for _, auth := range tenants.Authentication {
 			if auth.OIDC != nil {
-				return fmt.Errorf("spec.tenants.authentication.oidc should not be defined in openshift mode")
+				return fmt.Errorf("spec.tenants.authentication.oidc should not be defined in %s mode", tenants.Mode)
+			}
+			if auth.MTLS != nil {
+				return fmt.Errorf("spec.tenants.authentication.mTLS is only supported in static mode")
 			}
 		}
 	}
@@ -2200,6 +2200,29 @@ func TestValidateGatewayAndJaegerQuery(t *testing.T) {
 				),
 			},
 		},
+		{
+			name: "invalid configuration, rbac in kubernetes mode",
+			input: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{
+							Enabled: true,
+							RBAC: v1alpha1.RBACSpec{
+								Enabled: true,
+							},
+						},
+					},
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode: v1alpha1.ModeKubernetes,
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "template", "gateway", "rbac", "enabled"), true,
+					"query RBAC is not supported in kubernetes mode",
+				),
+			},
+		},
 		{
 			name: "warn for non-multitenancy instance on OpenShift",
 			ctrlConfig: configv1alpha1.ProjectConfig{
@@ -2328,6 +2351,25 @@ func TestValidateTenantConfigs(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("spec.tenants.authorization.roleBindings is required in static mode"),
 		},
+		{
+			name: "static with OPA policies and without roles",
+			input: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode: v1alpha1.ModeStatic,
+						Authorization: &v1alpha1.AuthorizationSpec{
+							OPA: &v1alpha1.OPAPolicySpec{ConfigMap: "policies", Package: "tempo"},
+						},
+						Authentication: []v1alpha1.AuthenticationSpec{},
+					},
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{
+							Enabled: true,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "openshift: RBAC should not be defined",
 			input: v1alpha1.TempoStack{
@@ -2391,6 +2433,199 @@ func TestValidateTenantConfigs(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("spec.tenants.authorization.oidc is required for each tenant in static mode"),
 		},
+		{
+			name: "static: tenants defined by TempoTenants",
+			input: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode:                v1alpha1.ModeStatic,
+						TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{},
+					},
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{
+							Enabled: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "openshift: TempoTenants are not supported",
+			input: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode:                v1alpha1.ModeOpenShift,
+						TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{},
+					},
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{
+							Enabled: true,
+						},
+					},
+				},
+			},
+			wantErr: fmt.Errorf("spec.tenants.tempoTenantSelector is only supported in static mode"),
+		},
+		{
+			name: "kubernetes: valid",
+			input: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode: v1alpha1.ModeKubernetes,
+						Authentication: []v1alpha1.AuthenticationSpec{
+							{TenantName: "dev", TenantID: "dev"},
+						},
+					},
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{
+							Enabled: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "kubernetes: gateway must be enabled",
+			input: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode: v1alpha1.ModeKubernetes,
+					},
+				},
+			},
+			wantErr: fmt.Errorf("kubernetes mode requires gateway enabled"),
+		},
+		{
+			name: "kubernetes: RBAC should not be defined",
+			input: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode:          v1alpha1.ModeKubernetes,
+						Authorization: &v1alpha1.AuthorizationSpec{},
+					},
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{
+							Enabled: true,
+						},
+					},
+				},
+			},
+			wantErr: fmt.Errorf("spec.tenants.authorization should not be defined in kubernetes mode"),
+		},
+		{
+			name: "kubernetes: OIDC should not be defined",
+			input: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode: v1alpha1.ModeKubernetes,
+						Authentication: []v1alpha1.AuthenticationSpec{
+							{
+								OIDC: &v1alpha1.OIDCSpec{},
+							},
+						},
+					},
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{
+							Enabled: true,
+						},
+					},
+				},
+			},
+			wantErr: fmt.Errorf("spec.tenants.authentication.oidc should not be defined in kubernetes mode"),
+		},
+		{
+			name: "kubernetes: issuer in static mode",
+			input: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode:       v1alpha1.ModeStatic,
+						Kubernetes: &v1alpha1.KubernetesTenantsSpec{IssuerURL: "https://kubernetes.default.svc"},
+					},
+				},
+			},
+			wantErr: fmt.Errorf("spec.tenants.kubernetes is only supported in kubernetes mode"),
+		},
+		{
+			name: "kubernetes: invalid issuer",
+			input: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode:       v1alpha1.ModeKubernetes,
+						Kubernetes: &v1alpha1.KubernetesTenantsSpec{IssuerURL: "http://kubernetes.default.svc"},
+					},
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{
+							Enabled: true,
+						},
+					},
+				},
+			},
+			wantErr: fmt.Errorf("spec.tenants.kubernetes.issuerURL must be a valid https URL"),
+		},
+		{
+			name: "static: mTLS instead of OIDC",
+			input: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode: v1alpha1.ModeStatic,
+						Authorization: &v1alpha1.AuthorizationSpec{
+							Roles:        []v1alpha1.RoleSpec{},
+							RoleBindings: []v1alpha1.RoleBindingsSpec{},
+						},
+						Authentication: []v1alpha1.AuthenticationSpec{
+							{MTLS: &v1alpha1.MTLSSpec{CA: "ca"}},
+						},
+					},
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{
+							Enabled: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "static: OIDC and mTLS defined",
+			input: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode: v1alpha1.ModeStatic,
+						Authorization: &v1alpha1.AuthorizationSpec{
+							Roles:        []v1alpha1.RoleSpec{},
+							RoleBindings: []v1alpha1.RoleBindingsSpec{},
+						},
+						Authentication: []v1alpha1.AuthenticationSpec{
+							{OIDC: &v1alpha1.OIDCSpec{}, MTLS: &v1alpha1.MTLSSpec{CA: "ca"}},
+						},
+					},
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{
+							Enabled: true,
+						},
+					},
+				},
+			},
+			wantErr: fmt.Errorf("spec.tenants.authentication.oidc and spec.tenants.authentication.mTLS cannot be defined for the same tenant"),
+		},
+		{
+			name: "openshift: mTLS is not supported",
+			input: v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode: v1alpha1.ModeOpenShift,
+						Authentication: []v1alpha1.AuthenticationSpec{
+							{MTLS: &v1alpha1.MTLSSpec{CA: "ca"}},
+						},
+					},
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{
+							Enabled: true,
+						},
+					},
+				},
+			},
+			wantErr: fmt.Errorf("spec.tenants.authentication.mTLS is only supported in static mode"),
+		},
 	}
 
 	for _, tc := range tt {
@@ -2656,13 +2891,7 @@ func TestValidatorObservabilityGrafana(t *testing.T) {
 					GrafanaOperator: true,
 				},
 			},
-			expected: field.ErrorList{
-				field.Invalid(
-					field.NewPath("spec").Child("observability").Child("grafana").Child("createDatasource"),
-					true,
-					"creating a data source for Tempo is not support if the gateway is enabled",
-				),
-			},
+			expected: nil,
 		},
 	}
 
@@ -3040,6 +3269,39 @@ func TestWarning(t *testing.T) {
 				"override tempo configuration could potentially break the stack, use it carefully",
 			},
 		},
+		{
+			name: "warning for extra config overriding operator managed configuration",
+			input: &v1alpha1.TempoStack{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-obj",
+					Namespace: "abc",
+				},
+				TypeMeta: gvType,
+				Spec: v1alpha1.TempoStackSpec{
+					ServiceAccount: naming.DefaultServiceAccountName("test-obj"),
+					Storage: v1alpha1.ObjectStorageSpec{
+						Secret: v1alpha1.ObjectStorageSecretSpec{
+							Name: "not-found",
+						},
+					},
+					Template: v1alpha1.TempoTemplateSpec{
+						Ingester: v1alpha1.TempoComponentSpec{
+							Replicas: func(i int32) *int32 { return &i }(1),
+						},
+					},
+					ExtraConfig: &v1alpha1.ExtraConfigSpec{
+						Tempo: v1.JSON{Raw: []byte(`{"storage": {"trace": {"backend": "local"}}}`)},
+					},
+				},
+			},
+			client: &k8sFake{
+				secret: &corev1.Secret{},
+			},
+			expected: admission.Warnings{
+				"override tempo configuration could potentially break the stack, use it carefully",
+				"spec.extraConfig.tempo.storage.trace.backend overrides configuration managed by the operator (storage)",
+			},
+		},
 		{
 			name: "warning for the deprecated jaeger query",
 			input: &v1alpha1.TempoStack{
@@ -3350,3 +3612,1297 @@ func TestValidateJaegerQueryDeprecation(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTuning(t *testing.T) {
+	path := field.NewPath("spec", "tuning")
+
+	tests := []struct {
+		name     string
+		input    *v1alpha1.TuningSpec
+		expected field.ErrorList
+	}{
+		{
+			name:     "no tuning",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name: "valid tuning",
+			input: &v1alpha1.TuningSpec{
+				Compaction: &v1alpha1.CompactionTuningSpec{
+					CompactionWindow:     &metav1.Duration{Duration: time.Hour},
+					MaxCompactionObjects: ptr.To(1000),
+					MaxBlockBytes:        ptr.To(100 * 1024 * 1024),
+				},
+				Ingestion: &v1alpha1.IngestionTuningSpec{
+					MaxBlockDuration: &metav1.Duration{Duration: 5 * time.Minute},
+					MaxBlockBytes:    ptr.To(100 * 1024 * 1024),
+					TraceIdlePeriod:  &metav1.Duration{Duration: 10 * time.Second},
+				},
+				Query: &v1alpha1.QueryTuningSpec{
+					TraceByIDQueryShards:    ptr.To(50),
+					SearchConcurrentJobs:    ptr.To(1000),
+					MetricsConcurrentJobs:   ptr.To(1000),
+					MaxOutstandingPerTenant: ptr.To(2000),
+					MaxConcurrentQueries:    ptr.To(20),
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "compaction out of bounds",
+			input: &v1alpha1.TuningSpec{
+				Compaction: &v1alpha1.CompactionTuningSpec{
+					CompactionWindow:     &metav1.Duration{Duration: 48 * time.Hour},
+					MaxCompactionObjects: ptr.To(0),
+					MaxBlockBytes:        ptr.To(1024),
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(path.Child("compaction", "compactionWindow"), "48h0m0s", "must be between 1m0s and 24h0m0s"),
+				field.Invalid(path.Child("compaction", "maxCompactionObjects"), 0, "must be greater than 0"),
+				field.Invalid(path.Child("compaction", "maxBlockBytes"), 1024, "must be at least 1048576 bytes"),
+			},
+		},
+		{
+			name: "trace idle period exceeds max block duration",
+			input: &v1alpha1.TuningSpec{
+				Ingestion: &v1alpha1.IngestionTuningSpec{
+					MaxBlockDuration: &metav1.Duration{Duration: 2 * time.Minute},
+					TraceIdlePeriod:  &metav1.Duration{Duration: 5 * time.Minute},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(path.Child("ingestion", "traceIdlePeriod"), "5m0s", "must be between 1s and 2m0s"),
+			},
+		},
+		{
+			name: "trace idle period exceeds default max block duration",
+			input: &v1alpha1.TuningSpec{
+				Ingestion: &v1alpha1.IngestionTuningSpec{
+					TraceIdlePeriod: &metav1.Duration{Duration: 15 * time.Minute},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(path.Child("ingestion", "traceIdlePeriod"), "15m0s", "must be between 1s and 10m0s"),
+			},
+		},
+		{
+			name: "query out of bounds",
+			input: &v1alpha1.TuningSpec{
+				Query: &v1alpha1.QueryTuningSpec{
+					TraceByIDQueryShards: ptr.To(1),
+					SearchConcurrentJobs: ptr.To(-1),
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(path.Child("query", "traceByIDQueryShards"), 1, "must be between 2 and 100000"),
+				field.Invalid(path.Child("query", "searchConcurrentJobs"), -1, "must be greater than 0"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := validateTuning(tc.input, path)
+			assert.Equal(t, tc.expected, errs)
+		})
+	}
+}
+
+func TestValidateComponentExtraConfig(t *testing.T) {
+	validator := &validator{}
+	templatePath := field.NewPath("spec", "template")
+
+	tests := []struct {
+		name     string
+		input    v1alpha1.TempoTemplateSpec
+		warnings admission.Warnings
+		errors   field.ErrorList
+	}{
+		{
+			name:  "no component extra config",
+			input: v1alpha1.TempoTemplateSpec{},
+		},
+		{
+			name: "valid component extra config and args",
+			input: v1alpha1.TempoTemplateSpec{
+				Querier: v1alpha1.TempoComponentSpec{
+					ExtraConfig: &v1alpha1.ExtraConfigSpec{
+						Tempo: v1.JSON{Raw: []byte(`{"querier": {"max_concurrent_queries": 50}}`)},
+					},
+					ExtraArgs: []string{"-log.level=debug"},
+				},
+			},
+		},
+		{
+			name: "invalid component extra config",
+			input: v1alpha1.TempoTemplateSpec{
+				Compactor: v1alpha1.TempoComponentSpec{
+					ExtraConfig: &v1alpha1.ExtraConfigSpec{
+						Tempo: v1.JSON{Raw: []byte(`{"compactor": {"compaction": {"block_retention": 5}, "disabled": "yes"}}`)},
+					},
+				},
+			},
+			errors: field.ErrorList{
+				field.Invalid(templatePath.Child("compactor", "extraConfig", "tempo", "compactor", "disabled"), "yes", "must be a boolean"),
+			},
+		},
+		{
+			name: "extra config is not supported for the gateway",
+			input: v1alpha1.TempoTemplateSpec{
+				Gateway: v1alpha1.TempoGatewaySpec{
+					TempoComponentSpec: v1alpha1.TempoComponentSpec{
+						ExtraConfig: &v1alpha1.ExtraConfigSpec{
+							Tempo: v1.JSON{Raw: []byte(`{"server": {}}`)},
+						},
+						ExtraArgs: []string{"--log.level=debug"},
+					},
+				},
+			},
+			errors: field.ErrorList{
+				field.Forbidden(templatePath.Child("gateway", "extraConfig"), "extra Tempo configuration is not supported for the gateway"),
+			},
+		},
+		{
+			name: "warning for operator managed configuration",
+			input: v1alpha1.TempoTemplateSpec{
+				QueryFrontend: v1alpha1.TempoQueryFrontendSpec{
+					TempoComponentSpec: v1alpha1.TempoComponentSpec{
+						ExtraConfig: &v1alpha1.ExtraConfigSpec{
+							Tempo: v1.JSON{Raw: []byte(`{"server": {"http_tls_config": {}}}`)},
+						},
+						ExtraArgs: []string{"-config.file=/tmp/tempo.yaml"},
+					},
+				},
+			},
+			warnings: admission.Warnings{
+				"spec.template.queryFrontend.extraConfig.tempo.server.http_tls_config overrides configuration managed by the operator (TLS)",
+				"spec.template.queryFrontend.extraArgs[0] overrides the configuration file managed by the operator",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings, errs := validator.validateComponentExtraConfig(v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{Template: tc.input},
+			})
+			assert.Equal(t, tc.warnings, warnings)
+			assert.Equal(t, tc.errors, errs)
+		})
+	}
+}
+
+func TestValidateOverrides(t *testing.T) {
+	path := field.NewPath("spec", "overrides")
+	warning := "overriding the generated objects could potentially break the deployment, use it carefully"
+
+	tests := []struct {
+		name     string
+		input    []v1alpha1.ObjectOverrideSpec
+		warnings admission.Warnings
+		errors   field.ErrorList
+	}{
+		{
+			name: "no overrides",
+		},
+		{
+			name: "valid override",
+			input: []v1alpha1.ObjectOverrideSpec{{
+				Target: v1alpha1.ObjectOverrideTarget{Kind: "Service", Component: "distributor"},
+				Patch:  v1.JSON{Raw: []byte(`{"metadata": {"annotations": {"team": "a"}}}`)},
+			}},
+			warnings: admission.Warnings{warning},
+		},
+		{
+			name: "patch of an immutable field",
+			input: []v1alpha1.ObjectOverrideSpec{{
+				Target: v1alpha1.ObjectOverrideTarget{Kind: "StatefulSet"},
+				Type:   v1alpha1.ObjectOverridePatchTypeJSON,
+				Patch:  v1.JSON{Raw: []byte(`[{"op": "remove", "path": "/spec/volumeClaimTemplates/0"}]`)},
+			}},
+			warnings: admission.Warnings{warning},
+			errors: field.ErrorList{
+				field.Forbidden(path.Index(0).Child("patch").Index(0), "the field spec.volumeClaimTemplates must not be patched"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings, errs := validateOverrides(tc.input, path)
+			assert.Equal(t, tc.warnings, warnings)
+			assert.Equal(t, tc.errors, errs)
+		})
+	}
+}
+
+func TestValidateGrafanaDataSourceLinks(t *testing.T) {
+	path := field.NewPath("spec", "observability", "grafana")
+
+	tests := []struct {
+		name     string
+		input    v1alpha1.GrafanaDataSourceLinksSpec
+		expected field.ErrorList
+	}{
+		{
+			name: "no links",
+		},
+		{
+			name: "valid time shifts",
+			input: v1alpha1.GrafanaDataSourceLinksSpec{
+				TracesToLogs:    &v1alpha1.GrafanaTracesToLogsSpec{DataSourceUID: "loki", SpanStartTimeShift: "-1h", SpanEndTimeShift: "30m"},
+				TracesToMetrics: &v1alpha1.GrafanaTracesToMetricsSpec{DataSourceUID: "prometheus"},
+				ServiceMap:      &v1alpha1.GrafanaServiceMapSpec{DataSourceUID: "prometheus"},
+			},
+		},
+		{
+			name: "invalid time shifts",
+			input: v1alpha1.GrafanaDataSourceLinksSpec{
+				TracesToLogs:    &v1alpha1.GrafanaTracesToLogsSpec{DataSourceUID: "loki", SpanEndTimeShift: "1 hour"},
+				TracesToMetrics: &v1alpha1.GrafanaTracesToMetricsSpec{DataSourceUID: "prometheus", SpanStartTimeShift: "-1"},
+			},
+			expected: field.ErrorList{
+				field.Invalid(path.Child("tracesToLogs", "spanEndTimeShift"), "1 hour", "must be a duration, e.g. -1h or 30m"),
+				field.Invalid(path.Child("tracesToMetrics", "spanStartTimeShift"), "-1", "must be a duration, e.g. -1h or 30m"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, validateGrafanaDataSourceLinks(tc.input, path))
+		})
+	}
+}
+
+func TestValidateGrafanaDataSourceAuthentication(t *testing.T) {
+	path := field.NewPath("spec", "observability", "grafana", "authentication")
+
+	tests := []struct {
+		name     string
+		input    *v1alpha1.GrafanaDataSourceAuthenticationSpec
+		expected field.ErrorList
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name:  "forward OAuth identity",
+			input: &v1alpha1.GrafanaDataSourceAuthenticationSpec{Method: v1alpha1.GrafanaDataSourceAuthenticationForwardOAuthIdentity},
+		},
+		{
+			name: "service account token",
+			input: &v1alpha1.GrafanaDataSourceAuthenticationSpec{
+				Method:      v1alpha1.GrafanaDataSourceAuthenticationServiceAccountToken,
+				TokenSecret: "grafana-token",
+			},
+		},
+		{
+			name:  "service account token without secret",
+			input: &v1alpha1.GrafanaDataSourceAuthenticationSpec{Method: v1alpha1.GrafanaDataSourceAuthenticationServiceAccountToken},
+			expected: field.ErrorList{
+				field.Required(path.Child("tokenSecret"), "the token secret must be set if the method is serviceAccountToken"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, validateGrafanaDataSourceAuthentication(tc.input, path))
+		})
+	}
+}
+
+func TestValidateMetricsScrape(t *testing.T) {
+	path := field.NewPath("spec", "observability", "metrics")
+
+	tests := []struct {
+		name             string
+		input            v1alpha1.MetricsConfigSpec
+		httpEncryption   bool
+		expectedWarnings admission.Warnings
+		expected         field.ErrorList
+	}{
+		{
+			name:  "service monitors",
+			input: v1alpha1.MetricsConfigSpec{CreateServiceMonitors: true},
+		},
+		{
+			name: "scrape tuning",
+			input: v1alpha1.MetricsConfigSpec{
+				CreateServiceMonitors: true,
+				MetricsScrapeSpec: v1alpha1.MetricsScrapeSpec{
+					ScrapeInterval:    &metav1.Duration{Duration: time.Minute},
+					ScrapeTimeout:     &metav1.Duration{Duration: 10 * time.Second},
+					MetricRelabelings: []v1alpha1.MetricRelabelConfig{{Regex: "tempo_.*"}},
+				},
+			},
+		},
+		{
+			name: "service monitors with annotations scrape mode",
+			input: v1alpha1.MetricsConfigSpec{
+				CreateServiceMonitors: true,
+				ScrapeMode:            v1alpha1.MetricsScrapeModeAnnotations,
+			},
+			expected: field.ErrorList{
+				field.Invalid(path.Child("createServiceMonitors"), true, "ServiceMonitors are not created if the scrape mode is annotations"),
+			},
+		},
+		{
+			name: "scrape timeout greater than interval",
+			input: v1alpha1.MetricsConfigSpec{
+				MetricsScrapeSpec: v1alpha1.MetricsScrapeSpec{
+					ScrapeInterval: &metav1.Duration{Duration: 10 * time.Second},
+					ScrapeTimeout:  &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(path.Child("scrapeTimeout"), "1m0s", "must not be greater than the scrape interval"),
+			},
+		},
+		{
+			name: "invalid relabel regex",
+			input: v1alpha1.MetricsConfigSpec{
+				MetricsScrapeSpec: v1alpha1.MetricsScrapeSpec{
+					MetricRelabelings: []v1alpha1.MetricRelabelConfig{{Regex: "tempo_("}},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(path.Child("metricRelabelings").Index(0).Child("regex"), "tempo_(", "error parsing regexp: missing closing ): `tempo_(`"),
+			},
+		},
+		{
+			name: "annotations scrape mode with tuning and HTTP encryption",
+			input: v1alpha1.MetricsConfigSpec{
+				ScrapeMode: v1alpha1.MetricsScrapeModeAnnotations,
+				MetricsScrapeSpec: v1alpha1.MetricsScrapeSpec{
+					SampleLimit: ptr.To(1000),
+				},
+			},
+			httpEncryption: true,
+			expectedWarnings: admission.Warnings{
+				"scrapeInterval, scrapeTimeout, sampleLimit and metricRelabelings are ignored if the scrape mode is annotations",
+				"the metrics endpoints require a client certificate, which is not supported by annotation-based scraping, use the otelCollector scrape mode instead",
+			},
+		},
+		{
+			name: "OpenTelemetry Collector scrape mode with HTTP encryption",
+			input: v1alpha1.MetricsConfigSpec{
+				ScrapeMode: v1alpha1.MetricsScrapeModeOTelCollector,
+			},
+			httpEncryption: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings, errs := validateMetricsScrape(tc.input, tc.httpEncryption, path)
+			assert.Equal(t, tc.expectedWarnings, warnings)
+			assert.Equal(t, tc.expected, errs)
+		})
+	}
+}
+
+func TestValidateOTLPTracing(t *testing.T) {
+	path := field.NewPath("spec", "observability", "tracing", "otlp")
+
+	tests := []struct {
+		name           string
+		input          *v1alpha1.OTLPTracingSpec
+		gatewayEnabled bool
+		expected       field.ErrorList
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name: "endpoint",
+			input: &v1alpha1.OTLPTracingSpec{
+				Endpoint:           "https://collector.observability.svc:4317",
+				SamplerArgument:    "0.25",
+				ResourceAttributes: map[string]string{"k8s.cluster.name": "prod"},
+			},
+		},
+		{
+			name:           "gateway",
+			input:          &v1alpha1.OTLPTracingSpec{Gateway: &v1alpha1.OTLPTracingGatewaySpec{Tenant: "dev"}},
+			gatewayEnabled: true,
+		},
+		{
+			name:  "neither endpoint nor gateway",
+			input: &v1alpha1.OTLPTracingSpec{},
+			expected: field.ErrorList{
+				field.Required(path.Child("endpoint"), "either the endpoint or the gateway must be set"),
+			},
+		},
+		{
+			name: "endpoint and gateway",
+			input: &v1alpha1.OTLPTracingSpec{
+				Endpoint: "http://collector:4317",
+				Gateway:  &v1alpha1.OTLPTracingGatewaySpec{Tenant: "dev"},
+			},
+			gatewayEnabled: true,
+			expected: field.ErrorList{
+				field.Invalid(path.Child("endpoint"), "http://collector:4317", "the endpoint and the gateway must not be set at the same time"),
+			},
+		},
+		{
+			name:  "endpoint without scheme",
+			input: &v1alpha1.OTLPTracingSpec{Endpoint: "collector:4317"},
+			expected: field.ErrorList{
+				field.Invalid(path.Child("endpoint"), "collector:4317", "must be a URL with the scheme http or https"),
+			},
+		},
+		{
+			name:  "gateway disabled",
+			input: &v1alpha1.OTLPTracingSpec{Gateway: &v1alpha1.OTLPTracingGatewaySpec{Tenant: "dev"}},
+			expected: field.ErrorList{
+				field.Invalid(path.Child("gateway"), "dev", "the gateway must be enabled to send the traces to the gateway"),
+			},
+		},
+		{
+			name: "invalid sampler argument and resource attribute",
+			input: &v1alpha1.OTLPTracingSpec{
+				Endpoint:           "http://collector:4317",
+				SamplerArgument:    "2",
+				ResourceAttributes: map[string]string{"a=b": "c"},
+			},
+			expected: field.ErrorList{
+				field.Invalid(path.Child("samplerArgument"), "2", "must be a number between 0 and 1"),
+				field.Invalid(path.Child("resourceAttributes").Key("a=b"), "a=b", "must not be empty or contain the characters , and ="),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, validateOTLPTracing(tc.input, tc.gatewayEnabled, path))
+		})
+	}
+}
+
+func TestValidateGrafanaDashboards(t *testing.T) {
+	path := field.NewPath("spec", "observability", "grafana", "dashboards")
+
+	tests := []struct {
+		name            string
+		input           *v1alpha1.GrafanaDashboardsSpec
+		grafanaOperator bool
+		warnings        admission.Warnings
+		err             string
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name:  "disabled",
+			input: &v1alpha1.GrafanaDashboardsSpec{ExtraLabels: map[string]string{"invalid label": "1"}},
+		},
+		{
+			name: "GrafanaDashboard objects",
+			input: &v1alpha1.GrafanaDashboardsSpec{
+				Enabled:          true,
+				InstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"dashboards": "grafana"}},
+			},
+			grafanaOperator: true,
+		},
+		{
+			name: "instance selector without Grafana Operator",
+			input: &v1alpha1.GrafanaDashboardsSpec{
+				Enabled:          true,
+				InstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"dashboards": "grafana"}},
+			},
+			warnings: admission.Warnings{
+				"spec.observability.grafana.dashboards.instanceSelector is ignored, the dashboards are created as ConfigMaps because the grafanaOperator feature gate is disabled",
+			},
+		},
+		{
+			name: "invalid extra labels",
+			input: &v1alpha1.GrafanaDashboardsSpec{
+				Enabled:     true,
+				ExtraLabels: map[string]string{"grafana_dashboard": "invalid value"},
+			},
+			err: `spec.observability.grafana.dashboards.extraLabels: Invalid value: "invalid value": a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings, errs := validateGrafanaDashboards(tc.input, tc.grafanaOperator, path)
+			assert.Equal(t, tc.warnings, warnings)
+			if tc.err != "" {
+				assert.EqualError(t, errs.ToAggregate(), tc.err)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestValidateUserConfigurableOverrides(t *testing.T) {
+	v := &validator{ctrlConfig: configv1alpha1.ProjectConfig{}}
+
+	tests := []struct {
+		name     string
+		input    *v1alpha1.UserConfigurableOverridesSpec
+		expected field.ErrorList
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name:  "enabled without poll interval",
+			input: &v1alpha1.UserConfigurableOverridesSpec{Enabled: true},
+		},
+		{
+			name:  "valid poll interval",
+			input: &v1alpha1.UserConfigurableOverridesSpec{Enabled: true, PollInterval: &metav1.Duration{Duration: 30 * time.Second}},
+		},
+		{
+			name:  "invalid poll interval",
+			input: &v1alpha1.UserConfigurableOverridesSpec{Enabled: true, PollInterval: &metav1.Duration{Duration: 0}},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "userConfigurableOverrides", "pollInterval"), "0s", "pollInterval must be greater than zero"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := v.validateUserConfigurableOverrides(v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					UserConfigurableOverrides: tc.input,
+				},
+			})
+			assert.Equal(t, tc.expected, errs)
+		})
+	}
+}
+
+func TestValidateTempoTenantSelector(t *testing.T) {
+	v := &validator{ctrlConfig: configv1alpha1.ProjectConfig{}}
+	selectorPath := field.NewPath("spec", "tenants", "tempoTenantSelector")
+	invalidSelector := &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "team", Operator: "invalid"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		input    *v1alpha1.TenantsSpec
+		expected field.ErrorList
+	}{
+		{
+			name: "no tenants",
+		},
+		{
+			name: "valid selectors",
+			input: &v1alpha1.TenantsSpec{
+				Mode: v1alpha1.ModeStatic,
+				TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tempo": "approved"}},
+					Selector:          &metav1.LabelSelector{},
+				},
+			},
+		},
+		{
+			name: "invalid selectors",
+			input: &v1alpha1.TenantsSpec{
+				Mode: v1alpha1.ModeStatic,
+				TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{
+					NamespaceSelector: invalidSelector,
+					Selector:          invalidSelector,
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(selectorPath.Child("namespaceSelector"), invalidSelector, `"invalid" is not a valid label selector operator`),
+				field.Invalid(selectorPath.Child("selector"), invalidSelector, `"invalid" is not a valid label selector operator`),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := v.validateTempoTenantSelector(v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: tc.input,
+				},
+			})
+			assert.Equal(t, tc.expected, errs)
+		})
+	}
+}
+
+func TestValidateGatewayTLS(t *testing.T) {
+	tlsPath := field.NewPath("spec", "template", "gateway", "tls")
+	tests := []struct {
+		name       string
+		tls        v1alpha1.TLSSpec
+		ctrlConfig configv1alpha1.ProjectConfig
+		expected   field.ErrorList
+	}{
+		{
+			name: "TLS disabled",
+		},
+		{
+			name: "certificate and CA",
+			tls:  v1alpha1.TLSSpec{Enabled: true, Cert: "cert", CA: "ca"},
+		},
+		{
+			name:     "missing certificate",
+			tls:      v1alpha1.TLSSpec{Enabled: true},
+			expected: field.ErrorList{field.Invalid(tlsPath.Child("certName"), "", "need to specify cert secret name")},
+		},
+		{
+			name: "serving certificates",
+			tls:  v1alpha1.TLSSpec{Enabled: true},
+			ctrlConfig: configv1alpha1.ProjectConfig{
+				Gates: configv1alpha1.FeatureGates{
+					OpenShift: configv1alpha1.OpenShiftFeatureGates{ServingCertsService: true},
+				},
+			},
+		},
+		{
+			name:     "missing CA",
+			tls:      v1alpha1.TLSSpec{Enabled: true, Cert: "cert"},
+			expected: field.ErrorList{field.Invalid(tlsPath.Child("caName"), "", "need to specify the CA ConfigMap name of the certificate")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := &validator{ctrlConfig: test.ctrlConfig}
+			tempo := v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{Enabled: true, TLS: test.tls},
+					},
+				},
+			}
+			assert.Equal(t, test.expected, v.validateGatewayTLS(tempo))
+		})
+	}
+}
+
+func TestValidateMTLS(t *testing.T) {
+	caConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "observability"}}
+	tenants := func(mtls *v1alpha1.MTLSSpec) *v1alpha1.TenantsSpec {
+		return &v1alpha1.TenantsSpec{
+			Mode: v1alpha1.ModeStatic,
+			Authentication: []v1alpha1.AuthenticationSpec{
+				{TenantName: "dev", TenantID: "dev", MTLS: mtls},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		tenants  *v1alpha1.TenantsSpec
+		gateway  v1alpha1.TempoGatewaySpec
+		client   client.Client
+		expected field.ErrorList
+	}{
+		{
+			name:    "no mTLS",
+			tenants: tenants(nil),
+			gateway: v1alpha1.TempoGatewaySpec{Enabled: true},
+		},
+		{
+			name:    "valid",
+			tenants: tenants(&v1alpha1.MTLSSpec{CA: "ca"}),
+			gateway: v1alpha1.TempoGatewaySpec{
+				Enabled: true,
+				TLS:     v1alpha1.TLSSpec{Enabled: true, Cert: "cert", CA: "ca"},
+				Ingress: v1alpha1.IngressSpec{
+					Type:  v1alpha1.IngressTypeRoute,
+					Route: v1alpha1.RouteSpec{Termination: v1alpha1.TLSRouteTerminationTypePassthrough},
+				},
+			},
+			client: &k8sFake{configmap: caConfigMap},
+		},
+		{
+			name:    "gateway TLS disabled and route terminated on the edge",
+			tenants: tenants(&v1alpha1.MTLSSpec{CA: "ca"}),
+			gateway: v1alpha1.TempoGatewaySpec{
+				Enabled: true,
+				Ingress: v1alpha1.IngressSpec{
+					Type:  v1alpha1.IngressTypeRoute,
+					Route: v1alpha1.RouteSpec{Termination: v1alpha1.TLSRouteTerminationTypeEdge},
+				},
+			},
+			client: &k8sFake{configmap: caConfigMap},
+			expected: field.ErrorList{
+				field.Invalid(
+					field.NewPath("spec", "template", "gateway", "tls", "enabled"),
+					false,
+					"TLS must be enabled on the gateway to use mTLS authentication",
+				),
+				field.Invalid(
+					field.NewPath("spec", "template", "gateway", "ingress", "route", "termination"),
+					v1alpha1.TLSRouteTerminationTypeEdge,
+					"the route termination must be passthrough to use mTLS authentication",
+				),
+			},
+		},
+		{
+			name:    "missing CA name",
+			tenants: tenants(&v1alpha1.MTLSSpec{}),
+			gateway: v1alpha1.TempoGatewaySpec{
+				Enabled: true,
+				TLS:     v1alpha1.TLSSpec{Enabled: true, Cert: "cert", CA: "ca"},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "tenants", "authentication").Index(0).Child("mTLS", "caName"), "the CA ConfigMap is required"),
+			},
+		},
+		{
+			name:    "CA does not exist",
+			tenants: tenants(&v1alpha1.MTLSSpec{CA: "ca"}),
+			gateway: v1alpha1.TempoGatewaySpec{
+				Enabled: true,
+				TLS:     v1alpha1.TLSSpec{Enabled: true, Cert: "cert", CA: "ca"},
+			},
+			client: &k8sFake{},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "tenants", "authentication").Index(0).Child("mTLS", "caName"), "ca", "mock: fails always"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := &validator{client: test.client}
+			tempo := v1alpha1.TempoStack{
+				ObjectMeta: metav1.ObjectMeta{Name: "simplest", Namespace: "observability"},
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants:  test.tenants,
+					Template: v1alpha1.TempoTemplateSpec{Gateway: test.gateway},
+				},
+			}
+			assert.Equal(t, test.expected, v.validateMTLS(context.Background(), tempo))
+		})
+	}
+}
+
+func TestValidateOPAPolicies(t *testing.T) {
+	policies := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "policies", Namespace: "observability"},
+			Data:       data,
+		}
+	}
+	opaPath := field.NewPath("spec", "tenants", "authorization", "opa")
+
+	tests := []struct {
+		name     string
+		opa      *v1alpha1.OPAPolicySpec
+		image    string
+		client   client.Client
+		expected field.ErrorList
+	}{
+		{
+			name:  "no OPA policies",
+			image: "openpolicyagent/opa",
+		},
+		{
+			name:  "valid",
+			opa:   &v1alpha1.OPAPolicySpec{ConfigMap: "policies", Package: "tempo.authz"},
+			image: "openpolicyagent/opa",
+			client: &k8sFake{configmap: policies(map[string]string{
+				"team.rego": "package tempo.authz\n\ndefault allow := false\n",
+			})},
+		},
+		{
+			name: "OPA image not configured",
+			opa:  &v1alpha1.OPAPolicySpec{ConfigMap: "policies", Package: "tempo"},
+			expected: field.ErrorList{
+				field.Invalid(opaPath, "policies", "the OPA image is not configured, please set the RELATED_IMAGE_OPA environment variable of the operator"),
+			},
+		},
+		{
+			name:   "ConfigMap does not exist",
+			opa:    &v1alpha1.OPAPolicySpec{ConfigMap: "policies", Package: "tempo"},
+			image:  "openpolicyagent/opa",
+			client: &k8sFake{},
+			expected: field.ErrorList{
+				field.Invalid(opaPath.Child("configMap"), "policies", "mock: fails always"),
+			},
+		},
+		{
+			name:   "no Rego policies",
+			opa:    &v1alpha1.OPAPolicySpec{ConfigMap: "policies", Package: "tempo"},
+			image:  "openpolicyagent/opa",
+			client: &k8sFake{configmap: policies(map[string]string{"policy.txt": "package tempo"})},
+			expected: field.ErrorList{
+				field.Invalid(opaPath.Child("configMap"), "policies", "the ConfigMap does not contain any Rego policy, the keys of the policies must have the .rego suffix"),
+			},
+		},
+		{
+			name:   "policies do not compile",
+			opa:    &v1alpha1.OPAPolicySpec{ConfigMap: "policies", Package: "tempo"},
+			image:  "openpolicyagent/opa",
+			client: &k8sFake{configmap: policies(map[string]string{"team.rego": "package tempo\n\nallow {\n\tinput.tenant == \"dev\"\n}\n"})},
+			expected: field.ErrorList{
+				field.Invalid(opaPath.Child("configMap"), "policies", "the Rego policies do not compile: 1 error occurred: team.rego:3: rego_parse_error: `if` keyword is required before rule body"),
+			},
+		},
+		{
+			name:   "package not declared",
+			opa:    &v1alpha1.OPAPolicySpec{ConfigMap: "policies", Package: "tempo"},
+			image:  "openpolicyagent/opa",
+			client: &k8sFake{configmap: policies(map[string]string{"team.rego": "package tempo.authz"})},
+			expected: field.ErrorList{
+				field.Invalid(opaPath.Child("package"), "tempo", "no policy of the ConfigMap policies declares this package"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := &validator{client: test.client}
+			v.ctrlConfig.DefaultImages.OPA = test.image
+			tempo := v1alpha1.TempoStack{
+				ObjectMeta: metav1.ObjectMeta{Name: "simplest", Namespace: "observability"},
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode:          v1alpha1.ModeStatic,
+						Authorization: &v1alpha1.AuthorizationSpec{OPA: test.opa},
+					},
+					Template: v1alpha1.TempoTemplateSpec{Gateway: v1alpha1.TempoGatewaySpec{Enabled: true}},
+				},
+			}
+			assert.Equal(t, test.expected, v.validateOPAPolicies(context.Background(), tempo))
+		})
+	}
+}
+
+func TestValidateJaegerUIAuthentication(t *testing.T) {
+	ctx := admission.NewContextWithRequest(context.Background(), admission.Request{})
+	path := field.NewPath("spec", "template", "queryFrontend", "jaegerQuery", "authentication")
+	allowed := &k8sFake{
+		subjectAccessReview: &authorizationv1.SubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: true},
+		},
+	}
+	kubeRBACProxy := v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy
+	oidc := &v1alpha1.JaegerUIOIDCSpec{
+		IssuerURL: "https://keycloak.example.com/realms/tempo",
+		Secret:    "oidc",
+	}
+	oidcSecret := func(cookieSecret string) *corev1.Secret {
+		return &corev1.Secret{
+			Data: map[string][]byte{
+				"clientID":     []byte("tempo"),
+				"clientSecret": []byte("secret"),
+				"cookieSecret": []byte(cookieSecret),
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		provider         v1alpha1.JaegerUIAuthenticationProvider
+		sar              string
+		gateway          bool
+		ingressType      v1alpha1.IngressType
+		image            string
+		oauth2ProxyImage string
+		oidc             *v1alpha1.JaegerUIOIDCSpec
+		servingCerts     bool
+		client           client.Client
+		expected         field.ErrorList
+	}{
+		{
+			name: "oauth-proxy",
+		},
+		{
+			name:     "valid",
+			provider: kubeRBACProxy,
+			sar:      `{"namespace": "observability", "resource": "pods", "verb": "get"}`,
+			image:    "quay.io/brancz/kube-rbac-proxy",
+			client:   allowed,
+		},
+		{
+			name:     "gateway enabled",
+			provider: kubeRBACProxy,
+			gateway:  true,
+			image:    "quay.io/brancz/kube-rbac-proxy",
+			expected: field.ErrorList{
+				field.Invalid(path.Child("provider"), kubeRBACProxy, "the kube-rbac-proxy provider cannot be used together with the gateway, the gateway authenticates all requests"),
+			},
+		},
+		{
+			name:     "image not configured",
+			provider: kubeRBACProxy,
+			expected: field.ErrorList{
+				field.Invalid(path.Child("provider"), kubeRBACProxy, "please configure the kube-rbac-proxy image of the operator to use the kube-rbac-proxy provider"),
+			},
+		},
+		{
+			name:        "route without serving certificates",
+			provider:    kubeRBACProxy,
+			ingressType: v1alpha1.IngressTypeRoute,
+			image:       "quay.io/brancz/kube-rbac-proxy",
+			expected: field.ErrorList{
+				field.Invalid(path.Child("provider"), kubeRBACProxy, "please enable the featureGates.openshift.servingCertsService feature gate to use the kube-rbac-proxy provider with a Route"),
+			},
+		},
+		{
+			name:         "route with serving certificates",
+			provider:     kubeRBACProxy,
+			ingressType:  v1alpha1.IngressTypeRoute,
+			image:        "quay.io/brancz/kube-rbac-proxy",
+			servingCerts: true,
+			client:       allowed,
+		},
+		{
+			name:     "unsupported verb",
+			provider: kubeRBACProxy,
+			sar:      `{"namespace": "observability", "resource": "pods", "verb": "list"}`,
+			image:    "quay.io/brancz/kube-rbac-proxy",
+			expected: field.ErrorList{
+				field.Invalid(path.Child("sar"), `{"namespace": "observability", "resource": "pods", "verb": "list"}`,
+					`invalid SAR: kube-rbac-proxy derives the verb from the HTTP method of the request, the verb "list" is not supported`),
+			},
+		},
+		{
+			name:     "missing permissions",
+			provider: kubeRBACProxy,
+			image:    "quay.io/brancz/kube-rbac-proxy",
+			client:   &k8sFake{},
+			expected: field.ErrorList{
+				field.Invalid(path.Child("provider"), kubeRBACProxy, "Cannot enable the kube-rbac-proxy provider: failed to create subject access review: mock: fails always"),
+			},
+		},
+		{
+			name:             "valid OIDC login",
+			provider:         kubeRBACProxy,
+			image:            "quay.io/brancz/kube-rbac-proxy",
+			oauth2ProxyImage: "quay.io/oauth2-proxy/oauth2-proxy",
+			oidc:             oidc,
+			client:           &k8sFake{subjectAccessReview: allowed.subjectAccessReview, secret: oidcSecret("0123456789abcdef")},
+		},
+		{
+			name:             "valid OIDC login with base64 encoded cookie secret",
+			provider:         kubeRBACProxy,
+			image:            "quay.io/brancz/kube-rbac-proxy",
+			oauth2ProxyImage: "quay.io/oauth2-proxy/oauth2-proxy",
+			oidc:             oidc,
+			client:           &k8sFake{subjectAccessReview: allowed.subjectAccessReview, secret: oidcSecret("MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=")},
+		},
+		{
+			name:     "OIDC login with oauth-proxy",
+			oidc:     oidc,
+			expected: field.ErrorList{field.Invalid(path.Child("provider"), v1alpha1.JaegerUIAuthenticationProvider(""), "the OIDC login is only supported by the kube-rbac-proxy provider")},
+		},
+		{
+			name:     "OIDC login without oauth2-proxy image",
+			provider: kubeRBACProxy,
+			image:    "quay.io/brancz/kube-rbac-proxy",
+			oidc:     oidc,
+			client:   allowed,
+			expected: field.ErrorList{field.Invalid(path.Child("oidc"), oidc.IssuerURL, "the oauth2-proxy image is not configured, please set the RELATED_IMAGE_OAUTH2_PROXY environment variable of the operator")},
+		},
+		{
+			name:             "OIDC login with HTTP issuer",
+			provider:         kubeRBACProxy,
+			image:            "quay.io/brancz/kube-rbac-proxy",
+			oauth2ProxyImage: "quay.io/oauth2-proxy/oauth2-proxy",
+			oidc:             &v1alpha1.JaegerUIOIDCSpec{IssuerURL: "http://keycloak", Secret: "oidc"},
+			client:           allowed,
+			expected:         field.ErrorList{field.Invalid(path.Child("oidc", "issuerURL"), "http://keycloak", "the issuer URL must be a valid https URL")},
+		},
+		{
+			name:             "OIDC login without Secret",
+			provider:         kubeRBACProxy,
+			image:            "quay.io/brancz/kube-rbac-proxy",
+			oauth2ProxyImage: "quay.io/oauth2-proxy/oauth2-proxy",
+			oidc:             oidc,
+			client:           allowed,
+			expected:         field.ErrorList{field.Invalid(path.Child("oidc", "secret"), "oidc", "mock: fails always")},
+		},
+		{
+			name:             "OIDC login with missing key",
+			provider:         kubeRBACProxy,
+			image:            "quay.io/brancz/kube-rbac-proxy",
+			oauth2ProxyImage: "quay.io/oauth2-proxy/oauth2-proxy",
+			oidc:             oidc,
+			client:           &k8sFake{subjectAccessReview: allowed.subjectAccessReview, secret: oidcSecret("")},
+			expected:         field.ErrorList{field.Invalid(path.Child("oidc", "secret"), "oidc", "the Secret must contain the key cookieSecret")},
+		},
+		{
+			name:             "OIDC login with invalid cookie secret",
+			provider:         kubeRBACProxy,
+			image:            "quay.io/brancz/kube-rbac-proxy",
+			oauth2ProxyImage: "quay.io/oauth2-proxy/oauth2-proxy",
+			oidc:             oidc,
+			client:           &k8sFake{subjectAccessReview: allowed.subjectAccessReview, secret: oidcSecret("short")},
+			expected:         field.ErrorList{field.Invalid(path.Child("oidc", "secret"), "oidc", "the cookieSecret of the Secret must have 16, 24 or 32 bytes")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := &validator{client: test.client}
+			v.ctrlConfig.DefaultImages.KubeRBACProxy = test.image
+			v.ctrlConfig.DefaultImages.OAuth2Proxy = test.oauth2ProxyImage
+			v.ctrlConfig.Gates.OpenShift.ServingCertsService = test.servingCerts
+			tempo := v1alpha1.TempoStack{
+				ObjectMeta: metav1.ObjectMeta{Name: "simplest", Namespace: "observability"},
+				Spec: v1alpha1.TempoStackSpec{
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{Enabled: test.gateway},
+						QueryFrontend: v1alpha1.TempoQueryFrontendSpec{
+							JaegerQuery: v1alpha1.JaegerQuerySpec{
+								Enabled: true,
+								Authentication: &v1alpha1.JaegerQueryAuthenticationSpec{
+									Enabled:  true,
+									Provider: test.provider,
+									SAR:      test.sar,
+									OIDC:     test.oidc,
+								},
+								Ingress: v1alpha1.IngressSpec{Type: test.ingressType},
+							},
+						},
+					},
+				},
+			}
+			assert.Equal(t, test.expected, v.validateJaegerUIAuthentication(ctx, tempo))
+		})
+	}
+}
+
+func TestValidateGatewayRateLimits(t *testing.T) {
+	rateLimitsPath := field.NewPath("spec", "template", "gateway", "rateLimits")
+	tests := []struct {
+		name       string
+		rateLimits *v1alpha1.GatewayRateLimitsSpec
+		selector   *v1alpha1.TempoTenantSelectorSpec
+		image      string
+		expected   field.ErrorList
+	}{
+		{
+			name: "no rate limits",
+		},
+		{
+			name: "valid",
+			rateLimits: &v1alpha1.GatewayRateLimitsSpec{
+				Global: v1alpha1.GatewayRequestRateLimitsSpec{
+					Write: &v1alpha1.RequestRateLimitSpec{Requests: 100, Window: metav1.Duration{Duration: time.Minute}},
+				},
+				PerTenant: map[string]v1alpha1.GatewayRequestRateLimitsSpec{
+					"dev": {Read: &v1alpha1.RequestRateLimitSpec{Requests: 10}},
+				},
+				Concurrency: &v1alpha1.GatewayConcurrencyLimitSpec{MaxRequests: 100},
+				Service:     v1alpha1.GatewayRateLimitServiceSpec{Enabled: true},
+			},
+			image: "ghcr.io/gubernator-io/gubernator",
+		},
+		{
+			name: "invalid window",
+			rateLimits: &v1alpha1.GatewayRateLimitsSpec{
+				Global: v1alpha1.GatewayRequestRateLimitsSpec{
+					Read: &v1alpha1.RequestRateLimitSpec{Requests: 10, Window: metav1.Duration{Duration: time.Microsecond}},
+				},
+				PerTenant: map[string]v1alpha1.GatewayRequestRateLimitsSpec{
+					"dev": {Write: &v1alpha1.RequestRateLimitSpec{Requests: 10, Window: metav1.Duration{Duration: -time.Second}}},
+				},
+				Concurrency: &v1alpha1.GatewayConcurrencyLimitSpec{MaxRequests: 100, BacklogDuration: metav1.Duration{Duration: -time.Second}},
+			},
+			expected: field.ErrorList{
+				field.Invalid(rateLimitsPath.Child("global", "read", "window"), "1µs", "the window must be a positive multiple of 1ms"),
+				field.Invalid(rateLimitsPath.Child("perTenant").Key("dev").Child("write", "window"), "-1s", "the window must be a positive multiple of 1ms"),
+				field.Invalid(rateLimitsPath.Child("concurrency", "backlogDuration"), "-1s", "the backlog duration must not be negative"),
+			},
+		},
+		{
+			name: "unknown tenant",
+			rateLimits: &v1alpha1.GatewayRateLimitsSpec{
+				PerTenant: map[string]v1alpha1.GatewayRequestRateLimitsSpec{
+					"prod": {Read: &v1alpha1.RequestRateLimitSpec{Requests: 10}},
+				},
+			},
+			expected: field.ErrorList{
+				field.NotFound(rateLimitsPath.Child("perTenant").Key("prod"), "prod"),
+			},
+		},
+		{
+			name: "tenant of a TempoTenant",
+			rateLimits: &v1alpha1.GatewayRateLimitsSpec{
+				PerTenant: map[string]v1alpha1.GatewayRequestRateLimitsSpec{
+					"prod": {Read: &v1alpha1.RequestRateLimitSpec{Requests: 10}},
+				},
+			},
+			selector: &v1alpha1.TempoTenantSelectorSpec{},
+		},
+		{
+			name: "rate limit service image not configured",
+			rateLimits: &v1alpha1.GatewayRateLimitsSpec{
+				Service: v1alpha1.GatewayRateLimitServiceSpec{Enabled: true},
+			},
+			expected: field.ErrorList{
+				field.Invalid(rateLimitsPath.Child("service", "enabled"), true, "the rate limit service image is not configured, please set the RELATED_IMAGE_GUBERNATOR environment variable of the operator"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := &validator{}
+			v.ctrlConfig.DefaultImages.Gubernator = test.image
+			tempo := v1alpha1.TempoStack{
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode: v1alpha1.ModeStatic,
+						Authentication: []v1alpha1.AuthenticationSpec{
+							{TenantName: "dev", TenantID: "dev"},
+						},
+						TempoTenantSelector: test.selector,
+					},
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{Enabled: true, RateLimits: test.rateLimits},
+					},
+				},
+			}
+			assert.Equal(t, test.expected, v.validateGatewayRateLimits(tempo))
+		})
+	}
+}
+
+func TestDefaultRouteTerminationMTLS(t *testing.T) {
+	defaulter := &Defaulter{}
+	tempo := &v1alpha1.TempoStack{
+		Spec: v1alpha1.TempoStackSpec{
+			Tenants: &v1alpha1.TenantsSpec{
+				Mode: v1alpha1.ModeStatic,
+				Authentication: []v1alpha1.AuthenticationSpec{
+					{TenantName: "dev", TenantID: "dev", MTLS: &v1alpha1.MTLSSpec{CA: "ca"}},
+				},
+			},
+			Template: v1alpha1.TempoTemplateSpec{
+				Gateway: v1alpha1.TempoGatewaySpec{
+					Enabled: true,
+					Ingress: v1alpha1.IngressSpec{Type: v1alpha1.IngressTypeRoute},
+				},
+			},
+		},
+	}
+	require.NoError(t, defaulter.Default(context.Background(), tempo))
+	assert.Equal(t, v1alpha1.TLSRouteTerminationTypePassthrough, tempo.Spec.Template.Gateway.Ingress.Route.Termination)
+}
+
+func TestValidateNamespaceScope(t *testing.T) {
+	namespaceScoped := configv1alpha1.ProjectConfig{
+		WatchNamespaces: []string{"team-a", "team-b"},
+	}
+
+	tests := []struct {
+		name       string
+		ctrlConfig configv1alpha1.ProjectConfig
+		tempo      v1alpha1.TempoStack
+		expected   field.ErrorList
+	}{
+		{
+			name: "cluster-scoped operator",
+			tempo: v1alpha1.TempoStack{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "observability"},
+				Spec: v1alpha1.TempoStackSpec{
+					ReplicationZones: []v1alpha1.ZoneSpec{{TopologyKey: "topology.kubernetes.io/zone"}},
+				},
+			},
+		},
+		{
+			name:       "watched namespace",
+			ctrlConfig: namespaceScoped,
+			tempo: v1alpha1.TempoStack{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-a"},
+			},
+		},
+		{
+			name:       "namespace not watched",
+			ctrlConfig: namespaceScoped,
+			tempo: v1alpha1.TempoStack{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "observability"},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("metadata", "namespace"), "the operator watches only the namespaces team-a, team-b"),
+			},
+		},
+		{
+			name:       "openshift tenancy mode",
+			ctrlConfig: namespaceScoped,
+			tempo: v1alpha1.TempoStack{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-a"},
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{Mode: v1alpha1.ModeOpenShift},
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{Enabled: true},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "tenants", "mode"),
+					"the openshift tenancy mode requires cluster-scoped objects, which are not supported if the operator is restricted to namespaces"),
+			},
+		},
+		{
+			name:       "static tenancy mode with TempoTenants of other namespaces",
+			ctrlConfig: namespaceScoped,
+			tempo: v1alpha1.TempoStack{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-a"},
+				Spec: v1alpha1.TempoStackSpec{
+					Tenants: &v1alpha1.TenantsSpec{
+						Mode: v1alpha1.ModeStatic,
+						TempoTenantSelector: &v1alpha1.TempoTenantSelectorSpec{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Template: v1alpha1.TempoTemplateSpec{
+						Gateway: v1alpha1.TempoGatewaySpec{Enabled: true},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "tenants", "tempoTenantSelector", "namespaceSelector"),
+					"selecting TempoTenants by namespace labels requires cluster-scoped objects, which are not supported if the operator is restricted to namespaces"),
+			},
+		},
+		{
+			name:       "kube-rbac-proxy and zone-aware replication",
+			ctrlConfig: namespaceScoped,
+			tempo: v1alpha1.TempoStack{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-a"},
+				Spec: v1alpha1.TempoStackSpec{
+					ReplicationZones: []v1alpha1.ZoneSpec{{TopologyKey: "topology.kubernetes.io/zone"}},
+					Template: v1alpha1.TempoTemplateSpec{
+						QueryFrontend: v1alpha1.TempoQueryFrontendSpec{
+							JaegerQuery: v1alpha1.JaegerQuerySpec{
+								Enabled: true,
+								Authentication: &v1alpha1.JaegerQueryAuthenticationSpec{
+									Enabled:  true,
+									Provider: v1alpha1.JaegerUIAuthenticationProviderKubeRBACProxy,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "template", "queryFrontend", "jaegerQuery", "authentication", "provider"),
+					"the kube-rbac-proxy provider requires cluster-scoped objects, which are not supported if the operator is restricted to namespaces"),
+				field.Forbidden(field.NewPath("spec", "replicationZones"),
+					"zone-aware replication requires cluster-scoped objects, which are not supported if the operator is restricted to namespaces"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := &validator{ctrlConfig: test.ctrlConfig}
+			assert.Equal(t, test.expected, v.validateNamespaceScope(test.tempo))
+		})
+	}
+}
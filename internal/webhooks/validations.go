@@ -2,14 +2,29 @@ package webhooks
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
+	configv1alpha1 "github.com/grafana/tempo-operator/api/config/v1alpha1"
+	"github.com/grafana/tempo-operator/api/tempo/v1alpha1"
+	"github.com/grafana/tempo-operator/internal/manifests/config"
 	"github.com/grafana/tempo-operator/internal/manifests/gateway"
+	"github.com/grafana/tempo-operator/internal/manifests/kuberbacproxy"
+	"github.com/grafana/tempo-operator/internal/manifests/overrides"
 
 	authenticationv1 "k8s.io/api/authentication/v1"
 	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -17,6 +32,20 @@ import (
 
 const maxLabelLength = 63
 
+const (
+	minBlockBytes           = 1024 * 1024
+	minCompactionWindow     = time.Minute
+	maxCompactionWindow     = 24 * time.Hour
+	minMaxBlockDuration     = time.Minute
+	maxMaxBlockDuration     = time.Hour
+	minTraceIdlePeriod      = time.Second
+	minTraceByIDQueryShards = 2
+	maxTraceByIDQueryShards = 100000
+)
+
+// defaultMaxBlockDuration is the max_block_duration of the generated Tempo configuration if maxBlockDuration is not set.
+var defaultMaxBlockDuration, _ = time.ParseDuration(config.DefaultMaxBlockDuration)
+
 func validateName(name string) field.ErrorList {
 	// We need to check this because the name is used as a label value for app.kubernetes.io/instance
 	// Only validate the length, because the DNS rules are enforced by the functions in the `naming` package.
@@ -30,6 +59,24 @@ func validateName(name string) field.ErrorList {
 	return nil
 }
 
+// validateWatchedNamespace checks if the instance is located in a namespace watched by the operator.
+func validateWatchedNamespace(ctrlConfig configv1alpha1.ProjectConfig, namespace string) field.ErrorList {
+	if ctrlConfig.IsNamespaceWatched(namespace) {
+		return nil
+	}
+
+	return field.ErrorList{field.Forbidden(
+		field.NewPath("metadata").Child("namespace"),
+		fmt.Sprintf("the operator watches only the namespaces %s", strings.Join(ctrlConfig.WatchNamespaces, ", ")),
+	)}
+}
+
+// namespaceScopedError returns the error for a feature which requires cluster-scoped objects or permissions.
+// A namespace-scoped operator (i.e. the watchNamespaces setting is set) cannot manage cluster-scoped objects.
+func namespaceScopedError(path *field.Path, feature string) *field.Error {
+	return field.Forbidden(path, fmt.Sprintf("%s requires cluster-scoped objects, which are not supported if the operator is restricted to namespaces", feature))
+}
+
 func validateTempoNameConflict(getFn func() error, instanceName string, to string, from string) field.ErrorList {
 	var allErrs field.ErrorList
 	err := getFn()
@@ -84,14 +131,128 @@ func subjectAccessReviewsForClusterRole(user authenticationv1.UserInfo, clusterR
 // In other words, the operator should not grant e.g. TokenReview permissions to the ServiceAccount of the Tempo instance
 // if the user creating or modifying the TempoStack or TempoMonolithic doesn't have these permissions.
 func validateGatewayOpenShiftModeRBAC(ctx context.Context, client client.Client) error {
+	return validateClusterRolePermissions(ctx, client, *gateway.NewAccessReviewClusterRole("", map[string]string{}))
+}
+
+// validateKubeRBACProxy validates the kube-rbac-proxy authentication of the Jaeger UI.
+// The operator grants TokenReview and SubjectAccessReview permissions to the ServiceAccount of the Jaeger UI,
+// therefore the user requesting the change must have these permissions already.
+func validateKubeRBACProxy(ctx context.Context, client client.Client, path *field.Path, authSpec *v1alpha1.JaegerQueryAuthenticationSpec, image string, route bool, servingCerts bool) field.ErrorList {
+	if !authSpec.IsKubeRBACProxy() {
+		return nil
+	}
+
+	if image == "" {
+		return field.ErrorList{field.Invalid(
+			path.Child("provider"),
+			authSpec.Provider,
+			"please configure the kube-rbac-proxy image of the operator to use the kube-rbac-proxy provider",
+		)}
+	}
+
+	if route && !servingCerts {
+		return field.ErrorList{field.Invalid(
+			path.Child("provider"),
+			authSpec.Provider,
+			"please enable the featureGates.openshift.servingCertsService feature gate to use the kube-rbac-proxy provider with a Route",
+		)}
+	}
+
+	// The SAR is set by the defaulter webhook.
+	if len(strings.TrimSpace(authSpec.SAR)) > 0 {
+		if _, err := kuberbacproxy.ParseSAR(authSpec.SAR); err != nil {
+			return field.ErrorList{field.Invalid(path.Child("sar"), authSpec.SAR, err.Error())}
+		}
+	}
+
+	if err := validateClusterRolePermissions(ctx, client, *kuberbacproxy.NewAccessReviewClusterRole("", map[string]string{})); err != nil {
+		return field.ErrorList{field.Invalid(
+			path.Child("provider"),
+			authSpec.Provider,
+			fmt.Sprintf("Cannot enable the kube-rbac-proxy provider: %v", err),
+		)}
+	}
+
+	return nil
+}
+
+// validateJaegerUIOIDC validates the OIDC login of the Jaeger UI.
+// The Secret of the OIDC client must exist, because the pod does not start without the keys of the Secret.
+func validateJaegerUIOIDC(ctx context.Context, client client.Client, path *field.Path, namespace string, authSpec *v1alpha1.JaegerQueryAuthenticationSpec, image string) field.ErrorList {
+	if authSpec == nil || !authSpec.Enabled || authSpec.OIDC == nil {
+		return nil
+	}
+
+	oidcPath := path.Child("oidc")
+	if !authSpec.IsKubeRBACProxy() {
+		return field.ErrorList{field.Invalid(
+			path.Child("provider"),
+			authSpec.Provider,
+			"the OIDC login is only supported by the kube-rbac-proxy provider",
+		)}
+	}
+
+	if image == "" {
+		return field.ErrorList{field.Invalid(
+			oidcPath,
+			authSpec.OIDC.IssuerURL,
+			fmt.Sprintf("the oauth2-proxy image is not configured, please set the %s environment variable of the operator", configv1alpha1.EnvRelatedImageOAuth2Proxy),
+		)}
+	}
+
+	issuerURL, err := url.Parse(authSpec.OIDC.IssuerURL)
+	if err != nil || issuerURL.Scheme != "https" || issuerURL.Host == "" {
+		return field.ErrorList{field.Invalid(oidcPath.Child("issuerURL"), authSpec.OIDC.IssuerURL, "the issuer URL must be a valid https URL")}
+	}
+
+	secretPath := oidcPath.Child("secret")
+	secret := &corev1.Secret{}
+	err = client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: authSpec.OIDC.Secret}, secret)
+	if err != nil {
+		return field.ErrorList{field.Invalid(secretPath, authSpec.OIDC.Secret, err.Error())}
+	}
+
+	for _, key := range []string{kuberbacproxy.OIDCClientIDKey, kuberbacproxy.OIDCClientSecretKey, kuberbacproxy.OIDCCookieSecretKey} {
+		if len(secret.Data[key]) == 0 {
+			return field.ErrorList{field.Invalid(secretPath, authSpec.OIDC.Secret, fmt.Sprintf("the Secret must contain the key %s", key))}
+		}
+	}
+
+	if !validCookieSecret(secret.Data[kuberbacproxy.OIDCCookieSecretKey]) {
+		return field.ErrorList{field.Invalid(secretPath, authSpec.OIDC.Secret,
+			fmt.Sprintf("the %s of the Secret must have 16, 24 or 32 bytes", kuberbacproxy.OIDCCookieSecretKey))}
+	}
+
+	return nil
+}
+
+// validCookieSecret returns true if the cookie secret can be used by oauth2-proxy to encrypt the session cookies.
+// oauth2-proxy accepts raw and base64 encoded secrets of 16, 24 or 32 bytes.
+func validCookieSecret(cookieSecret []byte) bool {
+	validLength := func(n int) bool {
+		return n == 16 || n == 24 || n == 32
+	}
+
+	if validLength(len(cookieSecret)) {
+		return true
+	}
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding} {
+		if decoded, err := encoding.DecodeString(string(cookieSecret)); err == nil && validLength(len(decoded)) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateClusterRolePermissions checks if the user of the admission request has all permissions of the ClusterRole.
+func validateClusterRolePermissions(ctx context.Context, client client.Client, clusterRole rbacv1.ClusterRole) error {
 	req, err := admission.RequestFromContext(ctx)
 	if err != nil {
 		return err
 	}
 
 	user := req.UserInfo
-	clusterRole := gateway.NewAccessReviewClusterRole("", map[string]string{})
-	reviews := subjectAccessReviewsForClusterRole(user, *clusterRole)
+	reviews := subjectAccessReviewsForClusterRole(user, clusterRole)
 
 	for _, sar := range reviews {
 		err := client.Create(ctx, &sar)
@@ -106,3 +267,215 @@ func validateGatewayOpenShiftModeRBAC(ctx context.Context, client client.Client)
 
 	return nil
 }
+
+func validateDurationRange(path *field.Path, value *metav1.Duration, minimum, maximum time.Duration) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if value.Duration < minimum || value.Duration > maximum {
+		return field.ErrorList{field.Invalid(path, value.Duration.String(),
+			fmt.Sprintf("must be between %s and %s", minimum, maximum),
+		)}
+	}
+	return nil
+}
+
+func validatePositive(path *field.Path, value *int) field.ErrorList {
+	if value != nil && *value <= 0 {
+		return field.ErrorList{field.Invalid(path, *value, "must be greater than 0")}
+	}
+	return nil
+}
+
+func validateBlockBytes(path *field.Path, value *int) field.ErrorList {
+	if value != nil && *value < minBlockBytes {
+		return field.ErrorList{field.Invalid(path, *value, fmt.Sprintf("must be at least %d bytes", minBlockBytes))}
+	}
+	return nil
+}
+
+// validateTuning validates the bounds of the compaction, ingestion and query tuning options.
+func validateTuning(tuning *v1alpha1.TuningSpec, path *field.Path) field.ErrorList {
+	if tuning == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	if tuning.Compaction != nil {
+		compactionPath := path.Child("compaction")
+		allErrs = append(allErrs, validateDurationRange(compactionPath.Child("compactionWindow"), tuning.Compaction.CompactionWindow, minCompactionWindow, maxCompactionWindow)...)
+		allErrs = append(allErrs, validatePositive(compactionPath.Child("maxCompactionObjects"), tuning.Compaction.MaxCompactionObjects)...)
+		allErrs = append(allErrs, validateBlockBytes(compactionPath.Child("maxBlockBytes"), tuning.Compaction.MaxBlockBytes)...)
+	}
+
+	if tuning.Ingestion != nil {
+		ingestionPath := path.Child("ingestion")
+		allErrs = append(allErrs, validateDurationRange(ingestionPath.Child("maxBlockDuration"), tuning.Ingestion.MaxBlockDuration, minMaxBlockDuration, maxMaxBlockDuration)...)
+		allErrs = append(allErrs, validateBlockBytes(ingestionPath.Child("maxBlockBytes"), tuning.Ingestion.MaxBlockBytes)...)
+
+		maxTraceIdlePeriod := defaultMaxBlockDuration
+		if tuning.Ingestion.MaxBlockDuration != nil {
+			maxTraceIdlePeriod = tuning.Ingestion.MaxBlockDuration.Duration
+		}
+		allErrs = append(allErrs, validateDurationRange(ingestionPath.Child("traceIdlePeriod"), tuning.Ingestion.TraceIdlePeriod, minTraceIdlePeriod, maxTraceIdlePeriod)...)
+	}
+
+	if tuning.Query != nil {
+		queryPath := path.Child("query")
+		if shards := tuning.Query.TraceByIDQueryShards; shards != nil && (*shards < minTraceByIDQueryShards || *shards > maxTraceByIDQueryShards) {
+			allErrs = append(allErrs, field.Invalid(queryPath.Child("traceByIDQueryShards"), *shards,
+				fmt.Sprintf("must be between %d and %d", minTraceByIDQueryShards, maxTraceByIDQueryShards),
+			))
+		}
+		allErrs = append(allErrs, validatePositive(queryPath.Child("searchConcurrentJobs"), tuning.Query.SearchConcurrentJobs)...)
+		allErrs = append(allErrs, validatePositive(queryPath.Child("metricsConcurrentJobs"), tuning.Query.MetricsConcurrentJobs)...)
+		allErrs = append(allErrs, validatePositive(queryPath.Child("maxOutstandingPerTenant"), tuning.Query.MaxOutstandingPerTenant)...)
+		allErrs = append(allErrs, validatePositive(queryPath.Child("maxConcurrentQueries"), tuning.Query.MaxConcurrentQueries)...)
+	}
+
+	return allErrs
+}
+
+// validateExtraConfig validates the extra Tempo configuration against the schema of the bundled Tempo version.
+func validateExtraConfig(extraConfig *v1alpha1.ExtraConfigSpec, path *field.Path) (admission.Warnings, field.ErrorList) {
+	if extraConfig == nil {
+		return nil, nil
+	}
+
+	warnings, errs := config.ValidateExtraConfig(extraConfig.Tempo, path.Child("tempo"))
+	return admission.Warnings(warnings), errs
+}
+
+// validateOverrides validates the patches of the generated objects.
+// Patches of selectors and immutable fields are rejected.
+func validateOverrides(objectOverrides []v1alpha1.ObjectOverrideSpec, path *field.Path) (admission.Warnings, field.ErrorList) {
+	if len(objectOverrides) == 0 {
+		return nil, nil
+	}
+
+	return admission.Warnings{"overriding the generated objects could potentially break the deployment, use it carefully"},
+		overrides.Validate(objectOverrides, path)
+}
+
+// validateGrafanaDashboards validates the Grafana dashboards configuration.
+func validateGrafanaDashboards(dashboards *v1alpha1.GrafanaDashboardsSpec, grafanaOperator bool, path *field.Path) (admission.Warnings, field.ErrorList) {
+	if dashboards == nil || !dashboards.Enabled {
+		return nil, nil
+	}
+
+	var warnings admission.Warnings
+	if dashboards.InstanceSelector != nil && !grafanaOperator {
+		warnings = append(warnings, fmt.Sprintf(
+			"%s is ignored, the dashboards are created as ConfigMaps because the grafanaOperator feature gate is disabled",
+			path.Child("instanceSelector"),
+		))
+	}
+
+	return warnings, metav1validation.ValidateLabels(dashboards.ExtraLabels, path.Child("extraLabels"))
+}
+
+// validateGrafanaDataSourceLinks validates the links of the Grafana data source to other data sources.
+func validateGrafanaDataSourceLinks(links v1alpha1.GrafanaDataSourceLinksSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	validateTimeShift := func(value string, path *field.Path) {
+		if value == "" {
+			return
+		}
+		if _, err := time.ParseDuration(value); err != nil {
+			errs = append(errs, field.Invalid(path, value, "must be a duration, e.g. -1h or 30m"))
+		}
+	}
+
+	if links.TracesToLogs != nil {
+		validateTimeShift(links.TracesToLogs.SpanStartTimeShift, path.Child("tracesToLogs", "spanStartTimeShift"))
+		validateTimeShift(links.TracesToLogs.SpanEndTimeShift, path.Child("tracesToLogs", "spanEndTimeShift"))
+	}
+	if links.TracesToMetrics != nil {
+		validateTimeShift(links.TracesToMetrics.SpanStartTimeShift, path.Child("tracesToMetrics", "spanStartTimeShift"))
+		validateTimeShift(links.TracesToMetrics.SpanEndTimeShift, path.Child("tracesToMetrics", "spanEndTimeShift"))
+	}
+	return errs
+}
+
+// validateGrafanaDataSourceAuthentication validates the authentication of the per-tenant Grafana data sources.
+func validateGrafanaDataSourceAuthentication(auth *v1alpha1.GrafanaDataSourceAuthenticationSpec, path *field.Path) field.ErrorList {
+	if auth == nil {
+		return nil
+	}
+	if auth.Method == v1alpha1.GrafanaDataSourceAuthenticationServiceAccountToken && auth.TokenSecret == "" {
+		return field.ErrorList{field.Required(path.Child("tokenSecret"), "the token secret must be set if the method is serviceAccountToken")}
+	}
+	return nil
+}
+
+// validateMetricsScrape validates the scrape settings of the metrics endpoints.
+func validateMetricsScrape(metrics v1alpha1.MetricsConfigSpec, httpEncryption bool, path *field.Path) (admission.Warnings, field.ErrorList) {
+	var warnings admission.Warnings
+	var errs field.ErrorList
+
+	scrapeMode := metrics.ScrapeMode
+	if scrapeMode != "" && scrapeMode != v1alpha1.MetricsScrapeModeServiceMonitor && metrics.CreateServiceMonitors {
+		errs = append(errs, field.Invalid(path.Child("createServiceMonitors"), metrics.CreateServiceMonitors,
+			fmt.Sprintf("ServiceMonitors are not created if the scrape mode is %s", scrapeMode)))
+	}
+
+	scrape := metrics.MetricsScrapeSpec
+	if scrape.ScrapeInterval != nil && scrape.ScrapeTimeout != nil && scrape.ScrapeTimeout.Duration > scrape.ScrapeInterval.Duration {
+		errs = append(errs, field.Invalid(path.Child("scrapeTimeout"), scrape.ScrapeTimeout.Duration.String(),
+			"must not be greater than the scrape interval"))
+	}
+	for i, relabeling := range scrape.MetricRelabelings {
+		if _, err := regexp.Compile(relabeling.Regex); err != nil {
+			errs = append(errs, field.Invalid(path.Child("metricRelabelings").Index(i).Child("regex"), relabeling.Regex, err.Error()))
+		}
+	}
+
+	if scrapeMode == v1alpha1.MetricsScrapeModeAnnotations {
+		if scrape.ScrapeInterval != nil || scrape.ScrapeTimeout != nil || scrape.SampleLimit != nil || len(scrape.MetricRelabelings) > 0 {
+			warnings = append(warnings, "scrapeInterval, scrapeTimeout, sampleLimit and metricRelabelings are ignored if the scrape mode is annotations")
+		}
+		if httpEncryption {
+			warnings = append(warnings, "the metrics endpoints require a client certificate, which is not supported by annotation-based scraping, use the otelCollector scrape mode instead")
+		}
+	}
+	return warnings, errs
+}
+
+// validateOTLPTracing validates the export of the traces of the Tempo components.
+func validateOTLPTracing(spec *v1alpha1.OTLPTracingSpec, gatewayEnabled bool, path *field.Path) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	switch {
+	case spec.Endpoint == "" && spec.Gateway == nil:
+		errs = append(errs, field.Required(path.Child("endpoint"), "either the endpoint or the gateway must be set"))
+	case spec.Endpoint != "" && spec.Gateway != nil:
+		errs = append(errs, field.Invalid(path.Child("endpoint"), spec.Endpoint, "the endpoint and the gateway must not be set at the same time"))
+	case spec.Endpoint != "":
+		endpoint, err := url.Parse(spec.Endpoint)
+		if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+			errs = append(errs, field.Invalid(path.Child("endpoint"), spec.Endpoint, "must be a URL with the scheme http or https"))
+		}
+	case spec.Gateway.Tenant == "":
+		errs = append(errs, field.Required(path.Child("gateway", "tenant"), "the tenant must be set"))
+	case !gatewayEnabled:
+		errs = append(errs, field.Invalid(path.Child("gateway"), spec.Gateway.Tenant, "the gateway must be enabled to send the traces to the gateway"))
+	}
+
+	if spec.SamplerArgument != "" {
+		ratio, err := strconv.ParseFloat(spec.SamplerArgument, 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			errs = append(errs, field.Invalid(path.Child("samplerArgument"), spec.SamplerArgument, "must be a number between 0 and 1"))
+		}
+	}
+
+	for key := range spec.ResourceAttributes {
+		if key == "" || strings.ContainsAny(key, ",=") {
+			errs = append(errs, field.Invalid(path.Child("resourceAttributes").Key(key), key, "must not be empty or contain the characters , and ="))
+		}
+	}
+	return errs
+}